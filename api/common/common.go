@@ -1,22 +1,68 @@
 package common
 
 import (
+	"errors"
+
 	corev1 "k8s.io/api/core/v1"
 )
 
 // StatusCreated is success status for Sonar resources.
 const StatusCreated = "created"
 
+const (
+	// SonarKind is the kind of the namespaced Sonar resource.
+	SonarKind = "Sonar"
+
+	// ClusterSonarKind is the kind of the cluster-scoped Sonar resource.
+	ClusterSonarKind = "ClusterSonar"
+
+	// SonarGroupKind is the kind of the SonarGroup resource.
+	SonarGroupKind = "SonarGroup"
+
+	// SonarPermissionTemplateKind is the kind of the SonarPermissionTemplate resource.
+	SonarPermissionTemplateKind = "SonarPermissionTemplate"
+
+	// SonarProjectKind is the kind of the SonarProject resource.
+	SonarProjectKind = "SonarProject"
+
+	// SonarQualityGateKind is the kind of the SonarQualityGate resource.
+	SonarQualityGateKind = "SonarQualityGate"
+
+	// SonarQualityProfileKind is the kind of the SonarQualityProfile resource.
+	SonarQualityProfileKind = "SonarQualityProfile"
+
+	// SonarUserKind is the kind of the SonarUser resource.
+	SonarUserKind = "SonarUser"
+
+	// SonarWebhookKind is the kind of the SonarWebhook resource.
+	SonarWebhookKind = "SonarWebhook"
+)
+
+// ErrNamespaceNotAllowed is returned when sonar doesn't allow references from the namespace of the resource.
+var ErrNamespaceNotAllowed = errors.New("namespace is not allowed")
+
+// ErrNotSupportedBySonarCloud is returned when the resource can't be managed in SonarCloud.
+var ErrNotSupportedBySonarCloud = errors.New("not supported by SonarCloud")
+
 // SonarRef is a reference to a Sonar instance.
+// +kubebuilder:validation:XValidation:rule="!has(self.__namespace__) || !has(self.kind) || self.kind == 'Sonar'",message="namespace can't be set for ClusterSonar"
 type SonarRef struct {
 	// Kind specifies the kind of the Sonar resource.
+	// Sonar is read from the namespace of the resource by default, ClusterSonar is cluster-scoped.
 	// +optional
 	// +kubebuilder:default=Sonar
+	// +kubebuilder:validation:Enum=Sonar;ClusterSonar
 	Kind string `json:"kind"`
 
 	// Name specifies the name of the Sonar resource.
 	// +required
 	Name string `json:"name"`
+
+	// Namespace specifies the namespace of the Sonar resource.
+	// Sonar from another namespace must allow the namespace of the resource in allowedNamespaces.
+	// +optional
+	// +kubebuilder:example="sonar"
+	Namespace string `json:"namespace,omitempty"`
 }
 
 type HasSonarRef interface {
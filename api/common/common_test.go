@@ -0,0 +1,38 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestKinds(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	kinds := map[string]runtime.Object{
+		common.SonarKind:                   &sonarApi.Sonar{},
+		common.ClusterSonarKind:            &sonarApi.ClusterSonar{},
+		common.SonarGroupKind:              &sonarApi.SonarGroup{},
+		common.SonarPermissionTemplateKind: &sonarApi.SonarPermissionTemplate{},
+		common.SonarProjectKind:            &sonarApi.SonarProject{},
+		common.SonarQualityGateKind:        &sonarApi.SonarQualityGate{},
+		common.SonarQualityProfileKind:     &sonarApi.SonarQualityProfile{},
+		common.SonarUserKind:               &sonarApi.SonarUser{},
+		common.SonarWebhookKind:            &sonarApi.SonarWebhook{},
+	}
+
+	for kind, obj := range kinds {
+		gvks, _, err := scheme.ObjectKinds(obj)
+		require.NoError(t, err)
+		require.Len(t, gvks, 1)
+		assert.Equal(t, gvks[0].Kind, kind)
+	}
+}
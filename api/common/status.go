@@ -0,0 +1,150 @@
+package common
+
+import (
+	"errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConditionReady indicates that the resource is synced and sonar is reachable.
+	ConditionReady = "Ready"
+
+	// ConditionSynced indicates that the resource spec is applied to sonar.
+	ConditionSynced = "Synced"
+
+	// ConditionSonarConnected indicates that the operator can connect to sonar.
+	ConditionSonarConnected = "SonarConnected"
+)
+
+const (
+	// ReasonReconciled is the reason of the Ready condition when the resource is reconciled.
+	ReasonReconciled = "Reconciled"
+
+	// ReasonSynced is the reason of the Synced condition when the resource spec is applied to sonar.
+	ReasonSynced = "Synced"
+
+	// ReasonSyncFailed is the reason of the Synced condition when the resource spec can't be applied to sonar.
+	ReasonSyncFailed = "SyncFailed"
+
+	// ReasonConnected is the reason of the SonarConnected condition when sonar is reachable.
+	ReasonConnected = "Connected"
+
+	// ReasonConnectionFailed is the reason of the SonarConnected condition when sonar is not reachable.
+	ReasonConnectionFailed = "ConnectionFailed"
+
+	// ReasonNamespaceNotAllowed is the reason of the SonarConnected condition
+	// when sonar doesn't allow references from the namespace of the resource.
+	ReasonNamespaceNotAllowed = "NamespaceNotAllowed"
+
+	// ReasonNotSupported is the reason of the Synced condition when the resource can't be managed in the sonar flavor.
+	ReasonNotSupported = "NotSupported"
+
+	// ReasonPending is the reason of a condition that is not evaluated yet.
+	ReasonPending = "Pending"
+)
+
+// lastSyncTimeRefreshInterval limits how often the last sync time is refreshed,
+// so that a status update doesn't trigger an endless chain of reconciliations.
+const lastSyncTimeRefreshInterval = time.Minute
+
+// ReconcileStatus is the common part of the status of all resources reconciled by the operator.
+// +kubebuilder:object:generate=true
+type ReconcileStatus struct {
+	// Conditions represent the latest available observations of the resource state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the last generation of the resource that was reconciled.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastSyncTime is the time when the resource was successfully synced with sonar last time.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// SetSonarConnected sets the SonarConnected condition according to the connection error.
+func (s *ReconcileStatus) SetSonarConnected(generation int64, err error) {
+	switch {
+	case errors.Is(err, ErrNamespaceNotAllowed):
+		s.setCondition(generation, ConditionSonarConnected, metav1.ConditionFalse, ReasonNamespaceNotAllowed, err.Error())
+	case err != nil:
+		s.setCondition(generation, ConditionSonarConnected, metav1.ConditionFalse, ReasonConnectionFailed, err.Error())
+	default:
+		s.setCondition(generation, ConditionSonarConnected, metav1.ConditionTrue, ReasonConnected, "Connected to sonar")
+	}
+
+	s.setReady(generation)
+}
+
+// SetSynced sets the Synced condition according to the sync error.
+// The last sync time is refreshed if the sync is successful.
+func (s *ReconcileStatus) SetSynced(generation int64, err error) {
+	switch {
+	case errors.Is(err, ErrNotSupportedBySonarCloud):
+		s.setCondition(generation, ConditionSynced, metav1.ConditionFalse, ReasonNotSupported, err.Error())
+	case err != nil:
+		s.setCondition(generation, ConditionSynced, metav1.ConditionFalse, ReasonSyncFailed, err.Error())
+	default:
+		s.setCondition(generation, ConditionSynced, metav1.ConditionTrue, ReasonSynced, "Resource is synced with sonar")
+
+		if s.LastSyncTime == nil || time.Since(s.LastSyncTime.Time) >= lastSyncTimeRefreshInterval {
+			now := metav1.Now()
+			s.LastSyncTime = &now
+		}
+	}
+
+	s.ObservedGeneration = generation
+	s.setReady(generation)
+}
+
+// setReady sets the Ready condition from the SonarConnected and Synced conditions.
+// A failed condition takes precedence over a condition that is not set yet.
+func (s *ReconcileStatus) setReady(generation int64) {
+	var pending string
+
+	for _, t := range []string{ConditionSonarConnected, ConditionSynced} {
+		c := meta.FindStatusCondition(s.Conditions, t)
+		if c == nil {
+			if pending == "" {
+				pending = t
+			}
+
+			continue
+		}
+
+		if c.Status != metav1.ConditionTrue {
+			s.setCondition(generation, ConditionReady, metav1.ConditionFalse, c.Reason, c.Message)
+
+			return
+		}
+	}
+
+	if pending != "" {
+		s.setCondition(generation, ConditionReady, metav1.ConditionFalse, ReasonPending, pending+" condition is not set yet")
+
+		return
+	}
+
+	s.setCondition(generation, ConditionReady, metav1.ConditionTrue, ReasonReconciled, "Resource is ready")
+}
+
+func (s *ReconcileStatus) setCondition(
+	generation int64,
+	conditionType string,
+	status metav1.ConditionStatus,
+	reason, message string,
+) {
+	meta.SetStatusCondition(&s.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
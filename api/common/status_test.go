@@ -0,0 +1,132 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReconcileStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		status          ReconcileStatus
+		update          func(s *ReconcileStatus)
+		wantReady       metav1.ConditionStatus
+		wantReason      string
+		wantGeneration  int64
+		wantLastSyncSet bool
+	}{
+		{
+			name: "resource is ready",
+			update: func(s *ReconcileStatus) {
+				s.SetSonarConnected(2, nil)
+				s.SetSynced(2, nil)
+			},
+			wantReady:       metav1.ConditionTrue,
+			wantReason:      ReasonReconciled,
+			wantGeneration:  2,
+			wantLastSyncSet: true,
+		},
+		{
+			name: "sonar is not connected",
+			update: func(s *ReconcileStatus) {
+				s.SetSonarConnected(1, errors.New("sonar is not connected"))
+			},
+			wantReady:  metav1.ConditionFalse,
+			wantReason: ReasonConnectionFailed,
+		},
+		{
+			name: "namespace is not allowed",
+			update: func(s *ReconcileStatus) {
+				s.SetSonarConnected(1, fmt.Errorf("sonar doesn't allow references from namespace team-a: %w",
+					ErrNamespaceNotAllowed))
+			},
+			wantReady:  metav1.ConditionFalse,
+			wantReason: ReasonNamespaceNotAllowed,
+		},
+		{
+			name: "sync failed",
+			update: func(s *ReconcileStatus) {
+				s.SetSonarConnected(1, nil)
+				s.SetSynced(1, errors.New("failed to create project"))
+			},
+			wantReady:      metav1.ConditionFalse,
+			wantReason:     ReasonSyncFailed,
+			wantGeneration: 1,
+		},
+		{
+			name: "resource is not supported by SonarCloud",
+			update: func(s *ReconcileStatus) {
+				s.SetSonarConnected(1, nil)
+				s.SetSynced(1, fmt.Errorf("SonarUser is %w", ErrNotSupportedBySonarCloud))
+			},
+			wantReady:      metav1.ConditionFalse,
+			wantReason:     ReasonNotSupported,
+			wantGeneration: 1,
+		},
+		{
+			name: "connection is not checked yet",
+			update: func(s *ReconcileStatus) {
+				s.SetSynced(1, nil)
+			},
+			wantReady:       metav1.ConditionFalse,
+			wantReason:      ReasonPending,
+			wantGeneration:  1,
+			wantLastSyncSet: true,
+		},
+		{
+			name: "connection is lost after successful sync",
+			update: func(s *ReconcileStatus) {
+				s.SetSonarConnected(1, nil)
+				s.SetSynced(1, nil)
+				s.SetSonarConnected(1, errors.New("sonar is not connected"))
+			},
+			wantReady:       metav1.ConditionFalse,
+			wantReason:      ReasonConnectionFailed,
+			wantGeneration:  1,
+			wantLastSyncSet: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := tt.status
+			tt.update(&s)
+
+			ready := meta.FindStatusCondition(s.Conditions, ConditionReady)
+			require.NotNil(t, ready)
+			require.Equal(t, tt.wantReady, ready.Status)
+			require.Equal(t, tt.wantReason, ready.Reason)
+			require.Equal(t, tt.wantGeneration, s.ObservedGeneration)
+			require.Equal(t, tt.wantLastSyncSet, s.LastSyncTime != nil)
+		})
+	}
+}
+
+func TestReconcileStatus_SetSynced_LastSyncTime(t *testing.T) {
+	t.Parallel()
+
+	recent := metav1.NewTime(time.Now().Add(-time.Second))
+	s := ReconcileStatus{LastSyncTime: &recent}
+
+	s.SetSynced(1, nil)
+	require.Equal(t, recent, *s.LastSyncTime, "recent sync time shouldn't be refreshed")
+
+	old := metav1.NewTime(time.Now().Add(-time.Hour))
+	s.LastSyncTime = &old
+
+	s.SetSynced(1, nil)
+	require.True(t, s.LastSyncTime.After(old.Time))
+
+	s.SetSynced(1, errors.New("failed"))
+	require.True(t, s.LastSyncTime.After(old.Time), "failed sync shouldn't reset sync time")
+}
@@ -0,0 +1,65 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Connected",type="boolean",JSONPath=".status.connected",description="Is connected to sonar"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is ready"
+// +kubebuilder:printcolumn:name="Health",type="string",JSONPath=".status.value",description="Sonar health"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version",description="Sonar version"
+// +kubebuilder:printcolumn:name="Edition",type="string",JSONPath=".status.edition",description="Sonar edition",priority=1
+// +kubebuilder:printcolumn:name="Last Sync",type="date",JSONPath=".status.lastSyncTime",description="Last successful sync"
+
+// ClusterSonar is the Schema for the cluster-scoped sonars API.
+// It can be referenced from any namespace with the ClusterSonar kind of sonarRef.
+// The Secrets and ConfigMaps referenced by ClusterSonar are read from the operator namespace.
+type ClusterSonar struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SonarSpec   `json:"spec,omitempty"`
+	Status SonarStatus `json:"status,omitempty"`
+}
+
+// ToSonar returns the Sonar view of ClusterSonar that is reconciled and connected to as a namespaced Sonar.
+// The view is placed in the given namespace to read the referenced Secrets and ConfigMaps from it.
+// The view keeps the ClusterSonar kind, so the objects it owns are owned by ClusterSonar.
+func (in *ClusterSonar) ToSonar(namespace string) *Sonar {
+	meta := in.ObjectMeta.DeepCopy()
+	meta.Namespace = namespace
+
+	return &Sonar{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.String(),
+			Kind:       common.ClusterSonarKind,
+		},
+		ObjectMeta: *meta,
+		Spec:       *in.Spec.DeepCopy(),
+		Status:     *in.Status.DeepCopy(),
+	}
+}
+
+// IsClusterSonarView checks if Sonar is the view of ClusterSonar.
+func (in *Sonar) IsClusterSonarView() bool {
+	return in.Kind == common.ClusterSonarKind
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterSonarList contains a list of ClusterSonar.
+type ClusterSonarList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterSonar `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterSonar{}, &ClusterSonarList{})
+}
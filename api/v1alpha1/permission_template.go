@@ -46,6 +46,8 @@ type SonarPermissionTemplateSpec struct {
 
 // SonarPermissionTemplateStatus defines the observed state of SonarPermissionTemplate.
 type SonarPermissionTemplateStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is a status of the permission template.
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -58,6 +60,9 @@ type SonarPermissionTemplateStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is ready"
+// +kubebuilder:printcolumn:name="Last Sync",type="date",JSONPath=".status.lastSyncTime",description="Last successful sync"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // SonarPermissionTemplate is the Schema for the sonar permission template API.
 type SonarPermissionTemplate struct {
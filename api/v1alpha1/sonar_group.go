@@ -34,6 +34,8 @@ type SonarGroupSpec struct {
 
 // SonarGroupStatus defines the observed state of SonarGroup.
 type SonarGroupStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is a status of the group.
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -46,6 +48,9 @@ type SonarGroupStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is ready"
+// +kubebuilder:printcolumn:name="Last Sync",type="date",JSONPath=".status.lastSyncTime",description="Last successful sync"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // SonarGroup is the Schema for the sonar group API.
 type SonarGroup struct {
@@ -7,6 +7,8 @@ import (
 )
 
 // SonarSpec defines the desired state of Sonar.
+// +kubebuilder:validation:XValidation:rule="!has(self.flavor) || self.flavor != 'SonarCloud' || has(self.organization)",message="organization is required for SonarCloud"
+// +kubebuilder:validation:XValidation:rule="!has(self.flavor) || self.flavor != 'SonarCloud' || (!has(self.plugins) && !has(self.license) && !has(self.adminCredentials))",message="plugins, license and adminCredentials are not supported by SonarCloud"
 type SonarSpec struct {
 	// Secret is the name of the k8s object Secret related to sonar.
 	// Secret should contain a user field with a sonar username and a password field with a sonar password.
@@ -14,8 +16,22 @@ type SonarSpec struct {
 	Secret string `json:"secret"`
 
 	// Url is the url of sonar instance.
+	// Use https://sonarcloud.io for SonarCloud.
 	Url string `json:"url"`
 
+	// Flavor is the type of sonar instance.
+	// SonarQube is a self-hosted server, SonarCloud is the SaaS offering that has no system API.
+	// +optional
+	// +kubebuilder:default=SonarQube
+	// +kubebuilder:validation:Enum=SonarQube;SonarCloud
+	Flavor string `json:"flavor,omitempty"`
+
+	// Organization is the key of the organization that is sent with the organization-scoped requests to sonar.
+	// It is required for SonarCloud.
+	// +optional
+	// +kubebuilder:example="my-organization"
+	Organization string `json:"organization,omitempty"`
+
 	// Settings specify which settings should be configured.
 	// +optional
 	Settings []SonarSetting `json:"settings,omitempty"`
@@ -24,8 +40,276 @@ type SonarSpec struct {
 	// +optional
 	// +kubebuilder:example="Default template for projects"
 	DefaultPermissionTemplate string `json:"defaultPermissionTemplate,omitempty"`
+
+	// Plugins is a list of plugins that should be installed in sonar.
+	// Missing plugins are installed from the SonarQube Marketplace, and sonar is restarted once after each batch of changes.
+	// +optional
+	// +kubebuilder:example={{key: "java"}, {key: "go", version: "1.15.0.4655"}}
+	Plugins []SonarPlugin `json:"plugins,omitempty"`
+
+	// PluginRemovalPolicy defines what happens with a plugin that was installed by the operator
+	// and then removed from the plugins list.
+	// Retain keeps the plugin installed, Uninstall removes it from sonar.
+	// +optional
+	// +kubebuilder:default=Retain
+	// +kubebuilder:validation:Enum=Retain;Uninstall
+	PluginRemovalPolicy string `json:"pluginRemovalPolicy,omitempty"`
+
+	// AdminCredentials configures bootstrap and rotation of the sonar admin password.
+	// If set, the operator manages the Secret from the secret field:
+	// it connects to a fresh sonar with the initial credentials, changes the password to the desired one
+	// and stores the current credentials in the Secret.
+	// +optional
+	AdminCredentials *AdminCredentials `json:"adminCredentials,omitempty"`
+
+	// TokenAuth enables authentication with an API token generated by the operator.
+	// The credentials from the secret field are used only to generate the token,
+	// all other requests are sent with the token that is stored in the operator-owned Secret.
+	// +optional
+	TokenAuth *TokenAuth `json:"tokenAuth,omitempty"`
+
+	// TLS configures the TLS connection to sonar.
+	// +optional
+	TLS *SonarTLS `json:"tls,omitempty"`
+
+	// Proxy configures the HTTP proxy that is used to connect to sonar.
+	// +optional
+	Proxy *SonarProxy `json:"proxy,omitempty"`
+
+	// Headers are additional HTTP headers that are sent with every request to sonar.
+	// +optional
+	Headers []SonarHeader `json:"headers,omitempty"`
+
+	// Timeout is the timeout of a single request to sonar.
+	// +optional
+	// +kubebuilder:example="30s"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// RequestPolicy configures the rate limit and the retries of the requests to sonar.
+	// If not set, failed requests are retried 3 times with the exponential backoff and the rate is not limited.
+	// +optional
+	RequestPolicy *SonarRequestPolicy `json:"requestPolicy,omitempty"`
+
+	// ProjectMetrics enables the export of the quality gate status and the measures
+	// of the projects managed by SonarProject resources as Prometheus metrics.
+	// If not set, the project metrics are not exported.
+	// +optional
+	ProjectMetrics *SonarProjectMetrics `json:"projectMetrics,omitempty"`
+
+	// License is the license of a commercial edition of sonar.
+	// The license is installed if sonar has no license or the license in the Secret is changed.
+	// +optional
+	License *SonarLicense `json:"license,omitempty"`
+
+	// AllowedNamespaces defines the namespaces whose resources can reference this sonar.
+	// Sonar is always available to the resources from its own namespace.
+	// If not set, Sonar is available only to its own namespace and ClusterSonar is available to all namespaces.
+	// +optional
+	AllowedNamespaces *AllowedNamespaces `json:"allowedNamespaces,omitempty"`
+}
+
+// AllowedNamespaces defines the namespaces that can reference sonar.
+// A namespace is allowed if it is listed in names or matches the selector.
+// +kubebuilder:validation:XValidation:rule="has(self.names) || has(self.selector)",message="at least one of names or selector must be set"
+type AllowedNamespaces struct {
+	// Names is a list of the allowed namespaces.
+	// +optional
+	// +kubebuilder:example={"team-a", "team-b"}
+	Names []string `json:"names,omitempty"`
+
+	// Selector selects the allowed namespaces by labels.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// SonarLicense defines the license of a commercial edition of sonar.
+type SonarLicense struct {
+	// SecretKeyRef is a reference to a key in a Secret with the license.
+	// +required
+	SecretKeyRef common.SecretKeySelector `json:"secretKeyRef"`
+
+	// ExpirationWarningDays is the number of days before the license expiration
+	// when the LicenseExpiring condition becomes true.
+	// +optional
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=1
+	ExpirationWarningDays int `json:"expirationWarningDays,omitempty"`
+}
+
+// SonarRequestPolicy defines the rate limit and the retries of the requests to sonar.
+// The requests rejected with 429 or 503 and the connection failures are retried with the exponential backoff and jitter.
+// Other server errors are retried only for the requests that don't change sonar.
+type SonarRequestPolicy struct {
+	// RateLimit is the maximum average number of requests per second sent to sonar.
+	// Zero disables the rate limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:example=10
+	RateLimit int `json:"rateLimit,omitempty"`
+
+	// Burst is the maximum number of requests that are sent at once when the rate limit is set.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Burst int `json:"burst,omitempty"`
+
+	// MaxRetries is the maximum number of retries of a failed request.
+	// Zero disables the retries.
+	// +optional
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// RetryWaitTime is the initial wait time of the exponential backoff.
+	// +optional
+	// +kubebuilder:default="1s"
+	RetryWaitTime *metav1.Duration `json:"retryWaitTime,omitempty"`
+
+	// MaxRetryWaitTime is the maximum wait time between the retries.
+	// The Retry-After header of sonar is respected, a request is not retried if sonar asks to wait longer.
+	// +optional
+	// +kubebuilder:default="30s"
+	MaxRetryWaitTime *metav1.Duration `json:"maxRetryWaitTime,omitempty"`
 }
 
+// SonarProjectMetrics defines the measures of the projects that are exported as Prometheus metrics.
+type SonarProjectMetrics struct {
+	// Metrics are the keys of the sonar metrics that are exported for every project.
+	// The quality gate status is always exported.
+	// +optional
+	// +kubebuilder:default={"coverage","bugs","vulnerabilities","code_smells"}
+	// +kubebuilder:validation:MaxItems=50
+	// +kubebuilder:example={"coverage","duplicated_lines_density"}
+	Metrics []string `json:"metrics,omitempty"`
+}
+
+// SonarProxy defines the HTTP proxy of the sonar connection.
+type SonarProxy struct {
+	// Url is the url of the proxy.
+	// +required
+	// +kubebuilder:example="http://proxy.example.com:3128"
+	Url string `json:"url"`
+
+	// CredentialsSecret is the name of the k8s object Secret with the proxy credentials.
+	// Secret should contain a user field with a proxy username and a password field with a proxy password.
+	// +optional
+	// +kubebuilder:example="sonar-proxy"
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// SonarHeader defines the HTTP header that is sent with every request to sonar.
+// +kubebuilder:validation:XValidation:rule="has(self.value) != has(self.valueRef)",message="exactly one of value or valueRef must be set"
+type SonarHeader struct {
+	// Name is the name of the header.
+	// +required
+	// +kubebuilder:example="X-Gateway-Key"
+	Name string `json:"name"`
+
+	// Value is the value of the header.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// ValueRef is a reference to a key in a ConfigMap or a Secret with the value of the header.
+	// +optional
+	ValueRef *common.SourceRef `json:"valueRef,omitempty"`
+}
+
+// SonarTLS defines the TLS settings of the sonar connection.
+type SonarTLS struct {
+	// CA is a reference to the PEM encoded CA bundle that is used to verify the sonar server certificate
+	// in addition to the system CA pool.
+	// +optional
+	CA *common.SourceRef `json:"ca,omitempty"`
+
+	// ClientCertSecret is the name of the kubernetes.io/tls Secret
+	// with the client certificate and key that are used for mTLS.
+	// Secret should contain tls.crt and tls.key fields.
+	// +optional
+	// +kubebuilder:example="sonar-client-cert"
+	ClientCertSecret string `json:"clientCertSecret,omitempty"`
+
+	// InsecureSkipVerify disables verification of the sonar server certificate.
+	// It should be used only for testing.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// TokenAuth defines the API token that is generated and renewed by the operator.
+// +kubebuilder:validation:XValidation:rule="self.renewBeforeDays < self.expirationDays",message="renewBeforeDays must be less than expirationDays"
+type TokenAuth struct {
+	// Secret is the name of the k8s object Secret where the operator stores the generated token.
+	// If not set, <sonar name>-operator-token is used.
+	// +optional
+	// +kubebuilder:example="sonar-operator-token"
+	Secret string `json:"secret,omitempty"`
+
+	// ExpirationDays is the lifetime of the generated token in days.
+	// +optional
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=1
+	ExpirationDays int `json:"expirationDays,omitempty"`
+
+	// RenewBeforeDays is the number of days before the token expiration when the token is renewed.
+	// +optional
+	// +kubebuilder:default=7
+	// +kubebuilder:validation:Minimum=0
+	RenewBeforeDays int `json:"renewBeforeDays,omitempty"`
+}
+
+// AdminCredentials defines the initial and desired credentials of the sonar admin.
+type AdminCredentials struct {
+	// InitialSecret is the name of the k8s object Secret with the initial admin credentials.
+	// Secret should contain a user field with a sonar username and a password field with a sonar password.
+	// If not set, the default admin/admin credentials are used.
+	// +optional
+	// +kubebuilder:example="sonar-initial-admin"
+	InitialSecret string `json:"initialSecret,omitempty"`
+
+	// DesiredSecret is the name of the k8s object Secret with the desired admin password.
+	// Secret should contain a password field with the desired password and
+	// may contain a user field that must match the initial username.
+	// The password is rotated whenever the Secret changes.
+	// +required
+	// +kubebuilder:example="sonar-admin-password"
+	DesiredSecret string `json:"desiredSecret"`
+}
+
+// SonarPlugin defines the plugin of sonar.
+type SonarPlugin struct {
+	// Key is the key of the plugin.
+	// +kubebuilder:example=java
+	Key string `json:"key"`
+
+	// Version is the pinned version of the plugin.
+	// The Marketplace can install only the latest compatible release,
+	// so reconciliation fails if that release doesn't match the pinned version.
+	// If not set, the latest compatible release is installed and kept.
+	// +optional
+	// +kubebuilder:example="1.15.0.4655"
+	Version string `json:"version,omitempty"`
+}
+
+const (
+	// FlavorSonarQube is a self-hosted SonarQube server.
+	FlavorSonarQube = "SonarQube"
+
+	// FlavorSonarCloud is SonarCloud.
+	FlavorSonarCloud = "SonarCloud"
+)
+
+// IsSonarCloud checks if the spec targets SonarCloud.
+func (in *SonarSpec) IsSonarCloud() bool {
+	return in.Flavor == FlavorSonarCloud
+}
+
+const (
+	// PluginRemovalPolicyRetain keeps plugins in sonar after they are removed from the spec.
+	PluginRemovalPolicyRetain = "Retain"
+
+	// PluginRemovalPolicyUninstall uninstalls plugins from sonar after they are removed from the spec.
+	PluginRemovalPolicyUninstall = "Uninstall"
+)
+
 // SonarSetting defines the setting of sonar.
 type SonarSetting struct {
 	// Key is the key of the setting.
@@ -56,6 +340,8 @@ type SonarSetting struct {
 
 // SonarStatus defines the observed state of Sonar.
 type SonarStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is status of sonar instance.
 	// Possible values:
 	// GREEN: SonarQube is fully operational
@@ -64,6 +350,26 @@ type SonarStatus struct {
 	// +optional
 	Value string `json:"value,omitempty"`
 
+	// HealthCauses explains why the health of sonar instance is not GREEN.
+	// +optional
+	HealthCauses []string `json:"healthCauses,omitempty"`
+
+	// Nodes shows the health of each node of the Data Center Edition cluster.
+	// +optional
+	Nodes []SonarNodeStatus `json:"nodes,omitempty"`
+
+	// Version is the version of sonar server.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Edition is the edition of sonar server, e.g. community, developer, enterprise or datacenter.
+	// +optional
+	Edition string `json:"edition,omitempty"`
+
+	// ServerID is the ID of sonar server.
+	// +optional
+	ServerID string `json:"serverId,omitempty"`
+
 	// Error represents error message if something went wrong.
 	// +optional
 	Error string `json:"error,omitempty"`
@@ -73,16 +379,215 @@ type SonarStatus struct {
 	Connected bool `json:"connected"`
 
 	// ProcessedSettings shows which settings were processed.
-	// It is used to compare the current settings with the settings that were processed
-	// to unset the settings that are not in the current settings.
+	// Deprecated: use Settings instead. The field is migrated to Settings and cleared by the operator.
 	// +optional
 	ProcessedSettings string `json:"processedSettings,omitempty"`
+
+	SettingsStatus `json:",inline"`
+
+	// InstalledPlugins shows which plugins managed by the operator are installed and their versions.
+	// It is also used to find plugins that were removed from the spec.
+	// +optional
+	InstalledPlugins []InstalledPlugin `json:"installedPlugins,omitempty"`
+
+	// AdminCredentials shows the progress of the admin password bootstrap and rotation.
+	// +optional
+	AdminCredentials *AdminCredentialsStatus `json:"adminCredentials,omitempty"`
+
+	// Token shows the API token that is used by the operator.
+	// +optional
+	Token *TokenStatus `json:"token,omitempty"`
+
+	// License shows the license installed in sonar.
+	// +optional
+	License *LicenseStatus `json:"license,omitempty"`
+}
+
+// LicenseStatus defines the observed state of the sonar license.
+type LicenseStatus struct {
+	// Edition is the sonar edition of the license.
+	// +optional
+	Edition string `json:"edition,omitempty"`
+
+	// ExpirationDate is the date when the license expires.
+	// +optional
+	ExpirationDate *metav1.Time `json:"expirationDate,omitempty"`
+
+	// MaxLinesOfCode is the maximum number of lines of code allowed by the license.
+	// +optional
+	MaxLinesOfCode int64 `json:"maxLinesOfCode,omitempty"`
+
+	// LinesOfCode is the number of lines of code analyzed by sonar.
+	// +optional
+	LinesOfCode int64 `json:"linesOfCode,omitempty"`
+
+	// RemainingLinesOfCode is the number of lines of code that can be analyzed before the license limit is reached.
+	// +optional
+	RemainingLinesOfCode int64 `json:"remainingLinesOfCode,omitempty"`
+
+	// LicenseHash is the SHA-256 hash of the license that was installed by the operator.
+	// +optional
+	LicenseHash string `json:"licenseHash,omitempty"`
+}
+
+const (
+	// ConditionLicenseExpiring indicates that the sonar license expires soon or is already expired.
+	ConditionLicenseExpiring = "LicenseExpiring"
+
+	// ReasonLicenseValid is the reason of the LicenseExpiring condition when the license is valid.
+	ReasonLicenseValid = "LicenseValid"
+
+	// ReasonLicenseExpiringSoon is the reason of the LicenseExpiring condition when the license expires soon.
+	ReasonLicenseExpiringSoon = "LicenseExpiringSoon"
+
+	// ReasonLicenseExpired is the reason of the LicenseExpiring condition when the license is expired.
+	ReasonLicenseExpired = "LicenseExpired"
+)
+
+// SettingsStatus defines the observed state of the settings that are managed by the operator.
+type SettingsStatus struct {
+	// Settings shows the settings from the spec that were processed.
+	// It is used to unset the settings that were removed from the spec
+	// and to find the settings that were changed in sonar outside the operator.
+	// +optional
+	// +listType=map
+	// +listMapKey=key
+	Settings []ProcessedSetting `json:"settings,omitempty"`
+
+	// SettingsDrift shows the settings that were changed in sonar outside the operator
+	// and were restored to the values from the spec during the last sync where such changes were found.
+	// +optional
+	SettingsDrift *SettingsDrift `json:"settingsDrift,omitempty"`
+}
+
+// ProcessedSetting defines the setting from the spec that was processed by the operator.
+type ProcessedSetting struct {
+	// Key is the key of the setting.
+	Key string `json:"key"`
+
+	// ValueHash is the SHA-256 hash of the last applied value of the setting.
+	// Values of the secured settings and the settings from secrets are hashed with HMAC keyed on the UID of the resource.
+	// +optional
+	ValueHash string `json:"valueHash,omitempty"`
+
+	// Source shows which field of the spec the value of the setting comes from.
+	// +optional
+	// +kubebuilder:validation:Enum=Inline;ValueRef;Values;FieldValues
+	Source string `json:"source,omitempty"`
+
+	// LastAppliedTime is the time when the value of the setting was applied last time.
+	// +optional
+	LastAppliedTime *metav1.Time `json:"lastAppliedTime,omitempty"`
+
+	// Error is the error that occurred while applying the setting.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+const (
+	// SettingSourceInline means that the setting value is set in the value field.
+	SettingSourceInline = "Inline"
+
+	// SettingSourceValueRef means that the setting value is taken from a ConfigMap or a Secret.
+	SettingSourceValueRef = "ValueRef"
+
+	// SettingSourceValues means that the setting is a multi-value setting.
+	SettingSourceValues = "Values"
+
+	// SettingSourceFieldValues means that the setting is a property set setting.
+	SettingSourceFieldValues = "FieldValues"
+)
+
+// SettingsDrift defines the sonar settings that drifted from the spec and were corrected.
+type SettingsDrift struct {
+	// Keys are the keys of the drifted settings.
+	Keys []string `json:"keys"`
+
+	// CorrectionTime is the time when the drifted settings were corrected.
+	CorrectionTime metav1.Time `json:"correctionTime"`
+}
+
+// SonarNodeStatus is the health of a node of the Data Center Edition cluster.
+type SonarNodeStatus struct {
+	// Name is the name of the node.
+	Name string `json:"name"`
+
+	// Type is the type of the node, APPLICATION or SEARCH.
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Host is the host of the node.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// Port is the port of the node.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Health is the health of the node, GREEN, YELLOW or RED.
+	// +optional
+	Health string `json:"health,omitempty"`
+
+	// Causes explains why the health of the node is not GREEN.
+	// +optional
+	Causes []string `json:"causes,omitempty"`
+}
+
+// TokenStatus defines the observed state of the operator API token.
+type TokenStatus struct {
+	// Name is the name of the token in sonar.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ExpirationDate is the date when the token expires.
+	// +optional
+	ExpirationDate *metav1.Time `json:"expirationDate,omitempty"`
+}
+
+// AdminCredentialsStatus defines the observed state of the admin password rotation.
+type AdminCredentialsStatus struct {
+	// Phase is the phase of the admin password rotation.
+	// Rotating means that the password is being changed, Synced means that the desired password is applied.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// DesiredSecretVersion is the resource version of the desired Secret that is being applied or was applied.
+	// If a rotation is interrupted, the desired Secret should be restored to this version.
+	// +optional
+	DesiredSecretVersion string `json:"desiredSecretVersion,omitempty"`
+
+	// LastRotationTime is the time when the admin password was changed last time.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+}
+
+const (
+	// AdminCredentialsPhaseRotating means that the admin password is being changed.
+	AdminCredentialsPhaseRotating = "Rotating"
+
+	// AdminCredentialsPhaseSynced means that the desired admin password is applied.
+	AdminCredentialsPhaseSynced = "Synced"
+)
+
+// InstalledPlugin defines the plugin installed in sonar.
+type InstalledPlugin struct {
+	// Key is the key of the plugin.
+	Key string `json:"key"`
+
+	// Version is the installed version of the plugin.
+	// +optional
+	Version string `json:"version,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Connected",type="boolean",JSONPath=".status.connected",description="Is connected to sonar"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is ready"
+// +kubebuilder:printcolumn:name="Health",type="string",JSONPath=".status.value",description="Sonar health"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version",description="Sonar version"
+// +kubebuilder:printcolumn:name="Edition",type="string",JSONPath=".status.edition",description="Sonar edition",priority=1
+// +kubebuilder:printcolumn:name="Last Sync",type="date",JSONPath=".status.lastSyncTime",description="Last successful sync"
 
 // Sonar is the Schema for the sonars API.
 type Sonar struct {
@@ -40,10 +40,18 @@ type SonarProjectSpec struct {
 	// +optional
 	// +kubebuilder:example="develop"
 	MainBranch string `json:"mainBranch,omitempty"`
+
+	// Settings is a list of project settings.
+	// Settings removed from the list are reset to the values inherited from the global settings.
+	// +optional
+	// +kubebuilder:example={{key: "sonar.exclusions", values: {"**/vendor/**"}}, {key: "sonar.coverage.exclusions", value: "**/*_test.go"}}
+	Settings []SonarSetting `json:"settings,omitempty"`
 }
 
 // SonarProjectStatus defines the observed state of SonarProject.
 type SonarProjectStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is a status of the project.
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -55,6 +63,8 @@ type SonarProjectStatus struct {
 	// ProjectKey is the actual project key in SonarQube.
 	// +optional
 	ProjectKey string `json:"projectKey,omitempty"`
+
+	SettingsStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -63,6 +73,8 @@ type SonarProjectStatus struct {
 // +kubebuilder:printcolumn:name="Key",type="string",JSONPath=".spec.key",description="Project key"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Project status"
 // +kubebuilder:printcolumn:name="Error",type="string",JSONPath=".status.error",description="Error message"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is ready"
+// +kubebuilder:printcolumn:name="Last Sync",type="date",JSONPath=".status.lastSyncTime",description="Last successful sync"
 
 // SonarProject is the Schema for the sonarprojects API.
 type SonarProject struct {
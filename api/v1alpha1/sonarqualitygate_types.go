@@ -54,6 +54,8 @@ type Condition struct {
 
 // SonarQualityGateStatus defines the observed state of SonarQualityGate
 type SonarQualityGateStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is a status of the quality gate.
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -66,6 +68,9 @@ type SonarQualityGateStatus struct {
 // SonarQualityGate is the Schema for the sonarqualitygates API
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is ready"
+// +kubebuilder:printcolumn:name="Last Sync",type="date",JSONPath=".status.lastSyncTime",description="Last successful sync"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type SonarQualityGate struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -57,6 +57,8 @@ type Rule struct {
 
 // SonarQualityProfileStatus defines the observed state of SonarQualityProfile
 type SonarQualityProfileStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is a status of the quality profile.
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -69,6 +71,9 @@ type SonarQualityProfileStatus struct {
 // SonarQualityProfile is the Schema for the sonarqualityprofiles API
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is ready"
+// +kubebuilder:printcolumn:name="Last Sync",type="date",JSONPath=".status.lastSyncTime",description="Last successful sync"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type SonarQualityProfile struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -8,6 +8,7 @@ import (
 // SonarUserSpec defines the desired state of SonarUser
 type SonarUserSpec struct {
 	// Email is a user email.
+	// If it is not set, the email of the user in sonar is not changed.
 	// +optional
 	// +kubebuilder:validation:MaxLength=100
 	// +kubebuilder:example="myname@email.com"
@@ -53,6 +54,8 @@ type SonarUserSpec struct {
 
 // SonarUserStatus defines the observed state of SonarUser
 type SonarUserStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
 	// Value is a status of the user.
 	// +optional
 	Value string `json:"value,omitempty"`
@@ -65,8 +68,12 @@ type SonarUserStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is ready"
+// +kubebuilder:printcolumn:name="Last Sync",type="date",JSONPath=".status.lastSyncTime",description="Last successful sync"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // SonarUser is the Schema for the sonarusers API.
+// SonarUser is not supported by SonarCloud, its users are managed by the identity provider.
 type SonarUser struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
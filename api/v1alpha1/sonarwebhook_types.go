@@ -0,0 +1,98 @@
+package v1alpha1
+
+import (
+	"github.com/epam/edp-sonar-operator/api/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SonarWebhookSpec defines the desired state of SonarWebhook.
+type SonarWebhookSpec struct {
+	// Name is the name of the webhook.
+	// +required
+	// +kubebuilder:validation:MaxLength=100
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:example="ci-pipeline"
+	Name string `json:"name"`
+
+	// Url is the server endpoint that will receive the webhook payload.
+	// +required
+	// +kubebuilder:validation:MaxLength=512
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:example="https://ci.example.com/sonar/webhook"
+	Url string `json:"url"`
+
+	// Secret is a reference to the secret key that is used as the HMAC secret to sign the webhook payload.
+	// +optional
+	Secret *common.SecretKeySelector `json:"secret,omitempty"`
+
+	// ProjectKey is the key of the project the webhook belongs to.
+	// If not set, the webhook is global.
+	// +optional
+	// +kubebuilder:example="my-project"
+	ProjectKey string `json:"projectKey,omitempty"`
+
+	// SonarRef is a reference to Sonar custom resource.
+	// +required
+	SonarRef common.SonarRef `json:"sonarRef"`
+}
+
+// SonarWebhookStatus defines the observed state of SonarWebhook.
+type SonarWebhookStatus struct {
+	common.ReconcileStatus `json:",inline"`
+
+	// Value is a status of the webhook.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Error is an error message if something went wrong.
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// WebhookKey is the key of the webhook in sonar.
+	// +optional
+	WebhookKey string `json:"webhookKey,omitempty"`
+
+	// ProjectKey is the key of the project the webhook was created for.
+	// +optional
+	ProjectKey string `json:"projectKey,omitempty"`
+
+	// SecretHash is the SHA-256 hash of the webhook secret that was applied by the operator.
+	// +optional
+	SecretHash string `json:"secretHash,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Name",type="string",JSONPath=".spec.name",description="Webhook name"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.projectKey",description="Project key"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Webhook status"
+// +kubebuilder:printcolumn:name="Error",type="string",JSONPath=".status.error",description="Error message"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is ready"
+// +kubebuilder:printcolumn:name="Last Sync",type="date",JSONPath=".status.lastSyncTime",description="Last successful sync"
+
+// SonarWebhook is the Schema for the sonarwebhooks API.
+type SonarWebhook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SonarWebhookSpec   `json:"spec,omitempty"`
+	Status SonarWebhookStatus `json:"status,omitempty"`
+}
+
+func (in *SonarWebhook) GetSonarRef() common.SonarRef {
+	return in.Spec.SonarRef
+}
+
+// +kubebuilder:object:root=true
+
+// SonarWebhookList contains a list of SonarWebhook.
+type SonarWebhookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SonarWebhook `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SonarWebhook{}, &SonarWebhookList{})
+}
@@ -6,9 +6,128 @@ package v1alpha1
 
 import (
 	"github.com/epam/edp-sonar-operator/api/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdminCredentials) DeepCopyInto(out *AdminCredentials) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminCredentials.
+func (in *AdminCredentials) DeepCopy() *AdminCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(AdminCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdminCredentialsStatus) DeepCopyInto(out *AdminCredentialsStatus) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminCredentialsStatus.
+func (in *AdminCredentialsStatus) DeepCopy() *AdminCredentialsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AdminCredentialsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedNamespaces) DeepCopyInto(out *AllowedNamespaces) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedNamespaces.
+func (in *AllowedNamespaces) DeepCopy() *AllowedNamespaces {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedNamespaces)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSonar) DeepCopyInto(out *ClusterSonar) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSonar.
+func (in *ClusterSonar) DeepCopy() *ClusterSonar {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSonar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterSonar) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSonarList) DeepCopyInto(out *ClusterSonarList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterSonar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSonarList.
+func (in *ClusterSonarList) DeepCopy() *ClusterSonarList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSonarList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterSonarList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -24,6 +143,59 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstalledPlugin) DeepCopyInto(out *InstalledPlugin) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstalledPlugin.
+func (in *InstalledPlugin) DeepCopy() *InstalledPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(InstalledPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseStatus) DeepCopyInto(out *LicenseStatus) {
+	*out = *in
+	if in.ExpirationDate != nil {
+		in, out := &in.ExpirationDate, &out.ExpirationDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseStatus.
+func (in *LicenseStatus) DeepCopy() *LicenseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessedSetting) DeepCopyInto(out *ProcessedSetting) {
+	*out = *in
+	if in.LastAppliedTime != nil {
+		in, out := &in.LastAppliedTime, &out.LastAppliedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessedSetting.
+func (in *ProcessedSetting) DeepCopy() *ProcessedSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessedSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rule) DeepCopyInto(out *Rule) {
 	*out = *in
@@ -39,13 +211,61 @@ func (in *Rule) DeepCopy() *Rule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsDrift) DeepCopyInto(out *SettingsDrift) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.CorrectionTime.DeepCopyInto(&out.CorrectionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsDrift.
+func (in *SettingsDrift) DeepCopy() *SettingsDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsStatus) DeepCopyInto(out *SettingsStatus) {
+	*out = *in
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make([]ProcessedSetting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SettingsDrift != nil {
+		in, out := &in.SettingsDrift, &out.SettingsDrift
+		*out = new(SettingsDrift)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsStatus.
+func (in *SettingsStatus) DeepCopy() *SettingsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sonar) DeepCopyInto(out *Sonar) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sonar.
@@ -72,7 +292,7 @@ func (in *SonarGroup) DeepCopyInto(out *SonarGroup) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarGroup.
@@ -149,6 +369,7 @@ func (in *SonarGroupSpec) DeepCopy() *SonarGroupSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarGroupStatus) DeepCopyInto(out *SonarGroupStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarGroupStatus.
@@ -161,6 +382,42 @@ func (in *SonarGroupStatus) DeepCopy() *SonarGroupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonarHeader) DeepCopyInto(out *SonarHeader) {
+	*out = *in
+	if in.ValueRef != nil {
+		in, out := &in.ValueRef, &out.ValueRef
+		*out = new(common.SourceRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarHeader.
+func (in *SonarHeader) DeepCopy() *SonarHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(SonarHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonarLicense) DeepCopyInto(out *SonarLicense) {
+	*out = *in
+	out.SecretKeyRef = in.SecretKeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarLicense.
+func (in *SonarLicense) DeepCopy() *SonarLicense {
+	if in == nil {
+		return nil
+	}
+	out := new(SonarLicense)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarList) DeepCopyInto(out *SonarList) {
 	*out = *in
@@ -193,13 +450,33 @@ func (in *SonarList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonarNodeStatus) DeepCopyInto(out *SonarNodeStatus) {
+	*out = *in
+	if in.Causes != nil {
+		in, out := &in.Causes, &out.Causes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarNodeStatus.
+func (in *SonarNodeStatus) DeepCopy() *SonarNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SonarNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarPermissionTemplate) DeepCopyInto(out *SonarPermissionTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarPermissionTemplate.
@@ -287,6 +564,7 @@ func (in *SonarPermissionTemplateSpec) DeepCopy() *SonarPermissionTemplateSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarPermissionTemplateStatus) DeepCopyInto(out *SonarPermissionTemplateStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarPermissionTemplateStatus.
@@ -299,13 +577,28 @@ func (in *SonarPermissionTemplateStatus) DeepCopy() *SonarPermissionTemplateStat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonarPlugin) DeepCopyInto(out *SonarPlugin) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarPlugin.
+func (in *SonarPlugin) DeepCopy() *SonarPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(SonarPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarProject) DeepCopyInto(out *SonarProject) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarProject.
@@ -358,10 +651,37 @@ func (in *SonarProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonarProjectMetrics) DeepCopyInto(out *SonarProjectMetrics) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarProjectMetrics.
+func (in *SonarProjectMetrics) DeepCopy() *SonarProjectMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(SonarProjectMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarProjectSpec) DeepCopyInto(out *SonarProjectSpec) {
 	*out = *in
 	out.SonarRef = in.SonarRef
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make([]SonarSetting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarProjectSpec.
@@ -377,6 +697,8 @@ func (in *SonarProjectSpec) DeepCopy() *SonarProjectSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarProjectStatus) DeepCopyInto(out *SonarProjectStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+	in.SettingsStatus.DeepCopyInto(&out.SettingsStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarProjectStatus.
@@ -389,13 +711,28 @@ func (in *SonarProjectStatus) DeepCopy() *SonarProjectStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonarProxy) DeepCopyInto(out *SonarProxy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarProxy.
+func (in *SonarProxy) DeepCopy() *SonarProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(SonarProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarQualityGate) DeepCopyInto(out *SonarQualityGate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarQualityGate.
@@ -474,6 +811,7 @@ func (in *SonarQualityGateSpec) DeepCopy() *SonarQualityGateSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarQualityGateStatus) DeepCopyInto(out *SonarQualityGateStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarQualityGateStatus.
@@ -492,7 +830,7 @@ func (in *SonarQualityProfile) DeepCopyInto(out *SonarQualityProfile) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarQualityProfile.
@@ -571,6 +909,7 @@ func (in *SonarQualityProfileSpec) DeepCopy() *SonarQualityProfileSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarQualityProfileStatus) DeepCopyInto(out *SonarQualityProfileStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarQualityProfileStatus.
@@ -583,6 +922,36 @@ func (in *SonarQualityProfileStatus) DeepCopy() *SonarQualityProfileStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonarRequestPolicy) DeepCopyInto(out *SonarRequestPolicy) {
+	*out = *in
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.RetryWaitTime != nil {
+		in, out := &in.RetryWaitTime, &out.RetryWaitTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxRetryWaitTime != nil {
+		in, out := &in.MaxRetryWaitTime, &out.MaxRetryWaitTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarRequestPolicy.
+func (in *SonarRequestPolicy) DeepCopy() *SonarRequestPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SonarRequestPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarSetting) DeepCopyInto(out *SonarSetting) {
 	*out = *in
@@ -625,6 +994,63 @@ func (in *SonarSpec) DeepCopyInto(out *SonarSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]SonarPlugin, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdminCredentials != nil {
+		in, out := &in.AdminCredentials, &out.AdminCredentials
+		*out = new(AdminCredentials)
+		**out = **in
+	}
+	if in.TokenAuth != nil {
+		in, out := &in.TokenAuth, &out.TokenAuth
+		*out = new(TokenAuth)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(SonarTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(SonarProxy)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]SonarHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RequestPolicy != nil {
+		in, out := &in.RequestPolicy, &out.RequestPolicy
+		*out = new(SonarRequestPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectMetrics != nil {
+		in, out := &in.ProjectMetrics, &out.ProjectMetrics
+		*out = new(SonarProjectMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.License != nil {
+		in, out := &in.License, &out.License
+		*out = new(SonarLicense)
+		**out = **in
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = new(AllowedNamespaces)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarSpec.
@@ -640,6 +1066,40 @@ func (in *SonarSpec) DeepCopy() *SonarSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarStatus) DeepCopyInto(out *SonarStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+	if in.HealthCauses != nil {
+		in, out := &in.HealthCauses, &out.HealthCauses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]SonarNodeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.SettingsStatus.DeepCopyInto(&out.SettingsStatus)
+	if in.InstalledPlugins != nil {
+		in, out := &in.InstalledPlugins, &out.InstalledPlugins
+		*out = make([]InstalledPlugin, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdminCredentials != nil {
+		in, out := &in.AdminCredentials, &out.AdminCredentials
+		*out = new(AdminCredentialsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(TokenStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.License != nil {
+		in, out := &in.License, &out.License
+		*out = new(LicenseStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarStatus.
@@ -652,13 +1112,33 @@ func (in *SonarStatus) DeepCopy() *SonarStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonarTLS) DeepCopyInto(out *SonarTLS) {
+	*out = *in
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(common.SourceRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarTLS.
+func (in *SonarTLS) DeepCopy() *SonarTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(SonarTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarUser) DeepCopyInto(out *SonarUser) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarUser.
@@ -740,6 +1220,7 @@ func (in *SonarUserSpec) DeepCopy() *SonarUserSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonarUserStatus) DeepCopyInto(out *SonarUserStatus) {
 	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarUserStatus.
@@ -751,3 +1232,133 @@ func (in *SonarUserStatus) DeepCopy() *SonarUserStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonarWebhook) DeepCopyInto(out *SonarWebhook) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarWebhook.
+func (in *SonarWebhook) DeepCopy() *SonarWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(SonarWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SonarWebhook) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonarWebhookList) DeepCopyInto(out *SonarWebhookList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SonarWebhook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarWebhookList.
+func (in *SonarWebhookList) DeepCopy() *SonarWebhookList {
+	if in == nil {
+		return nil
+	}
+	out := new(SonarWebhookList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SonarWebhookList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonarWebhookSpec) DeepCopyInto(out *SonarWebhookSpec) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(common.SecretKeySelector)
+		**out = **in
+	}
+	out.SonarRef = in.SonarRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarWebhookSpec.
+func (in *SonarWebhookSpec) DeepCopy() *SonarWebhookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SonarWebhookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonarWebhookStatus) DeepCopyInto(out *SonarWebhookStatus) {
+	*out = *in
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonarWebhookStatus.
+func (in *SonarWebhookStatus) DeepCopy() *SonarWebhookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SonarWebhookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenAuth) DeepCopyInto(out *TokenAuth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenAuth.
+func (in *TokenAuth) DeepCopy() *TokenAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenStatus) DeepCopyInto(out *TokenStatus) {
+	*out = *in
+	if in.ExpirationDate != nil {
+		in, out := &in.ExpirationDate, &out.ExpirationDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenStatus.
+func (in *TokenStatus) DeepCopy() *TokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"path/filepath"
+	"time"
 
 	"github.com/epam/edp-sonar-operator/internal/controller/group"
 	"github.com/epam/edp-sonar-operator/internal/controller/permission_template"
@@ -12,11 +14,14 @@ import (
 	"github.com/epam/edp-sonar-operator/internal/controller/qualityprofile"
 	"github.com/epam/edp-sonar-operator/internal/controller/sonar"
 	sonaruser "github.com/epam/edp-sonar-operator/internal/controller/user"
+	sonarwebhook "github.com/epam/edp-sonar-operator/internal/controller/webhook"
+	webhookv1alpha1 "github.com/epam/edp-sonar-operator/internal/webhook/v1alpha1"
 
 	"os"
 
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -40,6 +45,7 @@ import (
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
 	"github.com/epam/edp-sonar-operator/pkg/helper"
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
 )
 
 var (
@@ -50,12 +56,17 @@ var (
 const (
 	sonarOperatorLock = "edp-sonar-operator-lock"
 	DefaultPort       = 9443
+
+	tracingShutdownTimeout = 10 * time.Second
 )
 
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(sonarApi.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
+
+	// sonar API metrics are served by the metrics server of the manager.
+	utilruntime.Must(sonarclient.RegisterMetrics(metrics.Registry))
 }
 
 func main() {
@@ -68,6 +79,9 @@ func main() {
 		secureMetrics                                    bool
 		enableHTTP2                                      bool
 		tlsOpts                                          []func(*tls.Config)
+		projectMetricsInterval                           time.Duration
+		tracingOpts                                      tracing.Options
+		enableWebhooks                                   bool
 	)
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
@@ -78,6 +92,9 @@ func main() {
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.BoolVar(&secureMetrics, "metrics-secure", true,
 		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, the validating webhooks of the custom resources are served. "+
+			"The webhook server requires the certificate, see --webhook-cert-path.")
 	flag.StringVar(&webhookCertPath, "webhook-cert-path", "", "The directory that contains the webhook certificate.")
 	flag.StringVar(&webhookCertName, "webhook-cert-name", "tls.crt", "The name of the webhook certificate file.")
 	flag.StringVar(&webhookCertKey, "webhook-cert-key", "tls.key", "The name of the webhook key file.")
@@ -87,6 +104,15 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.DurationVar(&projectMetricsInterval, "project-metrics-interval", 5*time.Minute,
+		"The interval of the collection of the project metrics for Sonar with the enabled projectMetrics. "+
+			"Set to 0 to disable the collection.")
+	flag.StringVar(&tracingOpts.Endpoint, "tracing-otlp-endpoint", "",
+		"The host:port of the OTLP gRPC collector to export the traces to. Tracing is disabled if it is empty.")
+	flag.BoolVar(&tracingOpts.Insecure, "tracing-insecure", false,
+		"If set, TLS is disabled for the connection to the OTLP collector.")
+	flag.Float64Var(&tracingOpts.SampleRatio, "tracing-sample-ratio", 1,
+		"The ratio of the traced reconciles, from 0 to 1.")
 
 	opts := zap.Options{
 		Development: true,
@@ -108,6 +134,12 @@ func main() {
 		"platform", v.Platform,
 	)
 
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingOpts)
+	if err != nil {
+		setupLog.Error(err, "failed to setup tracing")
+		os.Exit(1)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -200,9 +232,16 @@ func main() {
 		})
 	}
 
-	ns, err := helper.GetWatchNamespace()
+	watchNamespaces, err := helper.GetWatchNamespaces()
 	if err != nil {
-		setupLog.Error(err, "failed to get watch namespace")
+		setupLog.Error(err, "failed to get watch namespaces")
+		os.Exit(1)
+	}
+
+	// the Secrets and ConfigMaps referenced by ClusterSonar are read from the operator namespace.
+	operatorNs, err := helper.GetOperatorNamespace()
+	if err != nil {
+		setupLog.Error(err, "failed to get operator namespace")
 		os.Exit(1)
 	}
 
@@ -214,7 +253,7 @@ func main() {
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       sonarOperatorLock,
 		Cache: cache.Options{
-			DefaultNamespaces: map[string]cache.Config{ns: {}},
+			DefaultNamespaces: getCacheNamespaces(watchNamespaces, operatorNs),
 		},
 	})
 	if err != nil {
@@ -222,7 +261,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	apiClientProvider := sonarclient.NewApiClientProvider(mgr.GetClient())
+	apiClientProvider := sonarclient.NewApiClientProvider(mgr.GetClient(), operatorNs)
 
 	if err = sonar.NewReconcileSonar(
 		mgr.GetClient(),
@@ -233,6 +272,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = sonar.NewReconcileClusterSonar(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		apiClientProvider,
+		operatorNs,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "failed to setup cluster sonar reconcile")
+		os.Exit(1)
+	}
+
 	if err = sonaruser.NewSonarUserReconciler(
 		mgr.GetClient(),
 		mgr.GetScheme(),
@@ -280,6 +329,31 @@ func main() {
 		setupLog.Error(err, "failed to setup sonar project reconcile")
 		os.Exit(1)
 	}
+
+	if err = sonarwebhook.NewSonarWebhookReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		apiClientProvider,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "failed to setup sonar webhook reconcile")
+		os.Exit(1)
+	}
+
+	if err = project.NewProjectMetricsCollector(
+		mgr.GetClient(),
+		apiClientProvider,
+		projectMetricsInterval,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "failed to setup project metrics collector")
+		os.Exit(1)
+	}
+
+	if enableWebhooks {
+		if err = webhookv1alpha1.SetupWebhooksWithManager(mgr); err != nil {
+			setupLog.Error(err, "failed to setup webhooks")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if metricsCertWatcher != nil {
@@ -312,8 +386,34 @@ func main() {
 
 	setupLog.Info("starting manager")
 
-	if err = mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	err = mgr.Start(ctrl.SetupSignalHandler())
+
+	// the spans that are not exported yet are flushed before the exit.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	if shutdownErr := shutdownTracing(shutdownCtx); shutdownErr != nil {
+		setupLog.Error(shutdownErr, "failed to shutdown tracing")
+	}
+
+	cancel()
+
+	if err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
 }
+
+// getCacheNamespaces returns the namespaces of the manager cache.
+// Nil is returned to cache all namespaces if one of the watch namespaces is empty.
+func getCacheNamespaces(watchNamespaces []string, operatorNs string) map[string]cache.Config {
+	namespaces := map[string]cache.Config{operatorNs: {}}
+
+	for _, ns := range watchNamespaces {
+		if ns == cache.AllNamespaces {
+			return nil
+		}
+
+		namespaces[ns] = cache.Config{}
+	}
+
+	return namespaces
+}
@@ -7,6 +7,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
 )
 
 type SonarGroupHandler interface {
@@ -28,7 +29,11 @@ func (ch *chain) ServeRequest(ctx context.Context, s *sonarApi.SonarGroup) error
 	for i := 0; i < len(ch.handlers); i++ {
 		h := ch.handlers[i]
 
-		err := h.ServeRequest(ctx, s)
+		handlerCtx, span := tracing.StartHandler(ctx, h)
+		err := h.ServeRequest(handlerCtx, s)
+
+		tracing.End(span, err)
+
 		if err != nil {
 			return fmt.Errorf("failed to serve handler: %w", err)
 		}
@@ -7,6 +7,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 // CreateGroup is a handler for creating group.
@@ -40,6 +41,7 @@ func (c CreateGroup) ServeRequest(ctx context.Context, group *sonarApi.SonarGrou
 		}
 
 		log.Info("Group has been created")
+		events.Normal(ctx, group, events.ReasonCreated, "Group %s has been created", group.Spec.Name)
 
 		return nil
 	}
@@ -55,6 +57,7 @@ func (c CreateGroup) ServeRequest(ctx context.Context, group *sonarApi.SonarGrou
 		}
 
 		log.Info("Group has been updated")
+		events.Normal(ctx, group, events.ReasonUpdated, "Group %s has been updated", group.Spec.Name)
 	}
 
 	return nil
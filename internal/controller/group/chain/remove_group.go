@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 // RemoveGroup is a handler for removing group.
@@ -29,7 +30,11 @@ func (c RemoveGroup) ServeRequest(ctx context.Context, group *sonarApi.SonarGrou
 		if !sonar.IsErrNotFound(err) {
 			return fmt.Errorf("failed to delete group: %w", err)
 		}
+
+		return nil
 	}
 
+	events.Normal(ctx, group, events.ReasonDeleted, "Group %s has been deleted", group.Spec.Name)
+
 	return nil
 }
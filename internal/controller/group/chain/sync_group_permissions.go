@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 	"github.com/epam/edp-sonar-operator/pkg/helper"
 )
 
@@ -44,6 +45,7 @@ func (h SyncGroupPermissions) ServeRequest(ctx context.Context, group *sonarApi.
 		}
 
 		log.Info("Group permission has been removed", "permission", p)
+		events.Normal(ctx, group, events.ReasonRemoved, "Permission %s has been removed from group", p)
 	}
 
 	for g := range currentPermissions {
@@ -52,6 +54,7 @@ func (h SyncGroupPermissions) ServeRequest(ctx context.Context, group *sonarApi.
 		}
 
 		log.Info("Group permission has been added", "permission", g)
+		events.Normal(ctx, group, events.ReasonAdded, "Permission %s has been added to group", g)
 	}
 
 	log.Info("Group permissions have been synced")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/epam/edp-sonar-operator/internal/controller/group/chain"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -17,6 +18,8 @@ import (
 	"github.com/epam/edp-sonar-operator/api/common"
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
 )
 
 const (
@@ -49,7 +52,8 @@ func NewSonarGroupReconciler(
 func (r *SonarGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&sonarApi.SonarGroup{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(common.SonarGroupKind,
+			events.NewReconciler(mgr.GetEventRecorderFor(events.Component), r)))
 }
 
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=sonargroups,verbs=get;list;watch;create;update;patch;delete
@@ -76,7 +80,29 @@ func (r *SonarGroupReconciler) Reconcile(ctx context.Context, req reconcile.Requ
 
 	sonarApiClient, err := r.apiClientProvider.GetSonarApiClientFromSonarRef(ctx, req.Namespace, group)
 	if err != nil {
+		if group.GetDeletionTimestamp() != nil && sonarclient.IsErrSonarRefUnavailable(err) {
+			// the resource can't be cleaned up in sonar, so only the finalizer is removed to not block the deletion.
+			log.Info("Sonar is unavailable, removing finalizer without cleanup in sonar", "reason", err.Error())
+			events.Warning(ctx, group, events.ReasonCleanupSkipped, err)
+
+			if controllerutil.RemoveFinalizer(group, sonarOperatorFinalizer) {
+				if err = r.client.Update(ctx, group); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+
+			return ctrl.Result{}, nil
+		}
+
 		log.Error(err, "An error has occurred while getting sonar api client")
+		events.ConnectionFailed(ctx, group, err)
+
+		oldStatus := group.Status.DeepCopy()
+		group.Status.SetSonarConnected(group.Generation, err)
+
+		if err = r.updateSonarGroupStatus(ctx, group, oldStatus); err != nil {
+			return ctrl.Result{}, err
+		}
 
 		return ctrl.Result{
 			RequeueAfter: errorRequeueTime,
@@ -87,6 +113,7 @@ func (r *SonarGroupReconciler) Reconcile(ctx context.Context, req reconcile.Requ
 		if controllerutil.ContainsFinalizer(group, sonarOperatorFinalizer) {
 			if err = chain.NewRemoveGroup(sonarApiClient).ServeRequest(ctx, group); err != nil {
 				log.Error(err, "An error has occurred while deleting SonarGroup")
+				events.Warning(ctx, group, events.ReasonDeletionFailed, err)
 
 				return ctrl.Result{
 					RequeueAfter: errorRequeueTime,
@@ -110,13 +137,16 @@ func (r *SonarGroupReconciler) Reconcile(ctx context.Context, req reconcile.Requ
 		}
 	}
 
-	oldStatus := group.Status
+	oldStatus := group.Status.DeepCopy()
 
 	if err = chain.MakeChain(sonarApiClient).ServeRequest(ctx, group); err != nil {
 		log.Error(err, "An error has occurred while handling SonarGroup")
+		events.Warning(ctx, group, events.ReasonSyncFailed, err)
 
 		group.Status.Value = "error"
 		group.Status.Error = err.Error()
+		group.Status.SetSynced(group.Generation, err)
+		sonarclient.SetConnectionCondition(&group.Status.ReconcileStatus, group.Generation, err)
 
 		if err = r.updateSonarGroupStatus(ctx, group, oldStatus); err != nil {
 			return ctrl.Result{}, err
@@ -129,6 +159,8 @@ func (r *SonarGroupReconciler) Reconcile(ctx context.Context, req reconcile.Requ
 
 	group.Status.Value = common.StatusCreated
 	group.Status.Error = ""
+	group.Status.SetSynced(group.Generation, nil)
+	group.Status.SetSonarConnected(group.Generation, nil)
 
 	if err = r.updateSonarGroupStatus(ctx, group, oldStatus); err != nil {
 		return ctrl.Result{}, err
@@ -140,9 +172,9 @@ func (r *SonarGroupReconciler) Reconcile(ctx context.Context, req reconcile.Requ
 func (r *SonarGroupReconciler) updateSonarGroupStatus(
 	ctx context.Context,
 	group *sonarApi.SonarGroup,
-	oldStatus sonarApi.SonarGroupStatus,
+	oldStatus *sonarApi.SonarGroupStatus,
 ) error {
-	if group.Status == oldStatus {
+	if equality.Semantic.DeepEqual(&group.Status, oldStatus) {
 		return nil
 	}
 
@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -38,7 +39,9 @@ var _ = Describe("SonarGroup controller", func() {
 				return false
 			}
 
-			return createdSonarGroup.Status.Value == common.StatusCreated && createdSonarGroup.Status.Error == ""
+			return createdSonarGroup.Status.Value == common.StatusCreated &&
+				createdSonarGroup.Status.Error == "" &&
+				meta.IsStatusConditionTrue(createdSonarGroup.Status.Conditions, common.ConditionReady)
 		}, timeout, interval).Should(BeTrue())
 	})
 	It("Should delete SonarGroup object", func() {
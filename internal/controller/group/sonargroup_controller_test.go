@@ -0,0 +1,95 @@
+package group
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+)
+
+func TestSonarGroupReconciler_Reconcile_DeletedWithUnavailableSonar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		objects  []client.Object
+		sonarRef common.SonarRef
+	}{
+		{
+			name: "namespace is removed from allowed namespaces",
+			objects: []client.Object{
+				&sonarApi.Sonar{
+					ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "sonar"},
+					Spec: sonarApi.SonarSpec{
+						Url:               "https://sonar.example.com",
+						Secret:            "sonar-admin",
+						AllowedNamespaces: &sonarApi.AllowedNamespaces{Names: []string{"team-b"}},
+					},
+					Status: sonarApi.SonarStatus{Connected: true},
+				},
+			},
+			sonarRef: common.SonarRef{Name: "sonar", Namespace: "sonar"},
+		},
+		{
+			name:     "sonar is deleted",
+			sonarRef: common.SonarRef{Name: "sonar"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(scheme))
+			require.NoError(t, sonarApi.AddToScheme(scheme))
+
+			group := &sonarApi.SonarGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "group",
+					Namespace:         "team-a",
+					Finalizers:        []string{sonarOperatorFinalizer},
+					DeletionTimestamp: &metav1.Time{Time: metav1.Now().Time},
+				},
+				Spec: sonarApi.SonarGroupSpec{Name: "developers", SonarRef: tt.sonarRef},
+			}
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tt.objects, group)...).
+				Build()
+
+			recorder := record.NewFakeRecorder(10)
+			r := NewSonarGroupReconciler(k8sClient, scheme, sonarclient.NewApiClientProvider(k8sClient, "operators"))
+
+			result, err := r.Reconcile(
+				events.IntoContext(ctrl.LoggerInto(context.Background(), logr.Discard()), recorder),
+				ctrl.Request{NamespacedName: client.ObjectKeyFromObject(group)},
+			)
+
+			require.NoError(t, err)
+			assert.Zero(t, result.RequeueAfter)
+
+			err = k8sClient.Get(context.Background(), client.ObjectKeyFromObject(group), &sonarApi.SonarGroup{})
+			assert.True(t, k8sErrors.IsNotFound(err), "group must be deleted after the finalizer is removed")
+
+			require.Len(t, recorder.Events, 1)
+			assert.Contains(t, <-recorder.Events, "Warning "+events.ReasonCleanupSkipped)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 // CreatePermissionTemplate is a handler for creating permission template.
@@ -42,6 +43,7 @@ func (h CreatePermissionTemplate) ServeRequest(ctx context.Context, template *so
 		}
 
 		log.Info("Permission template has been created")
+		events.Normal(ctx, template, events.ReasonCreated, "Permission template %s has been created", template.Spec.Name)
 	}
 
 	if template.Spec.Description != sonarTemplate.Description || template.Spec.ProjectKeyPattern != sonarTemplate.ProjectKeyPattern {
@@ -55,6 +57,7 @@ func (h CreatePermissionTemplate) ServeRequest(ctx context.Context, template *so
 		}
 
 		log.Info("Permission template has been updated")
+		events.Normal(ctx, template, events.ReasonUpdated, "Permission template %s has been updated", template.Spec.Name)
 	}
 
 	if template.Spec.Default && template.Spec.Default != sonarTemplate.IsDefault {
@@ -65,6 +68,8 @@ func (h CreatePermissionTemplate) ServeRequest(ctx context.Context, template *so
 		}
 
 		log.Info("Default permission template has been updated")
+		events.Normal(ctx, template, events.ReasonUpdated,
+			"Permission template %s has been set as default", template.Spec.Name)
 	}
 
 	return nil
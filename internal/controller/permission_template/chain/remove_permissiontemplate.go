@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 // RemovePermissionTemplate is a handler for removing permission template.
@@ -29,9 +30,12 @@ func (c RemovePermissionTemplate) ServeRequest(ctx context.Context, template *so
 		if !sonar.IsErrNotFound(err) {
 			return fmt.Errorf("failed to delete template: %w", err)
 		}
+
+		return nil
 	}
 
 	log.Info("Permission template has been removed")
+	events.Normal(ctx, template, events.ReasonDeleted, "Permission template %s has been deleted", template.Spec.Name)
 
 	return nil
 }
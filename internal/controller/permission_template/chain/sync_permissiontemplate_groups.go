@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 	"github.com/epam/edp-sonar-operator/pkg/helper"
 )
 
@@ -56,6 +57,8 @@ func (h SyncPermissionTemplateGroups) ServeRequest(ctx context.Context, template
 				if err = h.sonarApiClient.AddGroupToPermissionTemplate(ctx, sonarTemplate.ID, groupName, p); err != nil {
 					return fmt.Errorf("failed to add permission template group: %w", err)
 				}
+
+				events.Normal(ctx, template, events.ReasonAdded, "Permission %s has been added to group %s", p, groupName)
 			}
 
 			for p := range existingPermissionsMap {
@@ -64,6 +67,8 @@ func (h SyncPermissionTemplateGroups) ServeRequest(ctx context.Context, template
 				if err = h.sonarApiClient.RemoveGroupFromPermissionTemplate(ctx, sonarTemplate.ID, groupName, p); err != nil {
 					return fmt.Errorf("failed to remove permission template group: %w", err)
 				}
+
+				events.Normal(ctx, template, events.ReasonRemoved, "Permission %s has been removed from group %s", p, groupName)
 			}
 
 			continue
@@ -75,6 +80,8 @@ func (h SyncPermissionTemplateGroups) ServeRequest(ctx context.Context, template
 			if err = h.sonarApiClient.RemoveGroupFromPermissionTemplate(ctx, sonarTemplate.ID, groupName, p); err != nil {
 				return fmt.Errorf("failed to add permission template group: %w", err)
 			}
+
+			events.Normal(ctx, template, events.ReasonRemoved, "Permission %s has been removed from group %s", p, groupName)
 		}
 	}
 
@@ -89,6 +96,8 @@ func (h SyncPermissionTemplateGroups) ServeRequest(ctx context.Context, template
 			if err = h.sonarApiClient.AddGroupToPermissionTemplate(ctx, sonarTemplate.ID, groupName, p); err != nil {
 				return fmt.Errorf("failed to add permission template group: %w", err)
 			}
+
+			events.Normal(ctx, template, events.ReasonAdded, "Permission %s has been added to group %s", p, groupName)
 		}
 	}
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/epam/edp-sonar-operator/internal/controller/permission_template/chain"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -17,6 +18,8 @@ import (
 	"github.com/epam/edp-sonar-operator/api/common"
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
 )
 
 const (
@@ -49,7 +52,8 @@ func NewSonarPermissionTemplateReconciler(
 func (r *SonarPermissionTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&sonarApi.SonarPermissionTemplate{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(common.SonarPermissionTemplateKind,
+			events.NewReconciler(mgr.GetEventRecorderFor(events.Component), r)))
 }
 
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=sonarpermissiontemplates,verbs=get;list;watch;create;update;patch;delete
@@ -76,7 +80,29 @@ func (r *SonarPermissionTemplateReconciler) Reconcile(ctx context.Context, req r
 
 	sonarApiClient, err := r.apiClientProvider.GetSonarApiClientFromSonarRef(ctx, req.Namespace, template)
 	if err != nil {
+		if template.GetDeletionTimestamp() != nil && sonarclient.IsErrSonarRefUnavailable(err) {
+			// the resource can't be cleaned up in sonar, so only the finalizer is removed to not block the deletion.
+			log.Info("Sonar is unavailable, removing finalizer without cleanup in sonar", "reason", err.Error())
+			events.Warning(ctx, template, events.ReasonCleanupSkipped, err)
+
+			if controllerutil.RemoveFinalizer(template, sonarOperatorFinalizer) {
+				if err = r.client.Update(ctx, template); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+
+			return ctrl.Result{}, nil
+		}
+
 		log.Error(err, "An error has occurred while getting sonar api client")
+		events.ConnectionFailed(ctx, template, err)
+
+		oldStatus := template.Status.DeepCopy()
+		template.Status.SetSonarConnected(template.Generation, err)
+
+		if err = r.updateSonarPermissionTemplateStatus(ctx, template, oldStatus); err != nil {
+			return ctrl.Result{}, err
+		}
 
 		return ctrl.Result{
 			RequeueAfter: errorRequeueTime,
@@ -87,6 +113,7 @@ func (r *SonarPermissionTemplateReconciler) Reconcile(ctx context.Context, req r
 		if controllerutil.ContainsFinalizer(template, sonarOperatorFinalizer) {
 			if err = chain.NewRemovePermissionTemplate(sonarApiClient).ServeRequest(ctx, template); err != nil {
 				log.Error(err, "An error has occurred while deleting SonarPermissionTemplate")
+				events.Warning(ctx, template, events.ReasonDeletionFailed, err)
 
 				return ctrl.Result{
 					RequeueAfter: errorRequeueTime,
@@ -110,13 +137,16 @@ func (r *SonarPermissionTemplateReconciler) Reconcile(ctx context.Context, req r
 		}
 	}
 
-	oldStatus := template.Status
+	oldStatus := template.Status.DeepCopy()
 
 	if err = chain.MakeChain(sonarApiClient).ServeRequest(ctx, template); err != nil {
 		log.Error(err, "An error has occurred while handling SonarPermissionTemplate")
+		events.Warning(ctx, template, events.ReasonSyncFailed, err)
 
 		template.Status.Value = "error"
 		template.Status.Error = err.Error()
+		template.Status.SetSynced(template.Generation, err)
+		sonarclient.SetConnectionCondition(&template.Status.ReconcileStatus, template.Generation, err)
 
 		if err = r.updateSonarPermissionTemplateStatus(ctx, template, oldStatus); err != nil {
 			return ctrl.Result{}, err
@@ -129,6 +159,8 @@ func (r *SonarPermissionTemplateReconciler) Reconcile(ctx context.Context, req r
 
 	template.Status.Value = common.StatusCreated
 	template.Status.Error = ""
+	template.Status.SetSynced(template.Generation, nil)
+	template.Status.SetSonarConnected(template.Generation, nil)
 
 	if err = r.updateSonarPermissionTemplateStatus(ctx, template, oldStatus); err != nil {
 		return ctrl.Result{}, err
@@ -140,9 +172,9 @@ func (r *SonarPermissionTemplateReconciler) Reconcile(ctx context.Context, req r
 func (r *SonarPermissionTemplateReconciler) updateSonarPermissionTemplateStatus(
 	ctx context.Context,
 	template *sonarApi.SonarPermissionTemplate,
-	oldStatus sonarApi.SonarPermissionTemplateStatus,
+	oldStatus *sonarApi.SonarPermissionTemplateStatus,
 ) error {
-	if template.Status == oldStatus {
+	if equality.Semantic.DeepEqual(&template.Status, oldStatus) {
 		return nil
 	}
 
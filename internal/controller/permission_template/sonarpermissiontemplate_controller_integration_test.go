@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -66,7 +67,9 @@ var _ = Describe("PermissionTemplate controller", func() {
 				return false
 			}
 
-			return createdPermissionTemplate.Status.Value == common.StatusCreated && createdPermissionTemplate.Status.Error == ""
+			return createdPermissionTemplate.Status.Value == common.StatusCreated &&
+				createdPermissionTemplate.Status.Error == "" &&
+				meta.IsStatusConditionTrue(createdPermissionTemplate.Status.Conditions, common.ConditionReady)
 		}, timeout, interval).Should(BeTrue())
 		By("Getting PermissionTemplate object")
 		permissionTemplateToDelete := &sonarApi.SonarPermissionTemplate{}
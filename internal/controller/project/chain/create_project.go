@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 type CreateProject struct {
@@ -44,6 +45,7 @@ func (h *CreateProject) ServeRequest(ctx context.Context, sonarProject *sonarApi
 		}
 
 		log.Info("Project created successfully")
+		events.Normal(ctx, sonarProject, events.ReasonCreated, "Project %s has been created", sonarProject.Spec.Key)
 
 		return nil
 	}
@@ -59,6 +61,7 @@ func (h *CreateProject) ServeRequest(ctx context.Context, sonarProject *sonarApi
 		}
 
 		log.Info("Project updated successfully")
+		events.Normal(ctx, sonarProject, events.ReasonUpdated, "Project %s has been updated", sonarProject.Spec.Key)
 	}
 
 	return nil
@@ -8,7 +8,10 @@ import (
 
 func MakeChain(sonarApiClient sonar.ClientInterface, cl client.Client) SonarProjectHandler {
 	ch := &chain{}
-	ch.Use(NewCreateProject(sonarApiClient))
+	ch.Use(
+		NewCreateProject(sonarApiClient),
+		NewUpdateSettings(sonarApiClient, cl),
+	)
 
 	return ch
 }
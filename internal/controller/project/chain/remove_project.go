@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 type RemoveProject struct {
@@ -37,6 +38,7 @@ func (h *RemoveProject) ServeRequest(ctx context.Context, sonarProject *sonarApi
 	}
 
 	log.Info("Project deleted successfully")
+	events.Normal(ctx, sonarProject, events.ReasonDeleted, "Project %s has been deleted", sonarProject.Spec.Key)
 
 	return nil
 }
@@ -0,0 +1,46 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/settings"
+)
+
+type UpdateSettings struct {
+	settingsSyncer *settings.Syncer
+}
+
+func NewUpdateSettings(sonarApiClient sonar.ClientInterface, k8sClient client.Client) SonarProjectHandler {
+	return &UpdateSettings{settingsSyncer: settings.NewSyncer(sonarApiClient, k8sClient)}
+}
+
+func (h *UpdateSettings) ServeRequest(ctx context.Context, sonarProject *sonarApi.SonarProject) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("key", sonarProject.Spec.Key)
+
+	if len(sonarProject.Spec.Settings) == 0 && len(sonarProject.Status.Settings) == 0 {
+		return nil
+	}
+
+	log.Info("Updating project settings")
+
+	if err := h.settingsSyncer.Sync(
+		ctx,
+		sonarProject,
+		sonarProject.Spec.Key,
+		sonarProject.Namespace,
+		sonarProject.Spec.Settings,
+		&sonarProject.Status.SettingsStatus,
+	); err != nil {
+		return fmt.Errorf("failed to update project settings: %w", err)
+	}
+
+	log.Info("Project settings have been updated")
+
+	return nil
+}
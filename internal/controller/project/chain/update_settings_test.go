@@ -0,0 +1,142 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar/mocks"
+)
+
+func TestUpdateSettings_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "project-settings", Namespace: "default"},
+			Data:       map[string]string{"exclusions": "**/vendor/**"},
+		}).
+		Build()
+
+	tests := []struct {
+		name         string
+		sonarProject *sonarApi.SonarProject
+		setupMocks   func(m *mocks.MockClientInterface)
+		wantErr      bool
+		errContains  string
+		wantSettings []string
+	}{
+		{
+			name: "project settings are set",
+			sonarProject: &sonarApi.SonarProject{
+				ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "default"},
+				Spec: sonarApi.SonarProjectSpec{
+					Key: "test-project",
+					Settings: []sonarApi.SonarSetting{
+						{
+							Key: "sonar.exclusions",
+							ValueRef: &common.SourceRef{
+								ConfigMapKeyRef: &common.ConfigMapKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "project-settings"},
+									Key:                  "exclusions",
+								},
+							},
+						},
+					},
+				},
+				Status: sonarApi.SonarProjectStatus{
+					SettingsStatus: sonarApi.SettingsStatus{
+						Settings: []sonarApi.ProcessedSetting{{Key: "sonar.coverage.exclusions"}},
+					},
+				},
+			},
+			setupMocks: func(m *mocks.MockClientInterface) {
+				m.On("GetSettings", mock.Anything, "test-project", []string{"sonar.exclusions"}).
+					Return([]sonar.Setting{}, nil)
+				m.On("SetSetting", mock.Anything, url.Values{
+					"key":       {"sonar.exclusions"},
+					"value":     {"**/vendor/**"},
+					"component": {"test-project"},
+				}).Return(nil)
+				m.On("ResetSettings", mock.Anything, "test-project", []string{"sonar.coverage.exclusions"}).
+					Return(nil)
+			},
+			wantErr:      false,
+			wantSettings: []string{"sonar.exclusions"},
+		},
+		{
+			name: "project has no settings",
+			sonarProject: &sonarApi.SonarProject{
+				Spec: sonarApi.SonarProjectSpec{
+					Key: "test-project",
+				},
+			},
+			setupMocks:   func(m *mocks.MockClientInterface) {},
+			wantErr:      false,
+			wantSettings: []string{},
+		},
+		{
+			name: "error setting project settings",
+			sonarProject: &sonarApi.SonarProject{
+				Spec: sonarApi.SonarProjectSpec{
+					Key: "test-project",
+					Settings: []sonarApi.SonarSetting{
+						{Key: "sonar.exclusions", Value: "**/vendor/**"},
+					},
+				},
+			},
+			setupMocks: func(m *mocks.MockClientInterface) {
+				m.On("GetSettings", mock.Anything, "test-project", []string{"sonar.exclusions"}).
+					Return(nil, errors.New("component not found"))
+			},
+			wantErr:      true,
+			errContains:  "failed to update project settings",
+			wantSettings: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockClient := mocks.NewMockClientInterface(t)
+			tt.setupMocks(mockClient)
+
+			handler := NewUpdateSettings(mockClient, k8sClient)
+
+			err := handler.ServeRequest(context.Background(), tt.sonarProject)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			keys := make([]string, 0, len(tt.sonarProject.Status.Settings))
+			for _, s := range tt.sonarProject.Status.Settings {
+				keys = append(keys, s.Key)
+			}
+
+			assert.Equal(t, tt.wantSettings, keys)
+
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
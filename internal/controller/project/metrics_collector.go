@@ -0,0 +1,234 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+)
+
+const (
+	namespaceLabel = "namespace"
+	projectLabel   = "project"
+	sonarLabel     = "sonar"
+	statusLabel    = "status"
+	metricLabel    = "metric"
+)
+
+// defaultProjectMetrics are exported if the metrics are not set in Sonar.
+var defaultProjectMetrics = []string{"coverage", "bugs", "vulnerabilities", "code_smells"}
+
+// qualityGateStatuses are the statuses reported by the quality gate status gauge.
+var qualityGateStatuses = []string{
+	sonarclient.QualityGateStatusOK,
+	sonarclient.QualityGateStatusWarn,
+	sonarclient.QualityGateStatusError,
+	sonarclient.QualityGateStatusNone,
+}
+
+var (
+	projectQualityGateStatus = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "sonar_operator",
+		Name:      "project_quality_gate_status",
+		Help:      "Quality gate status of the project, 1 for the current status and 0 for others.",
+	}, []string{namespaceLabel, projectLabel, sonarLabel, statusLabel})
+
+	projectMeasure = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "sonar_operator",
+		Name:      "project_measure",
+		Help:      "Value of the project measure, e.g. coverage or bugs.",
+	}, []string{namespaceLabel, projectLabel, sonarLabel, metricLabel})
+)
+
+type projectMetricsApiClientProvider interface {
+	GetSonarFromSonarRef(ctx context.Context, namespace string, sonarRef common.HasSonarRef) (*sonarApi.Sonar, error)
+	apiClientProvider
+}
+
+// errProjectMetricsDisabled is returned if the project metrics are not enabled in Sonar of the project.
+var errProjectMetricsDisabled = errors.New("project metrics are disabled")
+
+// ProjectMetricsCollector periodically exports the quality gate status and the measures
+// of the projects managed by SonarProject resources as Prometheus metrics.
+// The metrics are exported only for the projects of Sonar with the enabled project metrics.
+type ProjectMetricsCollector struct {
+	client            client.Client
+	apiClientProvider projectMetricsApiClientProvider
+	interval          time.Duration
+	// exported are the projects exported by the previous collection, their metrics are removed when they are gone.
+	exported map[projectMetricsKey]struct{}
+}
+
+type projectMetricsKey struct {
+	namespace string
+	project   string
+}
+
+// NewProjectMetricsCollector returns a new ProjectMetricsCollector instance.
+func NewProjectMetricsCollector(
+	k8sClient client.Client,
+	apiClientProvider projectMetricsApiClientProvider,
+	interval time.Duration,
+) *ProjectMetricsCollector {
+	return &ProjectMetricsCollector{
+		client:            k8sClient,
+		apiClientProvider: apiClientProvider,
+		interval:          interval,
+		exported:          make(map[projectMetricsKey]struct{}),
+	}
+}
+
+// SetupWithManager registers the project metrics and runs the collector with the manager.
+// The collector runs only on the leader, it is disabled if the interval is not positive.
+func (c *ProjectMetricsCollector) SetupWithManager(mgr ctrl.Manager) error {
+	if c.interval <= 0 {
+		return nil
+	}
+
+	for _, m := range []prometheus.Collector{projectQualityGateStatus, projectMeasure} {
+		if err := metrics.Registry.Register(m); err != nil {
+			var alreadyRegistered prometheus.AlreadyRegisteredError
+			if !errors.As(err, &alreadyRegistered) {
+				return fmt.Errorf("failed to register project metrics: %w", err)
+			}
+		}
+	}
+
+	if err := mgr.Add(c); err != nil {
+		return fmt.Errorf("failed to add project metrics collector: %w", err)
+	}
+
+	return nil
+}
+
+// Start collects the project metrics with the interval until the context is done.
+func (c *ProjectMetricsCollector) Start(ctx context.Context) error {
+	ctx = ctrl.LoggerInto(ctx, ctrl.Log.WithName("project-metrics"))
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.Collect(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Collect exports the metrics of all reconciled projects and removes the metrics of the deleted projects.
+// The errors are logged, so the failed projects keep the metrics of the previous collection.
+func (c *ProjectMetricsCollector) Collect(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx)
+
+	projects := &sonarApi.SonarProjectList{}
+	if err := c.client.List(ctx, projects); err != nil {
+		log.Error(err, "Failed to list SonarProjects")
+
+		return
+	}
+
+	exported := make(map[projectMetricsKey]struct{}, len(projects.Items))
+
+	for i := range projects.Items {
+		project := &projects.Items[i]
+
+		if project.Status.ProjectKey == "" || project.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		key := projectMetricsKey{namespace: project.Namespace, project: project.Status.ProjectKey}
+
+		if err := c.collectProject(ctx, project); err != nil {
+			if errors.Is(err, errProjectMetricsDisabled) {
+				continue
+			}
+
+			log.Info("Unable to collect project metrics", "project", project.Status.ProjectKey,
+				"namespace", project.Namespace, "error", err.Error())
+		}
+
+		exported[key] = struct{}{}
+	}
+
+	for key := range c.exported {
+		if _, ok := exported[key]; !ok {
+			deleteProjectMetrics(key)
+		}
+	}
+
+	c.exported = exported
+}
+
+func (c *ProjectMetricsCollector) collectProject(ctx context.Context, project *sonarApi.SonarProject) error {
+	sonar, err := c.apiClientProvider.GetSonarFromSonarRef(ctx, project.Namespace, project)
+	if err != nil {
+		return fmt.Errorf("failed to get sonar: %w", err)
+	}
+
+	if sonar.Spec.ProjectMetrics == nil {
+		return errProjectMetricsDisabled
+	}
+
+	metricKeys := sonar.Spec.ProjectMetrics.Metrics
+	if len(metricKeys) == 0 {
+		metricKeys = defaultProjectMetrics
+	}
+
+	sonarApiClient, err := c.apiClientProvider.GetSonarApiClientFromSonarRef(ctx, project.Namespace, project)
+	if err != nil {
+		return fmt.Errorf("failed to get sonar api client: %w", err)
+	}
+
+	projectKey := project.Status.ProjectKey
+
+	status, err := sonarApiClient.GetProjectQualityGateStatus(ctx, projectKey)
+	if err != nil {
+		return err
+	}
+
+	measures, err := sonarApiClient.GetProjectMeasures(ctx, projectKey, metricKeys)
+	if err != nil {
+		return err
+	}
+
+	key := projectMetricsKey{namespace: project.Namespace, project: projectKey}
+	instance := sonarclient.InstanceName(sonar)
+
+	// the previous values are removed, because the sonar or the metric list of the project may be changed.
+	deleteProjectMetrics(key)
+
+	for _, s := range qualityGateStatuses {
+		value := 0.0
+		if s == status {
+			value = 1
+		}
+
+		projectQualityGateStatus.WithLabelValues(key.namespace, key.project, instance, s).Set(value)
+	}
+
+	for metric, value := range measures {
+		projectMeasure.WithLabelValues(key.namespace, key.project, instance, metric).Set(value)
+	}
+
+	return nil
+}
+
+func deleteProjectMetrics(key projectMetricsKey) {
+	labels := prometheus.Labels{namespaceLabel: key.namespace, projectLabel: key.project}
+
+	projectQualityGateStatus.DeletePartialMatch(labels)
+	projectMeasure.DeletePartialMatch(labels)
+}
@@ -0,0 +1,142 @@
+package project
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+)
+
+func TestProjectMetricsCollector_Collect(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/qualitygates/project_status":
+			_, _ = w.Write([]byte(`{"projectStatus":{"status":"WARN"}}`))
+		case "/api/measures/component":
+			assert.Equal(t, "coverage,bugs", r.URL.Query().Get("metricKeys"))
+			_, _ = w.Write([]byte(`{"component":{"measures":[` +
+				`{"metric":"coverage","value":"75.5"},{"metric":"bugs","value":"2"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	const ns = "metrics-collector"
+
+	sonarSpec := sonarApi.SonarSpec{
+		Url:           server.URL,
+		Secret:        "sonar-admin",
+		RequestPolicy: &sonarApi.SonarRequestPolicy{MaxRetries: ptr.To(0)},
+	}
+
+	project := &sonarApi.SonarProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: ns},
+		Spec:       sonarApi.SonarProjectSpec{SonarRef: common.SonarRef{Name: "sonar"}},
+		Status:     sonarApi.SonarProjectStatus{ProjectKey: "project-key"},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&sonarApi.Sonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: ns, UID: "sonar-uid"},
+			Spec: func() sonarApi.SonarSpec {
+				spec := sonarSpec
+				spec.ProjectMetrics = &sonarApi.SonarProjectMetrics{Metrics: []string{"coverage", "bugs"}}
+
+				return spec
+			}(),
+			Status: sonarApi.SonarStatus{Connected: true},
+		},
+		&sonarApi.Sonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "disabled", Namespace: ns, UID: "disabled-uid"},
+			Spec:       sonarSpec,
+			Status:     sonarApi.SonarStatus{Connected: true},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar-admin", Namespace: ns},
+			Data:       map[string][]byte{"user": []byte("admin"), "password": []byte("password")},
+		},
+		project,
+		&sonarApi.SonarProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "disabled", Namespace: ns},
+			Spec:       sonarApi.SonarProjectSpec{SonarRef: common.SonarRef{Name: "disabled"}},
+			Status:     sonarApi.SonarProjectStatus{ProjectKey: "disabled-key"},
+		},
+		&sonarApi.SonarProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "not-reconciled", Namespace: ns},
+			Spec:       sonarApi.SonarProjectSpec{SonarRef: common.SonarRef{Name: "sonar"}},
+		},
+	).Build()
+
+	ctx := context.Background()
+	collector := NewProjectMetricsCollector(k8sClient, sonarclient.NewApiClientProvider(k8sClient, "operators"), 0)
+
+	collector.Collect(ctx)
+
+	instance := ns + "/sonar"
+
+	assert.InDelta(t, 1, testutil.ToFloat64(
+		projectQualityGateStatus.WithLabelValues(ns, "project-key", instance, sonarclient.QualityGateStatusWarn)), 0)
+	assert.InDelta(t, 0, testutil.ToFloat64(
+		projectQualityGateStatus.WithLabelValues(ns, "project-key", instance, sonarclient.QualityGateStatusOK)), 0)
+	assert.InDelta(t, 75.5, testutil.ToFloat64(projectMeasure.WithLabelValues(ns, "project-key", instance, "coverage")), 0)
+	assert.InDelta(t, 2, testutil.ToFloat64(projectMeasure.WithLabelValues(ns, "project-key", instance, "bugs")), 0)
+
+	assert.Equal(t, 4, countProjectSeries(t, projectQualityGateStatus, ns), "only enabled projects are exported")
+	assert.Equal(t, 2, countProjectSeries(t, projectMeasure, ns))
+
+	require.NoError(t, k8sClient.Delete(ctx, project))
+
+	collector.Collect(ctx)
+
+	assert.Zero(t, countProjectSeries(t, projectQualityGateStatus, ns), "metrics of deleted project must be removed")
+	assert.Zero(t, countProjectSeries(t, projectMeasure, ns))
+}
+
+// countProjectSeries returns the number of series of the collector with the given namespace label.
+func countProjectSeries(t *testing.T, c prometheus.Collector, ns string) int {
+	t.Helper()
+
+	ch := make(chan prometheus.Metric)
+
+	go func() {
+		c.Collect(ch)
+		close(ch)
+	}()
+
+	count := 0
+
+	for m := range ch {
+		pb := &dto.Metric{}
+		require.NoError(t, m.Write(pb))
+
+		for _, l := range pb.GetLabel() {
+			if l.GetName() == namespaceLabel && l.GetValue() == ns {
+				count++
+			}
+		}
+	}
+
+	return count
+}
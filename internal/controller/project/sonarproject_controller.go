@@ -17,7 +17,9 @@ import (
 	"github.com/epam/edp-sonar-operator/api/common"
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 	"github.com/epam/edp-sonar-operator/pkg/helper"
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
 )
 
 const (
@@ -48,6 +50,7 @@ func NewSonarProjectReconciler(
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=sonarprojects/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=sonarprojects/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -68,7 +71,29 @@ func (r *SonarProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	sonarApiClient, err := r.apiClientProvider.GetSonarApiClientFromSonarRef(ctx, req.Namespace, project)
 	if err != nil {
+		if project.GetDeletionTimestamp() != nil && sonarclient.IsErrSonarRefUnavailable(err) {
+			// the resource can't be cleaned up in sonar, so only the finalizer is removed to not block the deletion.
+			log.Info("Sonar is unavailable, removing finalizer without cleanup in sonar", "reason", err.Error())
+			events.Warning(ctx, project, events.ReasonCleanupSkipped, err)
+
+			if controllerutil.RemoveFinalizer(project, helper.FinalizerName) {
+				if err = r.client.Update(ctx, project); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+
+			return ctrl.Result{}, nil
+		}
+
 		log.Error(err, "An error has occurred while getting sonar api client")
+		events.ConnectionFailed(ctx, project, err)
+
+		oldStatus := project.Status.DeepCopy()
+		project.Status.SetSonarConnected(project.Generation, err)
+
+		if err = r.updateSonarProjectStatus(ctx, project, oldStatus); err != nil {
+			return ctrl.Result{}, err
+		}
 
 		return ctrl.Result{
 			RequeueAfter: errorRequeueTime,
@@ -79,6 +104,7 @@ func (r *SonarProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		if controllerutil.ContainsFinalizer(project, helper.FinalizerName) {
 			if err = chain.NewRemoveProject(sonarApiClient).ServeRequest(ctx, project); err != nil {
 				log.Error(err, "An error has occurred while deleting SonarProject")
+				events.Warning(ctx, project, events.ReasonDeletionFailed, err)
 
 				return ctrl.Result{
 					RequeueAfter: errorRequeueTime,
@@ -106,9 +132,12 @@ func (r *SonarProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	if err = chain.MakeChain(sonarApiClient, r.client).ServeRequest(ctx, project); err != nil {
 		log.Error(err, "An error has occurred while handling SonarProject")
+		events.Warning(ctx, project, events.ReasonSyncFailed, err)
 
 		project.Status.Value = "error"
 		project.Status.Error = err.Error()
+		project.Status.SetSynced(project.Generation, err)
+		sonarclient.SetConnectionCondition(&project.Status.ReconcileStatus, project.Generation, err)
 
 		if err = r.updateSonarProjectStatus(ctx, project, oldStatus); err != nil {
 			return ctrl.Result{}, err
@@ -121,6 +150,8 @@ func (r *SonarProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	project.Status.Value = common.StatusCreated
 	project.Status.Error = ""
+	project.Status.SetSynced(project.Generation, nil)
+	project.Status.SetSonarConnected(project.Generation, nil)
 	project.Status.ProjectKey = project.Spec.Key
 
 	if err = r.updateSonarProjectStatus(ctx, project, oldStatus); err != nil {
@@ -134,7 +165,8 @@ func (r *SonarProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 func (r *SonarProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&sonarApi.SonarProject{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(common.SonarProjectKind,
+			events.NewReconciler(mgr.GetEventRecorderFor(events.Component), r)))
 }
 
 func (r *SonarProjectReconciler) updateSonarProjectStatus(ctx context.Context, sonarProject *sonarApi.SonarProject, oldStatus *sonarApi.SonarProjectStatus) error {
@@ -3,6 +3,7 @@ package project
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -37,7 +38,9 @@ var _ = Describe("SonarProject controller", func() {
 				return false
 			}
 
-			return createdSonarProject.Status.Value == common.StatusCreated && createdSonarProject.Status.Error == ""
+			return createdSonarProject.Status.Value == common.StatusCreated &&
+				createdSonarProject.Status.Error == "" &&
+				meta.IsStatusConditionTrue(createdSonarProject.Status.Conditions, common.ConditionReady)
 		}, timeout, interval).Should(BeTrue())
 	})
 	It("Should delete SonarProject object", func() {
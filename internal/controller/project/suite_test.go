@@ -99,7 +99,7 @@ var _ = BeforeSuite(func() {
 	err = sonar.NewReconcileSonar(
 		k8sManager.GetClient(),
 		k8sManager.GetScheme(),
-		sonarclient.NewApiClientProvider(k8sManager.GetClient()),
+		sonarclient.NewApiClientProvider(k8sManager.GetClient(), namespace),
 	).
 		SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
@@ -107,7 +107,7 @@ var _ = BeforeSuite(func() {
 	err = NewSonarProjectReconciler(
 		k8sManager.GetClient(),
 		k8sManager.GetScheme(),
-		sonarclient.NewApiClientProvider(k8sManager.GetClient()),
+		sonarclient.NewApiClientProvider(k8sManager.GetClient(), namespace),
 	).
 		SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 // CreateQualityGate is a handler for creating quality gate.
@@ -38,6 +39,7 @@ func (h CreateQualityGate) ServeRequest(ctx context.Context, gate *sonarApi.Sona
 		}
 
 		log.Info("Quality gate has been created")
+		events.Normal(ctx, gate, events.ReasonCreated, "Quality gate %s has been created", gate.Spec.Name)
 	}
 
 	if gate.Spec.Default && gate.Spec.Default != sonarGate.IsDefault {
@@ -48,6 +50,7 @@ func (h CreateQualityGate) ServeRequest(ctx context.Context, gate *sonarApi.Sona
 		}
 
 		log.Info("Default quality gate has been updated")
+		events.Normal(ctx, gate, events.ReasonUpdated, "Quality gate %s has been set as default", gate.Spec.Name)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 // RemoveQualityGate is a handler for removing quality gate.
@@ -29,9 +30,12 @@ func (r RemoveQualityGate) ServeRequest(ctx context.Context, gate *sonarApi.Sona
 		if !sonar.IsErrNotFound(err) {
 			return fmt.Errorf("failed to delete quality gate: %w", err)
 		}
+
+		return nil
 	}
 
 	log.Info("Quality gate has been removed")
+	events.Normal(ctx, gate, events.ReasonDeleted, "Quality gate %s has been deleted", gate.Spec.Name)
 
 	return nil
 }
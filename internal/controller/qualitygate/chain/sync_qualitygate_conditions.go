@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 // SyncQualityGateConditions is a handler for syncing quality gate conditions.
@@ -49,6 +50,8 @@ func (h SyncQualityGateConditions) ServeRequest(ctx context.Context, gate *sonar
 				return fmt.Errorf("failed to create quality gate condition: %w", err)
 			}
 
+			events.Normal(ctx, gate, events.ReasonCreated, "Condition on metric %s has been created", metric)
+
 			continue
 		}
 
@@ -61,6 +64,8 @@ func (h SyncQualityGateConditions) ServeRequest(ctx context.Context, gate *sonar
 			if err = h.sonarApiClient.UpdateQualityGateCondition(ctx, existingCond); err != nil {
 				return fmt.Errorf("failed to update quality gate condition: %w", err)
 			}
+
+			events.Normal(ctx, gate, events.ReasonUpdated, "Condition on metric %s has been updated", metric)
 		}
 
 		delete(existingCondMap, metric)
@@ -72,6 +77,8 @@ func (h SyncQualityGateConditions) ServeRequest(ctx context.Context, gate *sonar
 		if err = h.sonarApiClient.DeleteQualityGateCondition(ctx, cond.ID); err != nil {
 			return fmt.Errorf("failed to delete quality gate condition: %w", err)
 		}
+
+		events.Normal(ctx, gate, events.ReasonDeleted, "Condition on metric %s has been deleted", cond.Metric)
 	}
 
 	log.Info("Quality gate conditions have been synced")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/epam/edp-sonar-operator/internal/controller/qualitygate/chain"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -16,6 +17,8 @@ import (
 	"github.com/epam/edp-sonar-operator/api/common"
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
 )
 
 const (
@@ -66,7 +69,29 @@ func (r *SonarQualityGateReconciler) Reconcile(ctx context.Context, req ctrl.Req
 
 	sonarApiClient, err := r.apiClientProvider.GetSonarApiClientFromSonarRef(ctx, req.Namespace, gate)
 	if err != nil {
+		if gate.GetDeletionTimestamp() != nil && sonarclient.IsErrSonarRefUnavailable(err) {
+			// the resource can't be cleaned up in sonar, so only the finalizer is removed to not block the deletion.
+			log.Info("Sonar is unavailable, removing finalizer without cleanup in sonar", "reason", err.Error())
+			events.Warning(ctx, gate, events.ReasonCleanupSkipped, err)
+
+			if controllerutil.RemoveFinalizer(gate, sonarOperatorFinalizer) {
+				if err = r.client.Update(ctx, gate); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+
+			return ctrl.Result{}, nil
+		}
+
 		log.Error(err, "An error has occurred while getting sonar api client")
+		events.ConnectionFailed(ctx, gate, err)
+
+		oldStatus := gate.Status.DeepCopy()
+		gate.Status.SetSonarConnected(gate.Generation, err)
+
+		if err = r.updateSonarQualityGateStatus(ctx, gate, oldStatus); err != nil {
+			return ctrl.Result{}, err
+		}
 
 		return ctrl.Result{
 			RequeueAfter: errorRequeueTime,
@@ -77,6 +102,7 @@ func (r *SonarQualityGateReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		if controllerutil.ContainsFinalizer(gate, sonarOperatorFinalizer) {
 			if err = chain.NewRemoveQualityGate(sonarApiClient).ServeRequest(ctx, gate); err != nil {
 				log.Error(err, "An error has occurred while deleting QualityGate")
+				events.Warning(ctx, gate, events.ReasonDeletionFailed, err)
 
 				return ctrl.Result{
 					RequeueAfter: errorRequeueTime,
@@ -100,13 +126,16 @@ func (r *SonarQualityGateReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		}
 	}
 
-	oldStatus := gate.Status
+	oldStatus := gate.Status.DeepCopy()
 
 	if err = chain.MakeChain(sonarApiClient).ServeRequest(ctx, gate); err != nil {
 		log.Error(err, "An error has occurred while handling SonarQualityGate")
+		events.Warning(ctx, gate, events.ReasonSyncFailed, err)
 
 		gate.Status.Value = "error"
 		gate.Status.Error = err.Error()
+		gate.Status.SetSynced(gate.Generation, err)
+		sonarclient.SetConnectionCondition(&gate.Status.ReconcileStatus, gate.Generation, err)
 
 		if err = r.updateSonarQualityGateStatus(ctx, gate, oldStatus); err != nil {
 			return ctrl.Result{}, err
@@ -119,6 +148,8 @@ func (r *SonarQualityGateReconciler) Reconcile(ctx context.Context, req ctrl.Req
 
 	gate.Status.Value = common.StatusCreated
 	gate.Status.Error = ""
+	gate.Status.SetSynced(gate.Generation, nil)
+	gate.Status.SetSonarConnected(gate.Generation, nil)
 
 	if err = r.updateSonarQualityGateStatus(ctx, gate, oldStatus); err != nil {
 		return ctrl.Result{}, err
@@ -131,15 +162,16 @@ func (r *SonarQualityGateReconciler) Reconcile(ctx context.Context, req ctrl.Req
 func (r *SonarQualityGateReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&sonarApi.SonarQualityGate{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(common.SonarQualityGateKind,
+			events.NewReconciler(mgr.GetEventRecorderFor(events.Component), r)))
 }
 
 func (r *SonarQualityGateReconciler) updateSonarQualityGateStatus(
 	ctx context.Context,
 	gate *sonarApi.SonarQualityGate,
-	oldStatus sonarApi.SonarQualityGateStatus,
+	oldStatus *sonarApi.SonarQualityGateStatus,
 ) error {
-	if gate.Status == oldStatus {
+	if equality.Semantic.DeepEqual(&gate.Status, oldStatus) {
 		return nil
 	}
 
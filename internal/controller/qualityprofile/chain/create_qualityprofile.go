@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 // CreateQualityProfile is a handler for creating quality profile.
@@ -38,6 +39,7 @@ func (h CreateQualityProfile) ServeRequest(ctx context.Context, profile *sonarAp
 		}
 
 		log.Info("Quality profile has been created")
+		events.Normal(ctx, profile, events.ReasonCreated, "Quality profile %s has been created", profile.Spec.Name)
 	}
 
 	if profile.Spec.Default && profile.Spec.Default != sonarProfile.IsDefault {
@@ -48,6 +50,7 @@ func (h CreateQualityProfile) ServeRequest(ctx context.Context, profile *sonarAp
 		}
 
 		log.Info("Default quality profile has been updated")
+		events.Normal(ctx, profile, events.ReasonUpdated, "Quality profile %s has been set as default", profile.Spec.Name)
 	}
 
 	return nil
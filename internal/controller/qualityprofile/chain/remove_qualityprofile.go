@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 // RemoveQualityProfile is a handler for removing quality profile.
@@ -29,9 +30,12 @@ func (r RemoveQualityProfile) ServeRequest(ctx context.Context, profile *sonarAp
 		if !sonar.IsErrNotFound(err) {
 			return fmt.Errorf("failed to delete quality profile: %w", err)
 		}
+
+		return nil
 	}
 
 	log.Info("Quality profile has been removed")
+	events.Normal(ctx, profile, events.ReasonDeleted, "Quality profile %s has been deleted", profile.Spec.Name)
 
 	return nil
 }
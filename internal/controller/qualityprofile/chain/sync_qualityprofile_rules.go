@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 // SyncQualityProfileRules is a handler for syncing quality profile rules.
@@ -57,6 +58,8 @@ func (h SyncQualityProfileRules) ServeRequest(ctx context.Context, profile *sona
 		); err != nil {
 			return fmt.Errorf("failed to acticate rule: %w", err)
 		}
+
+		events.Normal(ctx, profile, events.ReasonActivated, "Rule %s has been activated", ruleKey)
 	}
 
 	for ruleKey := range existingRulesMap {
@@ -65,6 +68,8 @@ func (h SyncQualityProfileRules) ServeRequest(ctx context.Context, profile *sona
 		if err = h.sonarApiClient.DeactivateQualityProfileRule(ctx, sonarProfile.Key, ruleKey); err != nil {
 			return fmt.Errorf("failed to deactivate quality profile rule: %w", err)
 		}
+
+		events.Normal(ctx, profile, events.ReasonDeactivated, "Rule %s has been deactivated", ruleKey)
 	}
 
 	log.Info("Quality profile rules have been synced")
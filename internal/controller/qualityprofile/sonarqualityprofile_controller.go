@@ -7,6 +7,7 @@ import (
 
 	"github.com/epam/edp-sonar-operator/internal/controller/qualityprofile/chain"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -16,6 +17,8 @@ import (
 	"github.com/epam/edp-sonar-operator/api/common"
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
 )
 
 const (
@@ -66,7 +69,29 @@ func (r *SonarQualityProfileReconciler) Reconcile(ctx context.Context, req ctrl.
 
 	sonarApiClient, err := r.apiClientProvider.GetSonarApiClientFromSonarRef(ctx, req.Namespace, profile)
 	if err != nil {
+		if profile.GetDeletionTimestamp() != nil && sonarclient.IsErrSonarRefUnavailable(err) {
+			// the resource can't be cleaned up in sonar, so only the finalizer is removed to not block the deletion.
+			log.Info("Sonar is unavailable, removing finalizer without cleanup in sonar", "reason", err.Error())
+			events.Warning(ctx, profile, events.ReasonCleanupSkipped, err)
+
+			if controllerutil.RemoveFinalizer(profile, sonarOperatorFinalizer) {
+				if err = r.client.Update(ctx, profile); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+
+			return ctrl.Result{}, nil
+		}
+
 		log.Error(err, "An error has occurred while getting sonar api client")
+		events.ConnectionFailed(ctx, profile, err)
+
+		oldStatus := profile.Status.DeepCopy()
+		profile.Status.SetSonarConnected(profile.Generation, err)
+
+		if err = r.updateSonarQualityProfileStatus(ctx, profile, oldStatus); err != nil {
+			return ctrl.Result{}, err
+		}
 
 		return ctrl.Result{
 			RequeueAfter: errorRequeueTime,
@@ -77,6 +102,7 @@ func (r *SonarQualityProfileReconciler) Reconcile(ctx context.Context, req ctrl.
 		if controllerutil.ContainsFinalizer(profile, sonarOperatorFinalizer) {
 			if err = chain.NewRemoveQualityProfile(sonarApiClient).ServeRequest(ctx, profile); err != nil {
 				log.Error(err, "An error has occurred while deleting QualityProfile")
+				events.Warning(ctx, profile, events.ReasonDeletionFailed, err)
 
 				return ctrl.Result{
 					RequeueAfter: errorRequeueTime,
@@ -100,13 +126,16 @@ func (r *SonarQualityProfileReconciler) Reconcile(ctx context.Context, req ctrl.
 		}
 	}
 
-	oldStatus := profile.Status
+	oldStatus := profile.Status.DeepCopy()
 
 	if err = chain.MakeChain(sonarApiClient).ServeRequest(ctx, profile); err != nil {
 		log.Error(err, "An error has occurred while handling SonarQualityProfile")
+		events.Warning(ctx, profile, events.ReasonSyncFailed, err)
 
 		profile.Status.Value = "error"
 		profile.Status.Error = err.Error()
+		profile.Status.SetSynced(profile.Generation, err)
+		sonarclient.SetConnectionCondition(&profile.Status.ReconcileStatus, profile.Generation, err)
 
 		if err = r.updateSonarQualityProfileStatus(ctx, profile, oldStatus); err != nil {
 			return ctrl.Result{}, err
@@ -119,6 +148,8 @@ func (r *SonarQualityProfileReconciler) Reconcile(ctx context.Context, req ctrl.
 
 	profile.Status.Value = common.StatusCreated
 	profile.Status.Error = ""
+	profile.Status.SetSynced(profile.Generation, nil)
+	profile.Status.SetSonarConnected(profile.Generation, nil)
 
 	if err = r.updateSonarQualityProfileStatus(ctx, profile, oldStatus); err != nil {
 		return ctrl.Result{}, err
@@ -131,15 +162,16 @@ func (r *SonarQualityProfileReconciler) Reconcile(ctx context.Context, req ctrl.
 func (r *SonarQualityProfileReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&sonarApi.SonarQualityProfile{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(common.SonarQualityProfileKind,
+			events.NewReconciler(mgr.GetEventRecorderFor(events.Component), r)))
 }
 
 func (r *SonarQualityProfileReconciler) updateSonarQualityProfileStatus(
 	ctx context.Context,
 	profile *sonarApi.SonarQualityProfile,
-	oldStatus sonarApi.SonarQualityProfileStatus,
+	oldStatus *sonarApi.SonarQualityProfileStatus,
 ) error {
-	if profile.Status == oldStatus {
+	if equality.Semantic.DeepEqual(&profile.Status, oldStatus) {
 		return nil
 	}
 
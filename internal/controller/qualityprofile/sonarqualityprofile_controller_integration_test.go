@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -47,7 +48,9 @@ var _ = Describe("SonarQualityProfile controller", func() {
 				return false
 			}
 
-			return createdSonarQualityprofile.Status.Value == common.StatusCreated && createdSonarQualityprofile.Status.Error == ""
+			return createdSonarQualityprofile.Status.Value == common.StatusCreated &&
+				createdSonarQualityprofile.Status.Error == "" &&
+				meta.IsStatusConditionTrue(createdSonarQualityprofile.Status.Conditions, common.ConditionReady)
 		}, timeout, interval).Should(BeTrue())
 	})
 	It("Should update SonarQualityProfile object", func() {
@@ -96,7 +96,7 @@ var _ = BeforeSuite(func() {
 	err = sonar.NewReconcileSonar(
 		k8sManager.GetClient(),
 		k8sManager.GetScheme(),
-		sonarclient.NewApiClientProvider(k8sManager.GetClient()),
+		sonarclient.NewApiClientProvider(k8sManager.GetClient(), namespace),
 	).
 		SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
@@ -104,7 +104,7 @@ var _ = BeforeSuite(func() {
 	err = NewSonarQualityProfileReconciler(
 		k8sManager.GetClient(),
 		k8sManager.GetScheme(),
-		sonarclient.NewApiClientProvider(k8sManager.GetClient()),
+		sonarclient.NewApiClientProvider(k8sManager.GetClient(), namespace),
 	).
 		SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
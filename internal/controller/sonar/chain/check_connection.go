@@ -2,6 +2,7 @@ package chain
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -10,11 +11,17 @@ import (
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
 )
 
+type checkConnectionApiClient interface {
+	sonar.System
+	sonar.OrganizationClient
+	IsAuthenticated(ctx context.Context) (bool, error)
+}
+
 type CheckConnection struct {
-	sonarApiClient sonar.System
+	sonarApiClient checkConnectionApiClient
 }
 
-func NewCheckConnection(sonarApiClient sonar.System) *CheckConnection {
+func NewCheckConnection(sonarApiClient checkConnectionApiClient) *CheckConnection {
 	return &CheckConnection{sonarApiClient: sonarApiClient}
 }
 
@@ -22,15 +29,106 @@ func (h *CheckConnection) ServeRequest(ctx context.Context, sonarCR *sonarApi.So
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Start checking connection to sonar")
 
+	if sonarCR.Spec.IsSonarCloud() {
+		return h.checkSonarCloud(ctx, sonarCR)
+	}
+
 	systemHealth, err := h.sonarApiClient.Health(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get health: %w", err)
+		return setDisconnected(sonarCR, fmt.Errorf("failed to get health: %w", err))
+	}
+
+	sonarCR.Status.Connected = true
+	sonarCR.Status.SetSonarConnected(sonarCR.Generation, nil)
+	setHealthStatus(&sonarCR.Status, systemHealth)
+	sonar.SetInstanceHealth(sonar.InstanceName(sonarCR), true, systemHealth.Health)
+
+	if systemHealth.Health != "GREEN" {
+		log.Info("Sonar needs attention", "health", systemHealth.Health, "causes", sonarCR.Status.HealthCauses)
+	}
+
+	systemStatus, err := h.sonarApiClient.SystemStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get system status: %w", err)
+	}
+
+	sonarCR.Status.Version = systemStatus.Version
+	sonarCR.Status.ServerID = systemStatus.ID
+
+	edition, err := h.sonarApiClient.Edition(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sonar edition: %w", err)
+	}
+
+	sonarCR.Status.Edition = edition
+
+	log.Info("Connection to sonar is established", "version", systemStatus.Version, "edition", edition)
+
+	return nil
+}
+
+// checkSonarCloud checks the credentials and the organization because SonarCloud has no system API.
+func (h *CheckConnection) checkSonarCloud(ctx context.Context, sonarCR *sonarApi.Sonar) error {
+	authenticated, err := h.sonarApiClient.IsAuthenticated(ctx)
+	if err != nil {
+		return setDisconnected(sonarCR, err)
+	}
+
+	if !authenticated {
+		return setDisconnected(sonarCR, errors.New("sonar credentials are not valid"))
+	}
+
+	if _, err = h.sonarApiClient.GetOrganization(ctx, sonarCR.Spec.Organization); err != nil {
+		return setDisconnected(sonarCR, err)
 	}
 
 	sonarCR.Status.Connected = true
-	sonarCR.Status.Value = systemHealth.Health
+	sonarCR.Status.SetSonarConnected(sonarCR.Generation, nil)
+	setHealthStatus(&sonarCR.Status, &sonar.SystemHealth{})
+	sonar.SetInstanceHealth(sonar.InstanceName(sonarCR), true, "")
+	sonarCR.Status.Version = ""
+	sonarCR.Status.Edition = ""
+	sonarCR.Status.ServerID = ""
 
-	log.Info("Connection to sonar is established")
+	ctrl.LoggerFrom(ctx).Info("Connection to SonarCloud is established", "organization", sonarCR.Spec.Organization)
 
 	return nil
 }
+
+func setDisconnected(sonarCR *sonarApi.Sonar, err error) error {
+	sonarCR.Status.Connected = false
+	sonarCR.Status.SetSonarConnected(sonarCR.Generation, err)
+	sonar.SetInstanceHealth(sonar.InstanceName(sonarCR), false, "")
+
+	return err
+}
+
+func setHealthStatus(status *sonarApi.SonarStatus, health *sonar.SystemHealth) {
+	status.Value = health.Health
+	status.HealthCauses = healthCauseMessages(health.Causes)
+	status.Nodes = nil
+
+	for _, n := range health.Nodes {
+		status.Nodes = append(status.Nodes, sonarApi.SonarNodeStatus{
+			Name:   n.Name,
+			Type:   n.Type,
+			Host:   n.Host,
+			Port:   n.Port,
+			Health: n.Health,
+			Causes: healthCauseMessages(n.Causes),
+		})
+	}
+}
+
+func healthCauseMessages(causes []sonar.HealthCause) []string {
+	if len(causes) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(causes))
+	for _, c := range causes {
+		messages = append(messages, c.Message)
+	}
+
+	return messages
+}
@@ -3,12 +3,15 @@ package chain
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/epam/edp-sonar-operator/api/common"
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar/mocks"
@@ -19,17 +22,23 @@ func TestCheckConnection_ServeRequest(t *testing.T) {
 
 	tests := []struct {
 		name           string
-		sonarApiClient func(t *testing.T) sonar.System
+		sonarApiClient func(t *testing.T) checkConnectionApiClient
 		sonar          *sonarApi.Sonar
 		wantErr        require.ErrorAssertionFunc
+		wantValue      string
+		wantConnected  bool
 		wantStatus     sonarApi.SonarStatus
 	}{
 		{
 			name: "connection is established",
-			sonarApiClient: func(t *testing.T) sonar.System {
+			sonarApiClient: func(t *testing.T) checkConnectionApiClient {
 				m := mocks.NewMockClientInterface(t)
 				m.On("Health", mock.Anything).
 					Return(&sonar.SystemHealth{Health: "GREEN"}, nil)
+				m.On("SystemStatus", mock.Anything).
+					Return(&sonar.SystemStatusResponse{ID: "server-id", Version: "10.5.1", Status: "UP"}, nil)
+				m.On("Edition", mock.Anything).
+					Return("community", nil)
 
 				return m
 			},
@@ -38,15 +47,97 @@ func TestCheckConnection_ServeRequest(t *testing.T) {
 					Name: "sonar",
 				},
 			},
-			wantErr: require.NoError,
+			wantErr:       require.NoError,
+			wantValue:     "GREEN",
+			wantConnected: true,
 			wantStatus: sonarApi.SonarStatus{
-				Value:     "GREEN",
-				Connected: true,
+				Version:  "10.5.1",
+				Edition:  "community",
+				ServerID: "server-id",
 			},
 		},
+		{
+			name: "cluster needs attention",
+			sonarApiClient: func(t *testing.T) checkConnectionApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("Health", mock.Anything).
+					Return(&sonar.SystemHealth{
+						Health: "YELLOW",
+						Causes: []sonar.HealthCause{{Message: "There should be at least two search nodes"}},
+						Nodes: []sonar.NodeHealth{
+							{Name: "app-1", Type: "APPLICATION", Host: "10.0.0.1", Port: 9001, Health: "GREEN"},
+							{
+								Name:   "search-1",
+								Type:   "SEARCH",
+								Host:   "10.0.0.2",
+								Port:   9001,
+								Health: "YELLOW",
+								Causes: []sonar.HealthCause{{Message: "Elasticsearch status is YELLOW"}},
+							},
+						},
+					}, nil)
+				m.On("SystemStatus", mock.Anything).
+					Return(&sonar.SystemStatusResponse{ID: "server-id", Version: "10.5.1", Status: "UP"}, nil)
+				m.On("Edition", mock.Anything).
+					Return("datacenter", nil)
+
+				return m
+			},
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Status: sonarApi.SonarStatus{
+					Nodes: []sonarApi.SonarNodeStatus{{Name: "removed"}},
+				},
+			},
+			wantErr:       require.NoError,
+			wantValue:     "YELLOW",
+			wantConnected: true,
+			wantStatus: sonarApi.SonarStatus{
+				HealthCauses: []string{"There should be at least two search nodes"},
+				Nodes: []sonarApi.SonarNodeStatus{
+					{Name: "app-1", Type: "APPLICATION", Host: "10.0.0.1", Port: 9001, Health: "GREEN"},
+					{
+						Name:   "search-1",
+						Type:   "SEARCH",
+						Host:   "10.0.0.2",
+						Port:   9001,
+						Health: "YELLOW",
+						Causes: []string{"Elasticsearch status is YELLOW"},
+					},
+				},
+				Version:  "10.5.1",
+				Edition:  "datacenter",
+				ServerID: "server-id",
+			},
+		},
+		{
+			name: "failed to get system status",
+			sonarApiClient: func(t *testing.T) checkConnectionApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("Health", mock.Anything).
+					Return(&sonar.SystemHealth{Health: "GREEN"}, nil)
+				m.On("SystemStatus", mock.Anything).
+					Return(nil, errors.New("unauthorized"))
+
+				return m
+			},
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get system status")
+			},
+			wantValue:     "GREEN",
+			wantConnected: true,
+		},
 		{
 			name: "failed to connect",
-			sonarApiClient: func(t *testing.T) sonar.System {
+			sonarApiClient: func(t *testing.T) checkConnectionApiClient {
 				m := mocks.NewMockClientInterface(t)
 				m.On("Health", mock.Anything).
 					Return(nil, errors.New("failed to connect"))
@@ -62,9 +153,80 @@ func TestCheckConnection_ServeRequest(t *testing.T) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "failed to connect")
 			},
-			wantStatus: sonarApi.SonarStatus{
-				Connected: false,
+			wantConnected: false,
+		},
+		{
+			name: "sonar cloud connection is established",
+			sonarApiClient: func(t *testing.T) checkConnectionApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("IsAuthenticated", mock.Anything).
+					Return(true, nil)
+				m.On("GetOrganization", mock.Anything, "my-org").
+					Return(&sonar.Organization{Key: "my-org"}, nil)
+
+				return m
+			},
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Spec: sonarApi.SonarSpec{
+					Flavor:       sonarApi.FlavorSonarCloud,
+					Organization: "my-org",
+				},
+			},
+			wantErr:       require.NoError,
+			wantConnected: true,
+		},
+		{
+			name: "sonar cloud credentials are not valid",
+			sonarApiClient: func(t *testing.T) checkConnectionApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("IsAuthenticated", mock.Anything).
+					Return(false, nil)
+
+				return m
+			},
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Spec: sonarApi.SonarSpec{
+					Flavor:       sonarApi.FlavorSonarCloud,
+					Organization: "my-org",
+				},
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "sonar credentials are not valid")
+			},
+			wantConnected: false,
+		},
+		{
+			name: "sonar cloud organization not found",
+			sonarApiClient: func(t *testing.T) checkConnectionApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("IsAuthenticated", mock.Anything).
+					Return(true, nil)
+				m.On("GetOrganization", mock.Anything, "my-org").
+					Return(nil, sonar.NewHTTPError(http.StatusNotFound, "organization my-org not found"))
+
+				return m
+			},
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Spec: sonarApi.SonarSpec{
+					Flavor:       sonarApi.FlavorSonarCloud,
+					Organization: "my-org",
+				},
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "organization my-org not found")
 			},
+			wantConnected: false,
 		},
 	}
 
@@ -75,7 +237,17 @@ func TestCheckConnection_ServeRequest(t *testing.T) {
 			h := NewCheckConnection(tt.sonarApiClient(t))
 			err := h.ServeRequest(context.Background(), tt.sonar)
 			tt.wantErr(t, err)
-			require.Equal(t, tt.wantStatus, tt.sonar.Status)
+			require.Equal(t, tt.wantValue, tt.sonar.Status.Value)
+			require.Equal(t, tt.wantConnected, tt.sonar.Status.Connected)
+			require.Equal(t, tt.wantConnected, meta.IsStatusConditionTrue(
+				tt.sonar.Status.Conditions,
+				common.ConditionSonarConnected,
+			))
+			require.Equal(t, tt.wantStatus.HealthCauses, tt.sonar.Status.HealthCauses)
+			require.Equal(t, tt.wantStatus.Nodes, tt.sonar.Status.Nodes)
+			require.Equal(t, tt.wantStatus.Version, tt.sonar.Status.Version)
+			require.Equal(t, tt.wantStatus.Edition, tt.sonar.Status.Edition)
+			require.Equal(t, tt.wantStatus.ServerID, tt.sonar.Status.ServerID)
 		})
 	}
 }
@@ -0,0 +1,67 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+)
+
+// ErrSonarNotUp is returned when sonar is starting or migrating its database.
+// The rest of the chain is skipped until sonar is up.
+var ErrSonarNotUp = errors.New("sonar is not up yet")
+
+// notUpStatuses are the statuses of /api/system/status that sonar passes before it is UP.
+var notUpStatuses = []string{"STARTING", "RESTARTING", "DB_MIGRATION_NEEDED", "DB_MIGRATION_RUNNING"}
+
+// CheckSonarStatus stops the chain while sonar is not up, e.g. after the restart to apply plugin changes.
+// Sonar may not respond at all during the restart, so the connection errors stop the chain
+// only if the restart was requested by the previous reconciliation.
+type CheckSonarStatus struct {
+	sonarApiClient sonar.System
+}
+
+func NewCheckSonarStatus(sonarApiClient sonar.System) *CheckSonarStatus {
+	return &CheckSonarStatus{sonarApiClient: sonarApiClient}
+}
+
+func (h *CheckSonarStatus) ServeRequest(ctx context.Context, sonarCR *sonarApi.Sonar) error {
+	restarting := isRestartRequested(sonarCR)
+
+	status, err := h.sonarApiClient.SystemStatus(ctx)
+	if err != nil {
+		if restarting && sonar.IsErrConnection(err) {
+			return fmt.Errorf("%w: %w", ErrSonarRestarting, err)
+		}
+
+		// the next handlers report the error if sonar is not reachable.
+		return nil
+	}
+
+	if !slices.Contains(notUpStatuses, status.Status) {
+		return nil
+	}
+
+	ctrl.LoggerFrom(ctx).Info("Sonar is not up yet", "status", status.Status)
+
+	if restarting {
+		return fmt.Errorf("%w, current status: %s", ErrSonarRestarting, status.Status)
+	}
+
+	return fmt.Errorf("%w, current status: %s", ErrSonarNotUp, status.Status)
+}
+
+// isRestartRequested checks if sonar was restarted by the previous reconciliation and is not synced since then.
+func isRestartRequested(sonarCR *sonarApi.Sonar) bool {
+	c := meta.FindStatusCondition(sonarCR.Status.Conditions, common.ConditionSynced)
+
+	return c != nil && strings.Contains(c.Message, ErrSonarRestarting.Error())
+}
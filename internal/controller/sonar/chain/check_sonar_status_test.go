@@ -0,0 +1,91 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar/mocks"
+)
+
+func TestCheckSonarStatus_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	connErr := &url.Error{Op: "Get", URL: "http://sonar/api/system/status", Err: errors.New("connection refused")}
+
+	restarted := func() *sonarApi.Sonar {
+		return &sonarApi.Sonar{Status: sonarApi.SonarStatus{ReconcileStatus: common.ReconcileStatus{
+			Conditions: []metav1.Condition{{
+				Type:    common.ConditionSynced,
+				Status:  metav1.ConditionFalse,
+				Reason:  common.ReasonSyncFailed,
+				Message: "failed to serve handler: " + ErrSonarRestarting.Error(),
+			}},
+		}}}
+	}
+
+	tests := []struct {
+		name    string
+		status  *sonar.SystemStatusResponse
+		err     error
+		sonar   *sonarApi.Sonar
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:    "sonar is up",
+			status:  &sonar.SystemStatusResponse{Status: "UP"},
+			sonar:   restarted(),
+			wantErr: require.NoError,
+		},
+		{
+			name:   "sonar is starting",
+			status: &sonar.SystemStatusResponse{Status: "STARTING"},
+			sonar:  &sonarApi.Sonar{},
+			wantErr: func(t require.TestingT, err error, i ...any) {
+				require.ErrorIs(t, err, ErrSonarNotUp)
+				require.ErrorContains(t, err, "STARTING")
+			},
+		},
+		{
+			name:   "sonar is restarting after plugin changes",
+			status: &sonar.SystemStatusResponse{Status: "RESTARTING"},
+			sonar:  restarted(),
+			wantErr: func(t require.TestingT, err error, i ...any) {
+				require.ErrorIs(t, err, ErrSonarRestarting)
+			},
+		},
+		{
+			name:  "sonar is not reachable after restart",
+			err:   connErr,
+			sonar: restarted(),
+			wantErr: func(t require.TestingT, err error, i ...any) {
+				require.ErrorIs(t, err, ErrSonarRestarting)
+			},
+		},
+		{
+			name:    "sonar is not reachable without restart",
+			err:     connErr,
+			sonar:   &sonarApi.Sonar{},
+			wantErr: require.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mocks.NewMockClientInterface(t)
+			m.On("SystemStatus", mock.Anything).Return(tt.status, tt.err)
+
+			tt.wantErr(t, NewCheckSonarStatus(m).ServeRequest(context.Background(), tt.sonar))
+		})
+	}
+}
@@ -8,6 +8,22 @@ import (
 
 func MakeChain(sonarApiClient sonar.ClientInterface, k8sClient client.Client) SonarHandler {
 	ch := &chain{}
+	ch.Use(NewCheckSonarStatus(sonarApiClient))
+	ch.Use(NewSyncAdminCredentials(sonarApiClient, k8sClient))
+	ch.Use(NewSyncApiToken(sonarApiClient, k8sClient))
+	ch.Use(NewCheckConnection(sonarApiClient))
+	ch.Use(NewSyncLicense(sonarApiClient, k8sClient))
+	ch.Use(NewSyncPlugins(sonarApiClient))
+	ch.Use(NewUpdateSettings(sonarApiClient, k8sClient))
+	ch.Use(NewSetDefaultPermissionTemplate(sonarApiClient))
+
+	return ch
+}
+
+// MakeSonarCloudChain returns the chain for SonarCloud that has no admin user, licenses and plugins.
+func MakeSonarCloudChain(sonarApiClient sonar.ClientInterface, k8sClient client.Client) SonarHandler {
+	ch := &chain{}
+	ch.Use(NewSyncApiToken(sonarApiClient, k8sClient))
 	ch.Use(NewCheckConnection(sonarApiClient))
 	ch.Use(NewUpdateSettings(sonarApiClient, k8sClient))
 	ch.Use(NewSetDefaultPermissionTemplate(sonarApiClient))
@@ -0,0 +1,57 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+// setControllerReference sets sonar as the controller of the object.
+// The objects created for the view of ClusterSonar are controlled by ClusterSonar.
+func setControllerReference(sonarCR *sonarApi.Sonar, obj client.Object, scheme *runtime.Scheme) error {
+	if !sonarCR.IsClusterSonarView() {
+		return controllerutil.SetControllerReference(sonarCR, obj, scheme)
+	}
+
+	owner := &sonarApi.ClusterSonar{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: sonarCR.Name,
+			UID:  sonarCR.UID,
+		},
+	}
+
+	return controllerutil.SetControllerReference(owner, obj, scheme)
+}
+
+// updateStatus updates the status of sonar.
+// The status of the view of ClusterSonar is stored in ClusterSonar.
+func updateStatus(ctx context.Context, k8sClient client.Client, sonarCR *sonarApi.Sonar) error {
+	if !sonarCR.IsClusterSonarView() {
+		if err := k8sClient.Status().Update(ctx, sonarCR); err != nil {
+			return fmt.Errorf("failed to update sonar status: %w", err)
+		}
+
+		return nil
+	}
+
+	clusterSonar := &sonarApi.ClusterSonar{
+		ObjectMeta: *sonarCR.ObjectMeta.DeepCopy(),
+		Spec:       *sonarCR.Spec.DeepCopy(),
+		Status:     *sonarCR.Status.DeepCopy(),
+	}
+	clusterSonar.Namespace = ""
+
+	if err := k8sClient.Status().Update(ctx, clusterSonar); err != nil {
+		return fmt.Errorf("failed to update cluster sonar status: %w", err)
+	}
+
+	sonarCR.ResourceVersion = clusterSonar.ResourceVersion
+
+	return nil
+}
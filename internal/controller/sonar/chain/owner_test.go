@@ -0,0 +1,123 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestSetControllerReference(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	tests := []struct {
+		name     string
+		sonarCR  *sonarApi.Sonar
+		wantKind string
+	}{
+		{
+			name: "sonar",
+			sonarCR: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "default", UID: "sonar-uid"},
+			},
+			wantKind: common.SonarKind,
+		},
+		{
+			name: "view of cluster sonar",
+			sonarCR: (&sonarApi.ClusterSonar{
+				ObjectMeta: metav1.ObjectMeta{Name: "sonar", UID: "sonar-uid"},
+			}).ToSonar("default"),
+			wantKind: common.ClusterSonarKind,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "default"}}
+
+			require.NoError(t, setControllerReference(tt.sonarCR, secret, scheme))
+			require.Len(t, secret.OwnerReferences, 1)
+			assert.Equal(t, tt.wantKind, secret.OwnerReferences[0].Kind)
+			assert.Equal(t, "sonar", secret.OwnerReferences[0].Name)
+			assert.Equal(t, tt.sonarCR.UID, secret.OwnerReferences[0].UID)
+		})
+	}
+}
+
+func TestUpdateStatus(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	tests := []struct {
+		name   string
+		object client.Object
+		view   func(obj client.Object) *sonarApi.Sonar
+		get    func(t *testing.T, k8sClient client.Client) sonarApi.SonarStatus
+	}{
+		{
+			name:   "sonar",
+			object: &sonarApi.Sonar{ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "default"}},
+			view: func(obj client.Object) *sonarApi.Sonar {
+				return obj.(*sonarApi.Sonar)
+			},
+			get: func(t *testing.T, k8sClient client.Client) sonarApi.SonarStatus {
+				sonarCR := &sonarApi.Sonar{}
+				require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKey{
+					Name:      "sonar",
+					Namespace: "default",
+				}, sonarCR))
+
+				return sonarCR.Status
+			},
+		},
+		{
+			name:   "view of cluster sonar",
+			object: &sonarApi.ClusterSonar{ObjectMeta: metav1.ObjectMeta{Name: "sonar"}},
+			view: func(obj client.Object) *sonarApi.Sonar {
+				return obj.(*sonarApi.ClusterSonar).ToSonar("default")
+			},
+			get: func(t *testing.T, k8sClient client.Client) sonarApi.SonarStatus {
+				clusterSonar := &sonarApi.ClusterSonar{}
+				require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKey{Name: "sonar"}, clusterSonar))
+
+				return clusterSonar.Status
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.object).
+				WithStatusSubresource(tt.object).
+				Build()
+
+			sonarCR := tt.view(tt.object)
+			sonarCR.Status.Value = "rotating"
+			oldVersion := sonarCR.ResourceVersion
+
+			require.NoError(t, updateStatus(context.Background(), k8sClient, sonarCR))
+			assert.Equal(t, "rotating", tt.get(t, k8sClient).Value)
+			assert.NotEqual(t, oldVersion, sonarCR.ResourceVersion)
+		})
+	}
+}
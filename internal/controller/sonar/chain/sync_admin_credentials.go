@@ -0,0 +1,226 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+)
+
+// SyncAdminCredentials moves a fresh sonar off the initial admin credentials
+// and rotates the admin password whenever the desired Secret changes.
+// The current credentials are stored in the Secret from the Sonar spec.
+type SyncAdminCredentials struct {
+	sonarApiClient sonar.Authentication
+	k8sClient      client.Client
+}
+
+func NewSyncAdminCredentials(sonarApiClient sonar.Authentication, k8sClient client.Client) *SyncAdminCredentials {
+	return &SyncAdminCredentials{sonarApiClient: sonarApiClient, k8sClient: k8sClient}
+}
+
+func (h *SyncAdminCredentials) ServeRequest(ctx context.Context, sonarCR *sonarApi.Sonar) error {
+	if sonarCR.Spec.AdminCredentials == nil {
+		return nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Start syncing sonar admin credentials")
+
+	user, currentPassword, secretExists, err := h.getCurrentCredentials(ctx, sonarCR)
+	if err != nil {
+		return err
+	}
+
+	desiredPassword, desiredVersion, err := h.getDesiredPassword(ctx, sonarCR, user)
+	if err != nil {
+		return err
+	}
+
+	// if a previous rotation was interrupted after the password was changed,
+	// only the desired password is valid.
+	candidates := []string{currentPassword}
+	if desiredPassword != currentPassword {
+		candidates = append(candidates, desiredPassword)
+	}
+
+	password, err := h.findValidPassword(ctx, user, candidates...)
+	if err != nil {
+		return err
+	}
+
+	if password == "" {
+		if st := sonarCR.Status.AdminCredentials; st != nil &&
+			st.Phase == sonarApi.AdminCredentialsPhaseRotating && st.DesiredSecretVersion != desiredVersion {
+			return fmt.Errorf(
+				"admin password rotation was interrupted, neither the current nor the desired password is valid; "+
+					"restore the password from version %s of secret %s",
+				st.DesiredSecretVersion, sonarCR.Spec.AdminCredentials.DesiredSecret,
+			)
+		}
+
+		return errors.New("failed to log in to sonar with the current or the desired admin password")
+	}
+
+	h.sonarApiClient.SetCredentials(user, password)
+
+	lastRotationTime := getLastRotationTime(sonarCR.Status)
+
+	if password != desiredPassword {
+		log.Info("Changing sonar admin password")
+
+		// the progress is saved before the password is changed, so an interrupted rotation can be detected.
+		sonarCR.Status.AdminCredentials = &sonarApi.AdminCredentialsStatus{
+			Phase:                sonarApi.AdminCredentialsPhaseRotating,
+			DesiredSecretVersion: desiredVersion,
+			LastRotationTime:     lastRotationTime,
+		}
+
+		if err = updateStatus(ctx, h.k8sClient, sonarCR); err != nil {
+			return err
+		}
+
+		if err = h.sonarApiClient.ChangePassword(ctx, user, password, desiredPassword); err != nil {
+			return fmt.Errorf("failed to change admin password: %w", err)
+		}
+
+		now := metav1.Now()
+		lastRotationTime = &now
+
+		log.Info("Sonar admin password has been changed")
+		events.Normal(ctx, sonarCR, events.ReasonUpdated, "Sonar admin password has been changed")
+	}
+
+	if !secretExists || currentPassword != desiredPassword {
+		if err = h.saveCurrentCredentials(ctx, sonarCR, user, desiredPassword); err != nil {
+			return err
+		}
+	}
+
+	sonarCR.Status.AdminCredentials = &sonarApi.AdminCredentialsStatus{
+		Phase:                sonarApi.AdminCredentialsPhaseSynced,
+		DesiredSecretVersion: desiredVersion,
+		LastRotationTime:     lastRotationTime,
+	}
+
+	log.Info("Sonar admin credentials have been synced")
+
+	return nil
+}
+
+// getCurrentCredentials returns credentials from the Sonar secret.
+// If the secret doesn't exist yet, the initial admin credentials are returned.
+func (h *SyncAdminCredentials) getCurrentCredentials(
+	ctx context.Context,
+	sonarCR *sonarApi.Sonar,
+) (user, password string, secretExists bool, err error) {
+	user, password, err = sonar.GetCredentialsFromSecret(ctx, h.k8sClient, sonarCR.Namespace, sonarCR.Spec.Secret)
+	if err == nil {
+		return user, password, true, nil
+	}
+
+	if !k8sErrors.IsNotFound(err) {
+		return "", "", false, err
+	}
+
+	user, password, err = sonar.GetInitialAdminCredentials(ctx, h.k8sClient, sonarCR)
+	if err != nil {
+		return "", "", false, err
+	}
+
+	return user, password, false, nil
+}
+
+func (h *SyncAdminCredentials) getDesiredPassword(
+	ctx context.Context,
+	sonarCR *sonarApi.Sonar,
+	user string,
+) (password, version string, err error) {
+	secret := &corev1.Secret{}
+	if err = h.k8sClient.Get(ctx, types.NamespacedName{
+		Name:      sonarCR.Spec.AdminCredentials.DesiredSecret,
+		Namespace: sonarCR.Namespace,
+	}, secret); err != nil {
+		return "", "", fmt.Errorf("failed to get desired admin secret: %w", err)
+	}
+
+	if len(secret.Data["password"]) == 0 {
+		return "", "", errors.New("desired admin secret doesn't contain password")
+	}
+
+	if desiredUser, ok := secret.Data["user"]; ok && string(desiredUser) != user {
+		return "", "", fmt.Errorf("changing admin user from %s to %s is not supported", user, desiredUser)
+	}
+
+	return string(secret.Data["password"]), secret.ResourceVersion, nil
+}
+
+// findValidPassword returns the first password that can be used to log in to sonar.
+// If none of the passwords is valid, an empty string is returned.
+func (h *SyncAdminCredentials) findValidPassword(ctx context.Context, user string, passwords ...string) (string, error) {
+	for _, p := range passwords {
+		valid, err := h.sonarApiClient.ValidateCredentials(ctx, user, p)
+		if err != nil {
+			return "", fmt.Errorf("failed to validate admin credentials: %w", err)
+		}
+
+		if valid {
+			return p, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (h *SyncAdminCredentials) saveCurrentCredentials(
+	ctx context.Context,
+	sonarCR *sonarApi.Sonar,
+	user, password string,
+) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sonarCR.Spec.Secret,
+			Namespace: sonarCR.Namespace,
+		},
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, h.k8sClient, secret, func() error {
+		if secret.CreationTimestamp.IsZero() {
+			if err := setControllerReference(sonarCR, secret, h.k8sClient.Scheme()); err != nil {
+				return fmt.Errorf("failed to set controller reference: %w", err)
+			}
+		}
+
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+
+		secret.Data["user"] = []byte(user)
+		secret.Data["password"] = []byte(password)
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to save admin credentials to secret %s: %w", sonarCR.Spec.Secret, err)
+	}
+
+	return nil
+}
+
+func getLastRotationTime(status sonarApi.SonarStatus) *metav1.Time {
+	if status.AdminCredentials == nil {
+		return nil
+	}
+
+	return status.AdminCredentials.LastRotationTime
+}
@@ -0,0 +1,293 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar/mocks"
+)
+
+func TestSyncAdminCredentials_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	newSonar := func(status sonarApi.SonarStatus) *sonarApi.Sonar {
+		return &sonarApi.Sonar{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "sonar",
+				Namespace: "default",
+				UID:       "sonar-uid",
+			},
+			Spec: sonarApi.SonarSpec{
+				Secret: "sonar-admin",
+				AdminCredentials: &sonarApi.AdminCredentials{
+					DesiredSecret: "sonar-admin-desired",
+				},
+			},
+			Status: status,
+		}
+	}
+
+	newSecret := func(name string, data map[string]string) *corev1.Secret {
+		s := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Data: map[string][]byte{},
+		}
+
+		for k, v := range data {
+			s.Data[k] = []byte(v)
+		}
+
+		return s
+	}
+
+	tests := []struct {
+		name           string
+		sonar          *sonarApi.Sonar
+		objects        []client.Object
+		sonarApiClient func(t *testing.T) sonar.Authentication
+		wantErr        require.ErrorAssertionFunc
+		wantPassword   string
+		wantPhase      string
+	}{
+		{
+			name:  "admin credentials are not configured",
+			sonar: &sonarApi.Sonar{},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				return mocks.NewMockClientInterface(t)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:  "fresh sonar is moved off the default credentials",
+			sonar: newSonar(sonarApi.SonarStatus{}),
+			objects: []client.Object{
+				newSecret("sonar-admin-desired", map[string]string{"password": "new-password"}),
+			},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				m := mocks.NewMockClientInterface(t)
+				m.On("ValidateCredentials", mock.Anything, "admin", "admin").
+					Return(true, nil)
+				m.On("SetCredentials", "admin", "admin").
+					Return()
+				m.On("ChangePassword", mock.Anything, "admin", "admin", "new-password").
+					Return(nil)
+
+				return m
+			},
+			wantErr:      require.NoError,
+			wantPassword: "new-password",
+			wantPhase:    sonarApi.AdminCredentialsPhaseSynced,
+		},
+		{
+			name:  "password is rotated",
+			sonar: newSonar(sonarApi.SonarStatus{}),
+			objects: []client.Object{
+				newSecret("sonar-admin", map[string]string{"user": "admin", "password": "old-password"}),
+				newSecret("sonar-admin-desired", map[string]string{"user": "admin", "password": "new-password"}),
+			},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				m := mocks.NewMockClientInterface(t)
+				m.On("ValidateCredentials", mock.Anything, "admin", "old-password").
+					Return(true, nil)
+				m.On("SetCredentials", "admin", "old-password").
+					Return()
+				m.On("ChangePassword", mock.Anything, "admin", "old-password", "new-password").
+					Return(nil)
+
+				return m
+			},
+			wantErr:      require.NoError,
+			wantPassword: "new-password",
+			wantPhase:    sonarApi.AdminCredentialsPhaseSynced,
+		},
+		{
+			name:  "password is up to date",
+			sonar: newSonar(sonarApi.SonarStatus{}),
+			objects: []client.Object{
+				newSecret("sonar-admin", map[string]string{"user": "admin", "password": "password"}),
+				newSecret("sonar-admin-desired", map[string]string{"password": "password"}),
+			},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				m := mocks.NewMockClientInterface(t)
+				m.On("ValidateCredentials", mock.Anything, "admin", "password").
+					Return(true, nil)
+				m.On("SetCredentials", "admin", "password").
+					Return()
+
+				return m
+			},
+			wantErr:      require.NoError,
+			wantPassword: "password",
+			wantPhase:    sonarApi.AdminCredentialsPhaseSynced,
+		},
+		{
+			name: "interrupted rotation is recovered",
+			sonar: newSonar(sonarApi.SonarStatus{
+				AdminCredentials: &sonarApi.AdminCredentialsStatus{
+					Phase: sonarApi.AdminCredentialsPhaseRotating,
+				},
+			}),
+			objects: []client.Object{
+				newSecret("sonar-admin", map[string]string{"user": "admin", "password": "old-password"}),
+				newSecret("sonar-admin-desired", map[string]string{"password": "new-password"}),
+			},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				m := mocks.NewMockClientInterface(t)
+				m.On("ValidateCredentials", mock.Anything, "admin", "old-password").
+					Return(false, nil)
+				m.On("ValidateCredentials", mock.Anything, "admin", "new-password").
+					Return(true, nil)
+				m.On("SetCredentials", "admin", "new-password").
+					Return()
+
+				return m
+			},
+			wantErr:      require.NoError,
+			wantPassword: "new-password",
+			wantPhase:    sonarApi.AdminCredentialsPhaseSynced,
+		},
+		{
+			name: "interrupted rotation can't be recovered",
+			sonar: newSonar(sonarApi.SonarStatus{
+				AdminCredentials: &sonarApi.AdminCredentialsStatus{
+					Phase:                sonarApi.AdminCredentialsPhaseRotating,
+					DesiredSecretVersion: "100",
+				},
+			}),
+			objects: []client.Object{
+				newSecret("sonar-admin", map[string]string{"user": "admin", "password": "old-password"}),
+				newSecret("sonar-admin-desired", map[string]string{"password": "new-password"}),
+			},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				m := mocks.NewMockClientInterface(t)
+				m.On("ValidateCredentials", mock.Anything, "admin", mock.Anything).
+					Return(false, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "restore the password from version 100 of secret sonar-admin-desired")
+			},
+		},
+		{
+			name:  "no valid password",
+			sonar: newSonar(sonarApi.SonarStatus{}),
+			objects: []client.Object{
+				newSecret("sonar-admin-desired", map[string]string{"password": "new-password"}),
+			},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				m := mocks.NewMockClientInterface(t)
+				m.On("ValidateCredentials", mock.Anything, "admin", mock.Anything).
+					Return(false, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to log in to sonar")
+			},
+		},
+		{
+			name:  "admin user can't be changed",
+			sonar: newSonar(sonarApi.SonarStatus{}),
+			objects: []client.Object{
+				newSecret("sonar-admin-desired", map[string]string{"user": "root", "password": "new-password"}),
+			},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				return mocks.NewMockClientInterface(t)
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "changing admin user from admin to root is not supported")
+			},
+		},
+		{
+			name:  "desired secret not found",
+			sonar: newSonar(sonarApi.SonarStatus{}),
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				return mocks.NewMockClientInterface(t)
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get desired admin secret")
+			},
+		},
+		{
+			name:  "failed to change password",
+			sonar: newSonar(sonarApi.SonarStatus{}),
+			objects: []client.Object{
+				newSecret("sonar-admin-desired", map[string]string{"password": "new-password"}),
+			},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				m := mocks.NewMockClientInterface(t)
+				m.On("ValidateCredentials", mock.Anything, "admin", "admin").
+					Return(true, nil)
+				m.On("SetCredentials", "admin", "admin").
+					Return()
+				m.On("ChangePassword", mock.Anything, "admin", "admin", "new-password").
+					Return(errors.New("sonar is not in green state"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to change admin password")
+			},
+			wantPhase: sonarApi.AdminCredentialsPhaseRotating,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tt.objects, tt.sonar)...).
+				WithStatusSubresource(tt.sonar).
+				Build()
+
+			h := NewSyncAdminCredentials(tt.sonarApiClient(t), k8sClient)
+			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.sonar)
+
+			tt.wantErr(t, err)
+
+			if tt.wantPhase != "" {
+				require.NotNil(t, tt.sonar.Status.AdminCredentials)
+				require.Equal(t, tt.wantPhase, tt.sonar.Status.AdminCredentials.Phase)
+			}
+
+			if tt.wantPassword != "" {
+				secret := &corev1.Secret{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name:      tt.sonar.Spec.Secret,
+					Namespace: tt.sonar.Namespace,
+				}, secret))
+				require.Equal(t, tt.wantPassword, string(secret.Data["password"]))
+				require.Equal(t, "admin", string(secret.Data["user"]))
+			}
+		})
+	}
+}
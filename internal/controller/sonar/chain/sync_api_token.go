@@ -0,0 +1,184 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+)
+
+const (
+	defaultTokenExpirationDays = 30
+	operatorTokenNamePrefix    = "edp-sonar-operator"
+)
+
+// SyncApiToken generates the API token that is used by the operator instead of the sonar credentials.
+// The token is stored in the operator-owned Secret and renewed before it expires.
+// The sonar credentials are used only if the token is missing or not valid anymore.
+type SyncApiToken struct {
+	sonarApiClient sonar.Authentication
+	k8sClient      client.Client
+}
+
+func NewSyncApiToken(sonarApiClient sonar.Authentication, k8sClient client.Client) *SyncApiToken {
+	return &SyncApiToken{sonarApiClient: sonarApiClient, k8sClient: k8sClient}
+}
+
+func (h *SyncApiToken) ServeRequest(ctx context.Context, sonarCR *sonarApi.Sonar) error {
+	if sonarCR.Spec.TokenAuth == nil {
+		sonarCR.Status.Token = nil
+
+		return nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Start syncing sonar API token")
+
+	secret := &corev1.Secret{}
+	if err := h.k8sClient.Get(ctx, types.NamespacedName{
+		Name:      sonar.GetTokenSecretName(sonarCR),
+		Namespace: sonarCR.Namespace,
+	}, secret); err != nil && !k8sErrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get sonar token secret: %w", err)
+	}
+
+	token := string(secret.Data[sonar.TokenSecretKey])
+	oldTokenName := string(secret.Data[sonar.TokenNameSecretKey])
+	expirationDate, _ := time.Parse(time.RFC3339, string(secret.Data[sonar.TokenExpirationDateSecretKey]))
+
+	valid, err := h.isTokenValid(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	renewAt := expirationDate.AddDate(0, 0, -sonarCR.Spec.TokenAuth.RenewBeforeDays)
+
+	if valid && time.Now().Before(renewAt) {
+		h.sonarApiClient.SetToken(token)
+		setTokenStatus(sonarCR, oldTokenName, expirationDate)
+
+		log.Info("Sonar API token is up to date")
+
+		return nil
+	}
+
+	if valid {
+		// the token that is about to expire is still valid, so it is used to generate the new one.
+		h.sonarApiClient.SetToken(token)
+	} else {
+		user, password, credErr := sonar.GetSonarCredentials(ctx, h.k8sClient, sonarCR)
+		if credErr != nil {
+			return fmt.Errorf("failed to get sonar credentials to generate API token: %w", credErr)
+		}
+
+		h.sonarApiClient.SetCredentials(user, password)
+	}
+
+	now := time.Now()
+	newExpirationDate := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, getTokenExpirationDays(sonarCR.Spec.TokenAuth))
+
+	newToken, err := h.sonarApiClient.GenerateToken(
+		ctx,
+		fmt.Sprintf("%s-%d", operatorTokenNamePrefix, now.Unix()),
+		newExpirationDate,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to generate sonar API token: %w", err)
+	}
+
+	if err = h.saveToken(ctx, sonarCR, newToken, newExpirationDate); err != nil {
+		return err
+	}
+
+	h.sonarApiClient.SetToken(newToken.Token)
+	setTokenStatus(sonarCR, newToken.Name, newExpirationDate)
+
+	log.Info("Sonar API token has been generated", "name", newToken.Name)
+	events.Normal(ctx, sonarCR, events.ReasonCreated, "Sonar API token %s has been generated", newToken.Name)
+
+	if oldTokenName != "" && oldTokenName != newToken.Name {
+		// the new token is already saved, so a failed revocation shouldn't block the reconciliation.
+		if err = h.sonarApiClient.RevokeToken(ctx, oldTokenName); err != nil && !sonar.IsErrNotFound(err) {
+			log.Error(err, "Failed to revoke old sonar API token", "name", oldTokenName)
+		}
+	}
+
+	return nil
+}
+
+func (h *SyncApiToken) isTokenValid(ctx context.Context, token string) (bool, error) {
+	if token == "" {
+		return false, nil
+	}
+
+	valid, err := h.sonarApiClient.ValidateToken(ctx, token)
+	if err != nil {
+		return false, fmt.Errorf("failed to validate sonar API token: %w", err)
+	}
+
+	return valid, nil
+}
+
+func (h *SyncApiToken) saveToken(
+	ctx context.Context,
+	sonarCR *sonarApi.Sonar,
+	token *sonar.UserToken,
+	expirationDate time.Time,
+) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sonar.GetTokenSecretName(sonarCR),
+			Namespace: sonarCR.Namespace,
+		},
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, h.k8sClient, secret, func() error {
+		if err := setControllerReference(sonarCR, secret, h.k8sClient.Scheme()); err != nil {
+			return fmt.Errorf("failed to set controller reference: %w", err)
+		}
+
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+
+		secret.Data[sonar.TokenSecretKey] = []byte(token.Token)
+		secret.Data[sonar.TokenNameSecretKey] = []byte(token.Name)
+		secret.Data[sonar.TokenExpirationDateSecretKey] = []byte(expirationDate.Format(time.RFC3339))
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to save sonar API token to secret %s: %w", secret.Name, err)
+	}
+
+	return nil
+}
+
+func setTokenStatus(sonarCR *sonarApi.Sonar, name string, expirationDate time.Time) {
+	status := &sonarApi.TokenStatus{Name: name}
+
+	if !expirationDate.IsZero() {
+		t := metav1.NewTime(expirationDate)
+		status.ExpirationDate = &t
+	}
+
+	sonarCR.Status.Token = status
+}
+
+func getTokenExpirationDays(tokenAuth *sonarApi.TokenAuth) int {
+	if tokenAuth.ExpirationDays > 0 {
+		return tokenAuth.ExpirationDays
+	}
+
+	return defaultTokenExpirationDays
+}
@@ -0,0 +1,226 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar/mocks"
+)
+
+func TestSyncApiToken_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	sonarCR := &sonarApi.Sonar{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sonar",
+			Namespace: "default",
+			UID:       "sonar-uid",
+		},
+		Spec: sonarApi.SonarSpec{
+			Secret: "sonar-admin",
+			TokenAuth: &sonarApi.TokenAuth{
+				ExpirationDays:  30,
+				RenewBeforeDays: 7,
+			},
+		},
+	}
+
+	adminSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sonar-admin",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"user":     []byte("admin"),
+			"password": []byte("password"),
+		},
+	}
+
+	newTokenSecret := func(expirationDate time.Time) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "sonar-operator-token",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				sonar.TokenSecretKey:               []byte("old-token"),
+				sonar.TokenNameSecretKey:           []byte("edp-sonar-operator-1"),
+				sonar.TokenExpirationDateSecretKey: []byte(expirationDate.Format(time.RFC3339)),
+			},
+		}
+	}
+
+	newToken := &sonar.UserToken{Login: "admin", Name: "edp-sonar-operator-2", Token: "new-token"}
+
+	tests := []struct {
+		name           string
+		sonar          *sonarApi.Sonar
+		objects        []client.Object
+		sonarApiClient func(t *testing.T) sonar.Authentication
+		wantErr        require.ErrorAssertionFunc
+		wantToken      string
+	}{
+		{
+			name:  "token auth is not configured",
+			sonar: &sonarApi.Sonar{},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				return mocks.NewMockClientInterface(t)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "token is generated with sonar credentials",
+			sonar:   sonarCR.DeepCopy(),
+			objects: []client.Object{adminSecret},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				m := mocks.NewMockClientInterface(t)
+				m.On("SetCredentials", "admin", "password").
+					Return()
+				m.On("GenerateToken", mock.Anything, mock.Anything, mock.Anything).
+					Return(newToken, nil)
+				m.On("SetToken", "new-token").
+					Return()
+
+				return m
+			},
+			wantErr:   require.NoError,
+			wantToken: "new-token",
+		},
+		{
+			name:    "token is up to date",
+			sonar:   sonarCR.DeepCopy(),
+			objects: []client.Object{adminSecret, newTokenSecret(time.Now().AddDate(0, 0, 20))},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				m := mocks.NewMockClientInterface(t)
+				m.On("ValidateToken", mock.Anything, "old-token").
+					Return(true, nil)
+				m.On("SetToken", "old-token").
+					Return()
+
+				return m
+			},
+			wantErr:   require.NoError,
+			wantToken: "old-token",
+		},
+		{
+			name:    "token is renewed before expiration",
+			sonar:   sonarCR.DeepCopy(),
+			objects: []client.Object{adminSecret, newTokenSecret(time.Now().AddDate(0, 0, 3))},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				m := mocks.NewMockClientInterface(t)
+				m.On("ValidateToken", mock.Anything, "old-token").
+					Return(true, nil)
+				m.On("SetToken", "old-token").
+					Return()
+				m.On("GenerateToken", mock.Anything, mock.Anything, mock.Anything).
+					Return(newToken, nil)
+				m.On("SetToken", "new-token").
+					Return()
+				m.On("RevokeToken", mock.Anything, "edp-sonar-operator-1").
+					Return(nil)
+
+				return m
+			},
+			wantErr:   require.NoError,
+			wantToken: "new-token",
+		},
+		{
+			name:    "invalid token is replaced",
+			sonar:   sonarCR.DeepCopy(),
+			objects: []client.Object{adminSecret, newTokenSecret(time.Now().AddDate(0, 0, 20))},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				m := mocks.NewMockClientInterface(t)
+				m.On("ValidateToken", mock.Anything, "old-token").
+					Return(false, nil)
+				m.On("SetCredentials", "admin", "password").
+					Return()
+				m.On("GenerateToken", mock.Anything, mock.Anything, mock.Anything).
+					Return(newToken, nil)
+				m.On("SetToken", "new-token").
+					Return()
+				m.On("RevokeToken", mock.Anything, "edp-sonar-operator-1").
+					Return(sonar.NewHTTPError(http.StatusNotFound, "token not found"))
+
+				return m
+			},
+			wantErr:   require.NoError,
+			wantToken: "new-token",
+		},
+		{
+			name:  "sonar credentials not found",
+			sonar: sonarCR.DeepCopy(),
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				return mocks.NewMockClientInterface(t)
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get sonar credentials to generate API token")
+			},
+		},
+		{
+			name:    "failed to generate token",
+			sonar:   sonarCR.DeepCopy(),
+			objects: []client.Object{adminSecret},
+			sonarApiClient: func(t *testing.T) sonar.Authentication {
+				m := mocks.NewMockClientInterface(t)
+				m.On("SetCredentials", "admin", "password").
+					Return()
+				m.On("GenerateToken", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errors.New("insufficient privileges"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to generate sonar API token")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tt.objects, tt.sonar)...).
+				Build()
+
+			h := NewSyncApiToken(tt.sonarApiClient(t), k8sClient)
+			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.sonar)
+
+			tt.wantErr(t, err)
+
+			if tt.wantToken != "" {
+				secret := &corev1.Secret{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name:      "sonar-operator-token",
+					Namespace: "default",
+				}, secret))
+				require.Equal(t, tt.wantToken, string(secret.Data[sonar.TokenSecretKey]))
+				require.NotNil(t, tt.sonar.Status.Token)
+				require.NotNil(t, tt.sonar.Status.Token.ExpirationDate)
+			}
+		})
+	}
+}
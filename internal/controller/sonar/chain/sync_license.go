@@ -0,0 +1,155 @@
+package chain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+	"github.com/epam/edp-sonar-operator/pkg/sourceref"
+)
+
+const (
+	defaultLicenseExpirationWarningDays = 30
+	licenseExpirationDateLayout         = "2006-01-02"
+)
+
+// SyncLicense installs the license of a commercial edition of sonar
+// and reports the license details and its expiration in the Sonar status.
+type SyncLicense struct {
+	sonarApiClient sonar.LicenseClient
+	k8sClient      client.Client
+}
+
+func NewSyncLicense(sonarApiClient sonar.LicenseClient, k8sClient client.Client) *SyncLicense {
+	return &SyncLicense{sonarApiClient: sonarApiClient, k8sClient: k8sClient}
+}
+
+func (h *SyncLicense) ServeRequest(ctx context.Context, sonarCR *sonarApi.Sonar) error {
+	if sonarCR.Spec.License == nil {
+		sonarCR.Status.License = nil
+		meta.RemoveStatusCondition(&sonarCR.Status.Conditions, sonarApi.ConditionLicenseExpiring)
+
+		return nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Start syncing sonar license")
+
+	license, err := sourceref.GetValueFromSourceRef(
+		ctx,
+		&common.SourceRef{SecretKeyRef: &sonarCR.Spec.License.SecretKeyRef},
+		sonarCR.Namespace,
+		h.k8sClient,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get sonar license: %w", err)
+	}
+
+	license = strings.TrimSpace(license)
+	licenseHash := hashLicense(license)
+
+	installed, err := h.sonarApiClient.GetLicense(ctx)
+	if err != nil && !sonar.IsErrNotFound(err) {
+		return fmt.Errorf("failed to get installed sonar license: %w", err)
+	}
+
+	// sonar doesn't return the installed license, so we compare the hash of the license installed by the operator.
+	if installed == nil || sonarCR.Status.License == nil || sonarCR.Status.License.LicenseHash != licenseHash {
+		if err = h.sonarApiClient.SetLicense(ctx, license); err != nil {
+			return fmt.Errorf("failed to install sonar license: %w", err)
+		}
+
+		log.Info("Sonar license has been installed")
+		events.Normal(ctx, sonarCR, events.ReasonUpdated, "Sonar license has been installed")
+
+		if installed, err = h.sonarApiClient.GetLicense(ctx); err != nil {
+			return fmt.Errorf("failed to get installed sonar license: %w", err)
+		}
+	}
+
+	previousReason := ""
+	if c := meta.FindStatusCondition(sonarCR.Status.Conditions, sonarApi.ConditionLicenseExpiring); c != nil {
+		previousReason = c.Reason
+	}
+
+	setLicenseStatus(sonarCR, installed, licenseHash)
+
+	if meta.IsStatusConditionTrue(sonarCR.Status.Conditions, sonarApi.ConditionLicenseExpiring) {
+		c := meta.FindStatusCondition(sonarCR.Status.Conditions, sonarApi.ConditionLicenseExpiring)
+		log.Info("Sonar license needs to be renewed", "message", c.Message)
+
+		// the warning is recorded once the license starts expiring or expires, not on every reconciliation.
+		if c.Reason != previousReason {
+			events.Warning(ctx, sonarCR, c.Reason, errors.New(c.Message))
+		}
+	}
+
+	return nil
+}
+
+func setLicenseStatus(sonarCR *sonarApi.Sonar, license *sonar.License, licenseHash string) {
+	status := &sonarApi.LicenseStatus{
+		Edition:              license.Edition,
+		MaxLinesOfCode:       license.MaxLoc,
+		LinesOfCode:          license.Loc,
+		RemainingLinesOfCode: max(license.MaxLoc-license.Loc, 0),
+		LicenseHash:          licenseHash,
+	}
+
+	if expiresAt, err := time.Parse(licenseExpirationDateLayout, license.ExpiresAt); err == nil {
+		status.ExpirationDate = &metav1.Time{Time: expiresAt}
+	}
+
+	sonarCR.Status.License = status
+
+	warningDays := sonarCR.Spec.License.ExpirationWarningDays
+	if warningDays == 0 {
+		warningDays = defaultLicenseExpirationWarningDays
+	}
+
+	condition := metav1.Condition{
+		Type:               sonarApi.ConditionLicenseExpiring,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: sonarCR.Generation,
+		Reason:             sonarApi.ReasonLicenseValid,
+		Message:            "License has no expiration date",
+	}
+
+	if status.ExpirationDate != nil {
+		expiresAt := status.ExpirationDate.Format(licenseExpirationDateLayout)
+
+		switch {
+		case license.IsExpired || time.Now().After(status.ExpirationDate.Time):
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = sonarApi.ReasonLicenseExpired
+			condition.Message = fmt.Sprintf("License expired on %s", expiresAt)
+		case time.Now().AddDate(0, 0, warningDays).After(status.ExpirationDate.Time):
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = sonarApi.ReasonLicenseExpiringSoon
+			condition.Message = fmt.Sprintf("License expires on %s", expiresAt)
+		default:
+			condition.Message = fmt.Sprintf("License expires on %s", expiresAt)
+		}
+	}
+
+	meta.SetStatusCondition(&sonarCR.Status.Conditions, condition)
+}
+
+func hashLicense(license string) string {
+	sum := sha256.Sum256([]byte(license))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,275 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar/mocks"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+)
+
+func TestSyncLicense_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	licenseSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "sonar-license", Namespace: "default"},
+		Data:       map[string][]byte{"license": []byte("license-key\n")},
+	}
+
+	newSonar := func(status *sonarApi.LicenseStatus) *sonarApi.Sonar {
+		return &sonarApi.Sonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "default"},
+			Spec: sonarApi.SonarSpec{
+				License: &sonarApi.SonarLicense{
+					SecretKeyRef: common.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "sonar-license"},
+						Key:                  "license",
+					},
+					ExpirationWarningDays: 30,
+				},
+			},
+			Status: sonarApi.SonarStatus{License: status},
+		}
+	}
+
+	newLicense := func(expiresAt time.Time) *sonar.License {
+		return &sonar.License{
+			Edition:        "developer",
+			ExpiresAt:      expiresAt.Format("2006-01-02"),
+			IsValidEdition: true,
+			Loc:            1500,
+			MaxLoc:         100000,
+		}
+	}
+
+	validLicense := newLicense(time.Now().AddDate(1, 0, 0))
+
+	tests := []struct {
+		name           string
+		sonar          *sonarApi.Sonar
+		objects        []client.Object
+		sonarApiClient func(t *testing.T) sonar.LicenseClient
+		wantErr        require.ErrorAssertionFunc
+		wantExpiring   metav1.ConditionStatus
+		wantReason     string
+		wantEvents     []string
+	}{
+		{
+			name: "license is not configured",
+			sonar: &sonarApi.Sonar{
+				Status: sonarApi.SonarStatus{License: &sonarApi.LicenseStatus{Edition: "developer"}},
+			},
+			sonarApiClient: func(t *testing.T) sonar.LicenseClient {
+				return mocks.NewMockClientInterface(t)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "license is installed",
+			sonar:   newSonar(nil),
+			objects: []client.Object{licenseSecret},
+			sonarApiClient: func(t *testing.T) sonar.LicenseClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetLicense", mock.Anything).
+					Return(nil, sonar.NewHTTPError(http.StatusNotFound, "License not found")).Once()
+				m.On("SetLicense", mock.Anything, "license-key").
+					Return(nil)
+				m.On("GetLicense", mock.Anything).
+					Return(validLicense, nil).Once()
+
+				return m
+			},
+			wantErr:      require.NoError,
+			wantExpiring: metav1.ConditionFalse,
+			wantReason:   sonarApi.ReasonLicenseValid,
+		},
+		{
+			name:    "license is up to date but expires soon",
+			sonar:   newSonar(&sonarApi.LicenseStatus{LicenseHash: hashLicense("license-key")}),
+			objects: []client.Object{licenseSecret},
+			sonarApiClient: func(t *testing.T) sonar.LicenseClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetLicense", mock.Anything).
+					Return(newLicense(time.Now().AddDate(0, 0, 10)), nil)
+
+				return m
+			},
+			wantErr:      require.NoError,
+			wantExpiring: metav1.ConditionTrue,
+			wantReason:   sonarApi.ReasonLicenseExpiringSoon,
+			wantEvents:   []string{"Warning LicenseExpiringSoon License expires on"},
+		},
+		{
+			name:    "changed license is installed",
+			sonar:   newSonar(&sonarApi.LicenseStatus{LicenseHash: hashLicense("old-license-key")}),
+			objects: []client.Object{licenseSecret},
+			sonarApiClient: func(t *testing.T) sonar.LicenseClient {
+				expired := newLicense(time.Now().AddDate(0, 0, -1))
+				expired.IsExpired = true
+
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetLicense", mock.Anything).
+					Return(expired, nil).Once()
+				m.On("SetLicense", mock.Anything, "license-key").
+					Return(nil)
+				m.On("GetLicense", mock.Anything).
+					Return(validLicense, nil).Once()
+
+				return m
+			},
+			wantErr:      require.NoError,
+			wantExpiring: metav1.ConditionFalse,
+			wantReason:   sonarApi.ReasonLicenseValid,
+		},
+		{
+			name:    "license is expired",
+			sonar:   newSonar(&sonarApi.LicenseStatus{LicenseHash: hashLicense("license-key")}),
+			objects: []client.Object{licenseSecret},
+			sonarApiClient: func(t *testing.T) sonar.LicenseClient {
+				expired := newLicense(time.Now().AddDate(0, 0, -1))
+				expired.IsExpired = true
+
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetLicense", mock.Anything).
+					Return(expired, nil)
+
+				return m
+			},
+			wantErr:      require.NoError,
+			wantExpiring: metav1.ConditionTrue,
+			wantReason:   sonarApi.ReasonLicenseExpired,
+			wantEvents:   []string{"Warning LicenseExpired License expired on"},
+		},
+		{
+			name: "license is still expired",
+			sonar: func() *sonarApi.Sonar {
+				s := newSonar(&sonarApi.LicenseStatus{LicenseHash: hashLicense("license-key")})
+				s.Status.Conditions = []metav1.Condition{{
+					Type:   sonarApi.ConditionLicenseExpiring,
+					Status: metav1.ConditionTrue,
+					Reason: sonarApi.ReasonLicenseExpired,
+				}}
+
+				return s
+			}(),
+			objects: []client.Object{licenseSecret},
+			sonarApiClient: func(t *testing.T) sonar.LicenseClient {
+				expired := newLicense(time.Now().AddDate(0, 0, -1))
+				expired.IsExpired = true
+
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetLicense", mock.Anything).
+					Return(expired, nil)
+
+				return m
+			},
+			wantErr:      require.NoError,
+			wantExpiring: metav1.ConditionTrue,
+			wantReason:   sonarApi.ReasonLicenseExpired,
+		},
+		{
+			name:  "license secret not found",
+			sonar: newSonar(nil),
+			sonarApiClient: func(t *testing.T) sonar.LicenseClient {
+				return mocks.NewMockClientInterface(t)
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get sonar license")
+			},
+		},
+		{
+			name:    "failed to install license",
+			sonar:   newSonar(nil),
+			objects: []client.Object{licenseSecret},
+			sonarApiClient: func(t *testing.T) sonar.LicenseClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetLicense", mock.Anything).
+					Return(nil, sonar.NewHTTPError(http.StatusNotFound, "License not found"))
+				m.On("SetLicense", mock.Anything, "license-key").
+					Return(errors.New("license is not valid"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to install sonar license")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+
+			recorder := record.NewFakeRecorder(10)
+			ctx := events.IntoContext(ctrl.LoggerInto(context.Background(), logr.Discard()), recorder)
+
+			h := NewSyncLicense(tt.sonarApiClient(t), k8sClient)
+			err := h.ServeRequest(ctx, tt.sonar)
+
+			tt.wantErr(t, err)
+
+			close(recorder.Events)
+
+			var gotEvents []string
+			for e := range recorder.Events {
+				if strings.HasPrefix(e, "Warning") {
+					gotEvents = append(gotEvents, e)
+				}
+			}
+
+			require.Len(t, gotEvents, len(tt.wantEvents))
+
+			for i, e := range tt.wantEvents {
+				assert.Contains(t, gotEvents[i], e)
+			}
+
+			if tt.wantReason == "" {
+				assert.Nil(t, meta.FindStatusCondition(tt.sonar.Status.Conditions, sonarApi.ConditionLicenseExpiring))
+
+				if tt.sonar.Spec.License == nil {
+					assert.Nil(t, tt.sonar.Status.License)
+				}
+
+				return
+			}
+
+			condition := meta.FindStatusCondition(tt.sonar.Status.Conditions, sonarApi.ConditionLicenseExpiring)
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.wantExpiring, condition.Status)
+			assert.Equal(t, tt.wantReason, condition.Reason)
+
+			require.NotNil(t, tt.sonar.Status.License)
+			assert.Equal(t, hashLicense("license-key"), tt.sonar.Status.License.LicenseHash)
+			assert.Equal(t, "developer", tt.sonar.Status.License.Edition)
+			assert.Equal(t, int64(98500), tt.sonar.Status.License.RemainingLinesOfCode)
+			assert.NotNil(t, tt.sonar.Status.License.ExpirationDate)
+		})
+	}
+}
@@ -0,0 +1,342 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+)
+
+// ErrSonarRestarting is returned when sonar has been restarted to apply plugin changes.
+// The rest of the chain is skipped, and the plugins are synced again on the next reconcile when sonar is up.
+var ErrSonarRestarting = errors.New("sonar is restarting to apply plugin changes")
+
+// installedVersionRegexp matches the version format returned by /api/plugins/installed, e.g. "1.15 (build 4655)".
+var installedVersionRegexp = regexp.MustCompile(`^([\d.]+) \(build (\d+)\)$`)
+
+type pluginApiClient interface {
+	sonar.PluginClient
+	sonar.System
+}
+
+// SyncPlugins installs, updates and uninstalls sonar plugins according to the spec.
+// Sonar is restarted once if any plugin change is pending, and ErrSonarRestarting is returned.
+type SyncPlugins struct {
+	sonarApiClient pluginApiClient
+}
+
+func NewSyncPlugins(sonarApiClient pluginApiClient) *SyncPlugins {
+	return &SyncPlugins{sonarApiClient: sonarApiClient}
+}
+
+func (h *SyncPlugins) ServeRequest(ctx context.Context, sonarCR *sonarApi.Sonar) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if len(sonarCR.Spec.Plugins) == 0 && len(sonarCR.Status.InstalledPlugins) == 0 {
+		return nil
+	}
+
+	log.Info("Start syncing sonar plugins")
+
+	installed, err := h.getInstalledPlugins(ctx)
+	if err != nil {
+		return err
+	}
+
+	pending, err := h.sonarApiClient.GetPendingPlugins(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get pending plugins: %w", err)
+	}
+
+	needRestart := len(pending.Installing) != 0 || len(pending.Updating) != 0 || len(pending.Removing) != 0
+	marketplace := &pluginMarketplace{sonarApiClient: h.sonarApiClient}
+
+	for _, p := range sonarCR.Spec.Plugins {
+		changed, errSync := h.syncPlugin(ctx, sonarCR, p, installed, pending, marketplace)
+		if errSync != nil {
+			return errSync
+		}
+
+		needRestart = needRestart || changed
+	}
+
+	if sonarCR.Spec.PluginRemovalPolicy == sonarApi.PluginRemovalPolicyUninstall {
+		changed, errRemove := h.uninstallRemovedPlugins(ctx, sonarCR, installed, pending)
+		if errRemove != nil {
+			return errRemove
+		}
+
+		needRestart = needRestart || changed
+	}
+
+	setInstalledPlugins(&sonarCR.Status, sonarCR.Spec.Plugins, installed)
+
+	if needRestart {
+		log.Info("Restarting sonar to apply plugin changes")
+
+		if err = h.sonarApiClient.Reboot(); err != nil {
+			return fmt.Errorf("failed to restart sonar: %w", err)
+		}
+
+		events.Normal(ctx, sonarCR, events.ReasonUpdated, "Sonar is restarting to apply plugin changes")
+
+		return ErrSonarRestarting
+	}
+
+	log.Info("Sonar plugins have been synced")
+
+	return nil
+}
+
+// syncPlugin installs or updates the plugin if needed.
+// It returns true if the plugin was changed and sonar should be restarted.
+func (h *SyncPlugins) syncPlugin(
+	ctx context.Context,
+	sonarCR *sonarApi.Sonar,
+	p sonarApi.SonarPlugin,
+	installed map[string]string,
+	pending *sonar.PendingPlugins,
+	marketplace *pluginMarketplace,
+) (bool, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("plugin", p.Key)
+
+	if containsPlugin(pending.Installing, p.Key) || containsPlugin(pending.Updating, p.Key) {
+		return false, nil
+	}
+
+	installedVersion, ok := installed[p.Key]
+	if !ok {
+		if p.Version != "" {
+			version, err := marketplace.availableVersion(ctx, p.Key)
+			if err != nil {
+				return false, err
+			}
+
+			if version != p.Version {
+				return false, fmt.Errorf(
+					"plugin %s version %s is not available in the marketplace, available version: %s",
+					p.Key, p.Version, version,
+				)
+			}
+		}
+
+		if err := h.sonarApiClient.InstallPlugin(ctx, p.Key); err != nil {
+			return false, fmt.Errorf("failed to install plugin %s: %w", p.Key, err)
+		}
+
+		log.Info("Plugin has been installed")
+		events.Normal(ctx, sonarCR, events.ReasonCreated, "Plugin %s has been installed", p.Key)
+
+		return true, nil
+	}
+
+	if p.Version == "" || installedVersion == p.Version {
+		return false, nil
+	}
+
+	version, err := marketplace.updateVersion(ctx, p.Key)
+	if err != nil {
+		return false, err
+	}
+
+	if version != p.Version {
+		return false, fmt.Errorf(
+			"plugin %s version %s is not available in the marketplace, installed version: %s, update version: %s",
+			p.Key, p.Version, installedVersion, version,
+		)
+	}
+
+	if err = h.sonarApiClient.UpdatePlugin(ctx, p.Key); err != nil {
+		return false, fmt.Errorf("failed to update plugin %s: %w", p.Key, err)
+	}
+
+	log.Info("Plugin has been updated", "version", p.Version)
+	events.Normal(ctx, sonarCR, events.ReasonUpdated, "Plugin %s has been updated to version %s", p.Key, p.Version)
+
+	return true, nil
+}
+
+// uninstallRemovedPlugins uninstalls plugins that were installed by the operator and then removed from the spec.
+// It returns true if any plugin was uninstalled and sonar should be restarted.
+func (h *SyncPlugins) uninstallRemovedPlugins(
+	ctx context.Context,
+	sonarCR *sonarApi.Sonar,
+	installed map[string]string,
+	pending *sonar.PendingPlugins,
+) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	specPlugins := make(map[string]struct{}, len(sonarCR.Spec.Plugins))
+	for _, p := range sonarCR.Spec.Plugins {
+		specPlugins[p.Key] = struct{}{}
+	}
+
+	changed := false
+
+	for _, p := range sonarCR.Status.InstalledPlugins {
+		if _, ok := specPlugins[p.Key]; ok {
+			continue
+		}
+
+		if _, ok := installed[p.Key]; !ok || containsPlugin(pending.Removing, p.Key) {
+			continue
+		}
+
+		if err := h.sonarApiClient.UninstallPlugin(ctx, p.Key); err != nil {
+			return false, fmt.Errorf("failed to uninstall plugin %s: %w", p.Key, err)
+		}
+
+		log.Info("Plugin has been uninstalled", "plugin", p.Key)
+		events.Normal(ctx, sonarCR, events.ReasonDeleted, "Plugin %s has been uninstalled", p.Key)
+
+		changed = true
+	}
+
+	return changed, nil
+}
+
+func (h *SyncPlugins) getInstalledPlugins(ctx context.Context) (map[string]string, error) {
+	plugins, err := h.sonarApiClient.GetPlugins(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installed plugins: %w", err)
+	}
+
+	installed := make(map[string]string, len(plugins))
+	for _, p := range plugins {
+		installed[p.Key] = normalizePluginVersion(p.Version)
+	}
+
+	return installed, nil
+}
+
+// pluginMarketplace lazily loads available plugins and plugin updates from the SonarQube Marketplace.
+type pluginMarketplace struct {
+	sonarApiClient sonar.PluginClient
+	available      map[string]string
+	updates        map[string]string
+}
+
+func (m *pluginMarketplace) availableVersion(ctx context.Context, key string) (string, error) {
+	if m.available == nil {
+		plugins, err := m.sonarApiClient.GetAvailablePlugins(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to get available plugins: %w", err)
+		}
+
+		m.available = make(map[string]string, len(plugins))
+		for _, p := range plugins {
+			m.available[p.Key] = p.Release.Version
+		}
+	}
+
+	version, ok := m.available[key]
+	if !ok {
+		return "", fmt.Errorf("plugin %s is not available in the marketplace", key)
+	}
+
+	return version, nil
+}
+
+func (m *pluginMarketplace) updateVersion(ctx context.Context, key string) (string, error) {
+	if m.updates == nil {
+		plugins, err := m.sonarApiClient.GetPluginUpdates(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to get plugin updates: %w", err)
+		}
+
+		m.updates = make(map[string]string, len(plugins))
+
+		for _, p := range plugins {
+			if len(p.Updates) == 0 {
+				continue
+			}
+
+			// /plugins/update installs the latest compatible release, which is the last one in the list.
+			m.updates[p.Key] = p.Updates[len(p.Updates)-1].Release.Version
+		}
+	}
+
+	version, ok := m.updates[key]
+	if !ok {
+		return "", fmt.Errorf("plugin %s has no updates in the marketplace", key)
+	}
+
+	return version, nil
+}
+
+func containsPlugin(plugins []sonar.Plugin, key string) bool {
+	for _, p := range plugins {
+		if p.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizePluginVersion converts the installed plugin version to the marketplace format,
+// e.g. "1.15 (build 4655)" to "1.15.0.4655".
+func normalizePluginVersion(version string) string {
+	match := installedVersionRegexp.FindStringSubmatch(version)
+	if match == nil {
+		return version
+	}
+
+	parts := strings.Split(match[1], ".")
+	for len(parts) < 3 {
+		parts = append(parts, "0")
+	}
+
+	return strings.Join(append(parts, match[2]), ".")
+}
+
+// setInstalledPlugins sets the installed plugins from the spec to the status.
+// Plugins that were removed from the spec are kept in the status while they are installed,
+// so they can be uninstalled if the removal policy is changed to Uninstall.
+func setInstalledPlugins(status *sonarApi.SonarStatus, specPlugins []sonarApi.SonarPlugin, installed map[string]string) {
+	plugins := make([]sonarApi.InstalledPlugin, 0, len(specPlugins))
+	tracked := make(map[string]struct{}, len(specPlugins))
+
+	for _, p := range specPlugins {
+		tracked[p.Key] = struct{}{}
+
+		version, ok := installed[p.Key]
+		if !ok {
+			continue
+		}
+
+		plugins = append(plugins, sonarApi.InstalledPlugin{
+			Key:     p.Key,
+			Version: version,
+		})
+	}
+
+	for _, p := range status.InstalledPlugins {
+		if _, ok := tracked[p.Key]; ok {
+			continue
+		}
+
+		version, ok := installed[p.Key]
+		if !ok {
+			continue
+		}
+
+		plugins = append(plugins, sonarApi.InstalledPlugin{
+			Key:     p.Key,
+			Version: version,
+		})
+	}
+
+	if len(plugins) == 0 {
+		plugins = nil
+	}
+
+	status.InstalledPlugins = plugins
+}
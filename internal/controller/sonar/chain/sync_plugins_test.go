@@ -0,0 +1,363 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar/mocks"
+)
+
+func TestSyncPlugins_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		sonar          *sonarApi.Sonar
+		sonarApiClient func(t *testing.T) pluginApiClient
+		wantErr        require.ErrorAssertionFunc
+		wantStatus     []sonarApi.InstalledPlugin
+	}{
+		{
+			name: "no plugins",
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+			},
+			sonarApiClient: func(t *testing.T) pluginApiClient {
+				return mocks.NewMockClientInterface(t)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "plugins are already installed",
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Spec: sonarApi.SonarSpec{
+					Plugins: []sonarApi.SonarPlugin{
+						{Key: "java"},
+						{Key: "go", Version: "1.15.0.4655"},
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) pluginApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetPlugins", mock.Anything).
+					Return([]sonar.Plugin{
+						{Key: "java", Version: "7.16 (build 30901)"},
+						{Key: "go", Version: "1.15 (build 4655)"},
+					}, nil)
+				m.On("GetPendingPlugins", mock.Anything).
+					Return(&sonar.PendingPlugins{}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantStatus: []sonarApi.InstalledPlugin{
+				{Key: "java", Version: "7.16.0.30901"},
+				{Key: "go", Version: "1.15.0.4655"},
+			},
+		},
+		{
+			name: "install, update and uninstall plugins with a single restart",
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Spec: sonarApi.SonarSpec{
+					Plugins: []sonarApi.SonarPlugin{
+						{Key: "java", Version: "7.17.0.31219"},
+						{Key: "go", Version: "1.15.0.4655"},
+					},
+					PluginRemovalPolicy: sonarApi.PluginRemovalPolicyUninstall,
+				},
+				Status: sonarApi.SonarStatus{
+					InstalledPlugins: []sonarApi.InstalledPlugin{
+						{Key: "java", Version: "7.16.0.30901"},
+						{Key: "php", Version: "3.32.0.10180"},
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) pluginApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetPlugins", mock.Anything).
+					Return([]sonar.Plugin{
+						{Key: "java", Version: "7.16 (build 30901)"},
+						{Key: "php", Version: "3.32 (build 10180)"},
+					}, nil).Once()
+				m.On("GetPendingPlugins", mock.Anything).
+					Return(&sonar.PendingPlugins{}, nil)
+				m.On("GetPluginUpdates", mock.Anything).
+					Return([]sonar.UpdatablePlugin{
+						{
+							Key: "java",
+							Updates: []sonar.PluginUpdate{
+								{Release: sonar.PluginRelease{Version: "7.17.0.31219"}},
+							},
+						},
+					}, nil)
+				m.On("UpdatePlugin", mock.Anything, "java").
+					Return(nil)
+				m.On("GetAvailablePlugins", mock.Anything).
+					Return([]sonar.AvailablePlugin{
+						{Key: "go", Release: sonar.PluginRelease{Version: "1.15.0.4655"}},
+					}, nil)
+				m.On("InstallPlugin", mock.Anything, "go").
+					Return(nil)
+				m.On("UninstallPlugin", mock.Anything, "php").
+					Return(nil)
+				m.On("Reboot").
+					Return(nil).Once()
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrSonarRestarting)
+			},
+			wantStatus: []sonarApi.InstalledPlugin{
+				{Key: "java", Version: "7.16.0.30901"},
+				{Key: "php", Version: "3.32.0.10180"},
+			},
+		},
+		{
+			name: "removed plugin is retained",
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Spec: sonarApi.SonarSpec{
+					PluginRemovalPolicy: sonarApi.PluginRemovalPolicyRetain,
+				},
+				Status: sonarApi.SonarStatus{
+					InstalledPlugins: []sonarApi.InstalledPlugin{
+						{Key: "php", Version: "3.32.0.10180"},
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) pluginApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetPlugins", mock.Anything).
+					Return([]sonar.Plugin{
+						{Key: "php", Version: "3.32 (build 10180)"},
+					}, nil)
+				m.On("GetPendingPlugins", mock.Anything).
+					Return(&sonar.PendingPlugins{}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantStatus: []sonarApi.InstalledPlugin{
+				{Key: "php", Version: "3.32.0.10180"},
+			},
+		},
+		{
+			name: "retained plugin is uninstalled after the policy is changed",
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Spec: sonarApi.SonarSpec{
+					PluginRemovalPolicy: sonarApi.PluginRemovalPolicyUninstall,
+				},
+				Status: sonarApi.SonarStatus{
+					InstalledPlugins: []sonarApi.InstalledPlugin{
+						{Key: "php", Version: "3.32.0.10180"},
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) pluginApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetPlugins", mock.Anything).
+					Return([]sonar.Plugin{
+						{Key: "php", Version: "3.32 (build 10180)"},
+					}, nil)
+				m.On("GetPendingPlugins", mock.Anything).
+					Return(&sonar.PendingPlugins{}, nil)
+				m.On("UninstallPlugin", mock.Anything, "php").
+					Return(nil)
+				m.On("Reboot").
+					Return(nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrSonarRestarting)
+			},
+			wantStatus: []sonarApi.InstalledPlugin{
+				{Key: "php", Version: "3.32.0.10180"},
+			},
+		},
+		{
+			name: "pending plugin installation triggers restart",
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Spec: sonarApi.SonarSpec{
+					Plugins: []sonarApi.SonarPlugin{
+						{Key: "go"},
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) pluginApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetPlugins", mock.Anything).
+					Return([]sonar.Plugin{}, nil)
+				m.On("GetPendingPlugins", mock.Anything).
+					Return(&sonar.PendingPlugins{Installing: []sonar.Plugin{{Key: "go"}}}, nil)
+				m.On("Reboot").
+					Return(nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, ErrSonarRestarting)
+			},
+		},
+		{
+			name: "pinned version is not available",
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Spec: sonarApi.SonarSpec{
+					Plugins: []sonarApi.SonarPlugin{
+						{Key: "go", Version: "1.14.0.4481"},
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) pluginApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetPlugins", mock.Anything).
+					Return([]sonar.Plugin{}, nil)
+				m.On("GetPendingPlugins", mock.Anything).
+					Return(&sonar.PendingPlugins{}, nil)
+				m.On("GetAvailablePlugins", mock.Anything).
+					Return([]sonar.AvailablePlugin{
+						{Key: "go", Release: sonar.PluginRelease{Version: "1.15.0.4655"}},
+					}, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "plugin go version 1.14.0.4481 is not available in the marketplace")
+			},
+		},
+		{
+			name: "failed to install plugin",
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Spec: sonarApi.SonarSpec{
+					Plugins: []sonarApi.SonarPlugin{
+						{Key: "go"},
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) pluginApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetPlugins", mock.Anything).
+					Return([]sonar.Plugin{}, nil)
+				m.On("GetPendingPlugins", mock.Anything).
+					Return(&sonar.PendingPlugins{}, nil)
+				m.On("InstallPlugin", mock.Anything, "go").
+					Return(errors.New("failed"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to install plugin go")
+			},
+		},
+		{
+			name: "failed to restart sonar",
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Spec: sonarApi.SonarSpec{
+					Plugins: []sonarApi.SonarPlugin{
+						{Key: "go"},
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) pluginApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetPlugins", mock.Anything).
+					Return([]sonar.Plugin{}, nil)
+				m.On("GetPendingPlugins", mock.Anything).
+					Return(&sonar.PendingPlugins{}, nil)
+				m.On("InstallPlugin", mock.Anything, "go").
+					Return(nil)
+				m.On("Reboot").
+					Return(errors.New("failed"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to restart sonar")
+			},
+		},
+		{
+			name: "failed to get installed plugins",
+			sonar: &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "sonar",
+				},
+				Spec: sonarApi.SonarSpec{
+					Plugins: []sonarApi.SonarPlugin{
+						{Key: "go"},
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) pluginApiClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetPlugins", mock.Anything).
+					Return(nil, errors.New("failed"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get installed plugins")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewSyncPlugins(tt.sonarApiClient(t))
+			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.sonar)
+			tt.wantErr(t, err)
+
+			if err == nil || errors.Is(err, ErrSonarRestarting) {
+				require.Equal(t, tt.wantStatus, tt.sonar.Status.InstalledPlugins)
+			}
+		})
+	}
+}
+
+func TestNormalizePluginVersion(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "7.16.0.30901", normalizePluginVersion("7.16 (build 30901)"))
+	require.Equal(t, "1.15.1.123", normalizePluginVersion("1.15.1 (build 123)"))
+	require.Equal(t, "1.0.0", normalizePluginVersion("1.0.0"))
+}
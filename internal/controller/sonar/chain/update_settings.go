@@ -2,9 +2,7 @@ package chain
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/url"
 	"sort"
 	"strings"
 
@@ -13,123 +11,60 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
-	"github.com/epam/edp-sonar-operator/pkg/sourceref"
+	"github.com/epam/edp-sonar-operator/pkg/settings"
 )
 
 type UpdateSettings struct {
-	sonarApiClient sonar.Settings
-	k8sClient      client.Client
+	settingsSyncer *settings.Syncer
 }
 
 func NewUpdateSettings(sonarApiClient sonar.Settings, k8sClient client.Client) *UpdateSettings {
-	return &UpdateSettings{sonarApiClient: sonarApiClient, k8sClient: k8sClient}
+	return &UpdateSettings{settingsSyncer: settings.NewSyncer(sonarApiClient, k8sClient)}
 }
 
 func (h *UpdateSettings) ServeRequest(ctx context.Context, sonarCR *sonarApi.Sonar) error {
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Start updating settings to sonar")
 
-	// if the user removes a setting from the CR, we need to reset it in Sonar
-	settingsToReset := getSettingsKeysMap(sonarCR.Status)
-	// we need to save processed settings to know which settings we need to reset
-	processedSettings := make([]string, 0, len(sonarCR.Spec.Settings))
-
-	for _, s := range sonarCR.Spec.Settings {
-		setting, err := h.makeSetting(ctx, s, sonarCR.Namespace)
-		if err != nil {
-			return err
-		}
-
-		if err = h.sonarApiClient.SetSetting(ctx, setting); err != nil {
-			return fmt.Errorf("failed to set setting %s: %w", s.Key, err)
-		}
-
-		processedSettings = append(processedSettings, s.Key)
-		delete(settingsToReset, s.Key)
-	}
-
-	if len(settingsToReset) != 0 {
-		if err := h.sonarApiClient.ResetSettings(ctx, settingsKeysMapToSlice(settingsToReset)); err != nil {
-			return fmt.Errorf("failed to reset settings: %w", err)
-		}
+	migrateProcessedSettings(&sonarCR.Status)
+
+	if err := h.settingsSyncer.Sync(
+		ctx,
+		sonarCR,
+		"",
+		sonarCR.Namespace,
+		sonarCR.Spec.Settings,
+		&sonarCR.Status.SettingsStatus,
+	); err != nil {
+		return fmt.Errorf("failed to update sonar settings: %w", err)
 	}
 
-	setProcessedSettings(&sonarCR.Status, processedSettings)
-
 	log.Info("Sonar settings have been updated")
 
 	return nil
 }
 
-func getSettingsKeysMap(status sonarApi.SonarStatus) map[string]struct{} {
-	var processedSettings []string
-	if status.ProcessedSettings != "" {
-		processedSettings = strings.Split(status.ProcessedSettings, ",")
+// migrateProcessedSettings moves the keys from the deprecated ProcessedSettings field to Settings.
+// The migrated settings have no value hashes, so they are not reported as drifted during the first sync.
+func migrateProcessedSettings(status *sonarApi.SonarStatus) {
+	if status.ProcessedSettings == "" {
+		return
 	}
 
-	m := make(map[string]struct{}, len(processedSettings))
-
-	for _, s := range processedSettings {
-		m[s] = struct{}{}
+	processed := make(map[string]struct{}, len(status.Settings))
+	for _, s := range status.Settings {
+		processed[s.Key] = struct{}{}
 	}
 
-	return m
-}
-
-func settingsKeysMapToSlice(m map[string]struct{}) []string {
-	s := make([]string, 0, len(m))
-
-	for k := range m {
-		s = append(s, k)
-	}
-
-	return s
-}
-
-func (h *UpdateSettings) makeSetting(
-	ctx context.Context,
-	setting sonarApi.SonarSetting,
-	namespace string,
-) (url.Values, error) {
-	if setting.FieldValues != nil {
-		// nolint:errchkjson //we can skip error for marshal map[string]string
-		fv, _ := json.Marshal(setting.FieldValues)
-
-		return url.Values{
-			"key":         []string{setting.Key},
-			"fieldValues": []string{string(fv)},
-		}, nil
-	}
-
-	if setting.Values != nil {
-		return url.Values{
-			"key":    []string{setting.Key},
-			"values": setting.Values,
-		}, nil
-	}
-
-	if setting.ValueRef != nil {
-		val, err := sourceref.GetValueFromSourceRef(ctx, setting.ValueRef, namespace, h.k8sClient)
-		if err != nil {
-			return url.Values{}, fmt.Errorf("failed to get sonar setting from source ref: %w", err)
+	for _, key := range strings.Split(status.ProcessedSettings, ",") {
+		if _, ok := processed[key]; !ok {
+			status.Settings = append(status.Settings, sonarApi.ProcessedSetting{Key: key})
 		}
-
-		return newSettingValue(setting.Key, val), nil
 	}
 
-	return newSettingValue(setting.Key, setting.Value), nil
-}
-
-func newSettingValue(key, value string) url.Values {
-	return url.Values{
-		"key":   []string{key},
-		"value": []string{value},
-	}
-}
+	sort.Slice(status.Settings, func(i, j int) bool {
+		return status.Settings[i].Key < status.Settings[j].Key
+	})
 
-func setProcessedSettings(status *sonarApi.SonarStatus, settingsKeys []string) {
-	// we need to sort keys to make sure that we have the same order of keys in the status
-	// to not update ProcessedSettings filed every time
-	sort.Strings(settingsKeys)
-	status.ProcessedSettings = strings.Join(settingsKeys, ",")
+	status.ProcessedSettings = ""
 }
@@ -13,10 +13,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
-	"github.com/epam/edp-sonar-operator/api/common"
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar/mocks"
@@ -32,9 +30,8 @@ func TestUpdateSettings_ServeRequest(t *testing.T) {
 		name           string
 		sonarApiClient func(t *testing.T) sonar.Settings
 		sonar          *sonarApi.Sonar
-		k8sClient      func(t *testing.T) client.Client
 		wantErr        require.ErrorAssertionFunc
-		wantStatus     sonarApi.SonarStatus
+		wantSettings   []string
 	}{
 		{
 			name: "settings is set",
@@ -53,53 +50,23 @@ func TestUpdateSettings_ServeRequest(t *testing.T) {
 							Key:    "sonar.core.b",
 							Values: []string{"value1", "value2"},
 						},
-						{
-							Key: "sonar.core.c",
-							FieldValues: map[string]string{
-								"field1": "value1",
-								"field2": "value2",
-							},
-						},
-						{
-							Key: "sonar.secret",
-							ValueRef: &common.SourceRef{
-								SecretKeyRef: &common.SecretKeySelector{
-									LocalObjectReference: corev1.LocalObjectReference{
-										Name: "sonar-secret",
-									},
-									Key: "secret-key",
-								},
-							},
-						},
 					},
 				},
 			},
 			sonarApiClient: func(t *testing.T) sonar.Settings {
 				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "", []string{"sonar.core.a", "sonar.core.b"}).
+					Return(nil, nil)
 				m.On("SetSetting", mock.Anything, mock.Anything).
-					Return(nil).Times(4)
+					Return(nil).Twice()
 
 				return m
 			},
-			k8sClient: func(t *testing.T) client.Client {
-				return fake.NewClientBuilder().
-					WithScheme(scheme).
-					WithObjects(&corev1.Secret{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "sonar-secret",
-							Namespace: "default",
-						},
-						Data: map[string][]byte{"secret-key": []byte("secret-value")},
-					}).
-					Build()
-			},
-			wantErr: require.NoError,
-			wantStatus: sonarApi.SonarStatus{
-				ProcessedSettings: "sonar.core.a,sonar.core.b,sonar.core.c,sonar.secret",
-			},
+			wantErr:      require.NoError,
+			wantSettings: []string{"sonar.core.a", "sonar.core.b"},
 		},
 		{
-			name: "unset previous settings",
+			name: "processed settings are migrated",
 			sonar: &sonarApi.Sonar{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "sonar",
@@ -118,23 +85,20 @@ func TestUpdateSettings_ServeRequest(t *testing.T) {
 			},
 			sonarApiClient: func(t *testing.T) sonar.Settings {
 				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "", []string{"sonar.core.a"}).
+					Return([]sonar.Setting{{Key: "sonar.core.a", Value: "changed"}}, nil)
 				m.On("SetSetting", mock.Anything, mock.Anything).
 					Return(nil)
-				m.On("ResetSettings", mock.Anything, []string{"sonar.core.b"}).
+				m.On("ResetSettings", mock.Anything, "", []string{"sonar.core.b"}).
 					Return(nil)
 
 				return m
 			},
-			k8sClient: func(t *testing.T) client.Client {
-				return fake.NewClientBuilder().WithScheme(scheme).Build()
-			},
-			wantErr: require.NoError,
-			wantStatus: sonarApi.SonarStatus{
-				ProcessedSettings: "sonar.core.a",
-			},
+			wantErr:      require.NoError,
+			wantSettings: []string{"sonar.core.a"},
 		},
 		{
-			name: "failed to unset previous settings",
+			name: "failed to set setting",
 			sonar: &sonarApi.Sonar{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "sonar",
@@ -148,59 +112,24 @@ func TestUpdateSettings_ServeRequest(t *testing.T) {
 					},
 				},
 				Status: sonarApi.SonarStatus{
-					ProcessedSettings: "sonar.core.a,sonar.core.b",
-				},
-			},
-			sonarApiClient: func(t *testing.T) sonar.Settings {
-				m := mocks.NewMockClientInterface(t)
-				m.On("SetSetting", mock.Anything, mock.Anything).
-					Return(nil)
-				m.On("ResetSettings", mock.Anything, []string{"sonar.core.b"}).
-					Return(errors.New("failed to unset settings"))
-
-				return m
-			},
-			k8sClient: func(t *testing.T) client.Client {
-				return fake.NewClientBuilder().WithScheme(scheme).Build()
-			},
-			wantErr: func(t require.TestingT, err error, i ...interface{}) {
-				require.Error(t, err)
-				require.Contains(t, err.Error(), "failed to unset settings")
-			},
-			wantStatus: sonarApi.SonarStatus{
-				ProcessedSettings: "sonar.core.a,sonar.core.b",
-			},
-		},
-		{
-			name: "failed to set setting",
-			sonar: &sonarApi.Sonar{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "sonar",
-				},
-				Spec: sonarApi.SonarSpec{
-					Settings: []sonarApi.SonarSetting{
-						{
-							Key:   "sonar.core.a",
-							Value: "sonar",
-						},
-					},
+					ProcessedSettings: "sonar.core.a",
 				},
 			},
 			sonarApiClient: func(t *testing.T) sonar.Settings {
 				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "", mock.Anything).
+					Return(nil, nil)
 				m.On("SetSetting", mock.Anything, mock.Anything).
 					Return(errors.New("failed to set setting"))
 
 				return m
 			},
-			k8sClient: func(t *testing.T) client.Client {
-				return fake.NewClientBuilder().WithScheme(scheme).Build()
-			},
 			wantErr: func(t require.TestingT, err error, i ...interface{}) {
 				require.Error(t, err)
-				require.Contains(t, err.Error(), "failed to set setting")
+				require.Contains(t, err.Error(), "failed to update sonar settings")
+				require.Contains(t, err.Error(), "failed to set setting sonar.core.a")
 			},
-			wantStatus: sonarApi.SonarStatus{},
+			wantSettings: []string{"sonar.core.a"},
 		},
 	}
 
@@ -208,11 +137,19 @@ func TestUpdateSettings_ServeRequest(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			h := NewUpdateSettings(tt.sonarApiClient(t), tt.k8sClient(t))
+			h := NewUpdateSettings(tt.sonarApiClient(t), fake.NewClientBuilder().WithScheme(scheme).Build())
 			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.sonar)
 
 			tt.wantErr(t, err)
-			assert.Equal(t, tt.wantStatus, tt.sonar.Status)
+
+			assert.Empty(t, tt.sonar.Status.ProcessedSettings)
+
+			keys := make([]string, 0, len(tt.sonar.Status.Settings))
+			for _, s := range tt.sonar.Status.Settings {
+				keys = append(keys, s.Key)
+			}
+
+			assert.Equal(t, tt.wantSettings, keys)
 		})
 	}
 }
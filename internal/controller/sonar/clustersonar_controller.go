@@ -0,0 +1,137 @@
+package sonar
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
+)
+
+func NewReconcileClusterSonar(
+	k8sClient client.Client,
+	scheme *runtime.Scheme,
+	apiClientProvider apiClientProvider,
+	operatorNamespace string,
+) *ReconcileClusterSonar {
+	return &ReconcileClusterSonar{
+		sonarReconciler:   NewReconcileSonar(k8sClient, scheme, apiClientProvider),
+		operatorNamespace: operatorNamespace,
+	}
+}
+
+// ReconcileClusterSonar reconciles ClusterSonar in the same way as Sonar.
+// The Secrets and ConfigMaps referenced by ClusterSonar are read from the operator namespace.
+type ReconcileClusterSonar struct {
+	sonarReconciler   *ReconcileSonar
+	operatorNamespace string
+}
+
+func (r *ReconcileClusterSonar) SetupWithManager(mgr ctrl.Manager) error {
+	if err := setupReferenceIndexes(context.Background(), mgr, &sonarApi.ClusterSonar{}); err != nil {
+		return fmt.Errorf("failed to setup ClusterSonar reference indexes: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sonarApi.ClusterSonar{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findClusterSonarsForSecret)).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.findClusterSonarsForConfigMap)).
+		Complete(tracing.NewReconciler(common.ClusterSonarKind,
+			events.NewReconciler(mgr.GetEventRecorderFor(events.Component), r)))
+}
+
+// +kubebuilder:rbac:groups=edp.epam.com,resources=clustersonars,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=edp.epam.com,resources=clustersonars/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=edp.epam.com,resources=clustersonars/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *ReconcileClusterSonar) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling ClusterSonar")
+
+	k8sClient := r.sonarReconciler.client
+
+	clusterSonar := &sonarApi.ClusterSonar{}
+	if err := k8sClient.Get(ctx, request.NamespacedName, clusterSonar); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			r.sonarReconciler.apiClientProvider.EvictClient(common.ClusterSonarKind, request.NamespacedName)
+
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, err
+	}
+
+	sonar := clusterSonar.ToSonar(r.operatorNamespace)
+	// the events of the Sonar view are recorded for ClusterSonar.
+	ctx = events.IntoContext(ctx, events.Redirect(events.FromContext(ctx), sonar, clusterSonar))
+
+	result, err := r.sonarReconciler.syncSonar(ctx, sonar)
+
+	if !equality.Semantic.DeepEqual(&sonar.Status, &clusterSonar.Status) {
+		clusterSonar.Status = sonar.Status
+		// the status may have been saved during the sync.
+		clusterSonar.ResourceVersion = sonar.ResourceVersion
+
+		if statusErr := k8sClient.Status().Update(ctx, clusterSonar); statusErr != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to update ClusterSonar status: %w", statusErr)
+		}
+	}
+
+	if err != nil {
+		return result, err
+	}
+
+	log.Info("Reconciling ClusterSonar is finished")
+
+	return result, nil
+}
+
+// findClusterSonarsForSecret returns the requests for ClusterSonar that reference the Secret.
+func (r *ReconcileClusterSonar) findClusterSonarsForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.findClusterSonarsByIndex(ctx, secretRefsIndex, obj)
+}
+
+// findClusterSonarsForConfigMap returns the requests for ClusterSonar that reference the ConfigMap.
+func (r *ReconcileClusterSonar) findClusterSonarsForConfigMap(
+	ctx context.Context,
+	obj client.Object,
+) []reconcile.Request {
+	return r.findClusterSonarsByIndex(ctx, configMapRefsIndex, obj)
+}
+
+func (r *ReconcileClusterSonar) findClusterSonarsByIndex(
+	ctx context.Context,
+	index string,
+	obj client.Object,
+) []reconcile.Request {
+	if obj.GetNamespace() != r.operatorNamespace {
+		return nil
+	}
+
+	sonars := &sonarApi.ClusterSonarList{}
+	if err := r.sonarReconciler.client.List(ctx, sonars, client.MatchingFields{index: obj.GetName()}); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list ClusterSonar by reference", "index", index, "name", obj.GetName())
+
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(sonars.Items))
+
+	for i := range sonars.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&sonars.Items[i])})
+	}
+
+	return requests
+}
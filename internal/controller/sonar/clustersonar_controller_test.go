@@ -0,0 +1,140 @@
+package sonar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+)
+
+type failingApiClientProvider struct {
+	namespace string
+	evicted   []types.NamespacedName
+}
+
+func (p *failingApiClientProvider) GetSonarApiClientFromSonar(
+	_ context.Context,
+	sonar *sonarApi.Sonar,
+) (*sonarclient.Client, error) {
+	p.namespace = sonar.Namespace
+
+	return nil, errors.New("connection refused")
+}
+
+func (p *failingApiClientProvider) EvictClient(_ string, key types.NamespacedName) {
+	p.evicted = append(p.evicted, key)
+}
+
+func TestReconcileClusterSonar_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	clusterSonar := &sonarApi.ClusterSonar{
+		ObjectMeta: metav1.ObjectMeta{Name: "sonar"},
+		Spec:       sonarApi.SonarSpec{Url: "https://sonar.example.com", Secret: "sonar-admin"},
+		Status:     sonarApi.SonarStatus{Connected: true},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(clusterSonar).
+		WithStatusSubresource(clusterSonar).
+		Build()
+
+	provider := &failingApiClientProvider{}
+	r := NewReconcileClusterSonar(k8sClient, scheme, provider, "operators")
+
+	_, err := r.Reconcile(
+		ctrl.LoggerInto(context.Background(), logr.Discard()),
+		reconcile.Request{NamespacedName: types.NamespacedName{Name: "sonar"}},
+	)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "connection refused")
+	assert.Equal(t, "operators", provider.namespace)
+
+	got := &sonarApi.ClusterSonar{}
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKey{Name: "sonar"}, got))
+	assert.False(t, got.Status.Connected)
+	assert.Equal(t, "connection refused", got.Status.Error)
+}
+
+func TestReconcileClusterSonar_Reconcile_Deleted(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	provider := &failingApiClientProvider{}
+	r := NewReconcileClusterSonar(fake.NewClientBuilder().WithScheme(scheme).Build(), scheme, provider, "operators")
+
+	_, err := r.Reconcile(
+		ctrl.LoggerInto(context.Background(), logr.Discard()),
+		reconcile.Request{NamespacedName: types.NamespacedName{Name: "sonar"}},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, []types.NamespacedName{{Name: "sonar"}}, provider.evicted)
+}
+
+func TestReconcileClusterSonar_findClusterSonarsForReferences(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(&sonarApi.ClusterSonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar"},
+			Spec:       sonarApi.SonarSpec{Secret: "sonar-admin"},
+		}).
+		WithIndex(&sonarApi.ClusterSonar{}, secretRefsIndex, indexSecretRefs).
+		WithIndex(&sonarApi.ClusterSonar{}, configMapRefsIndex, indexConfigMapRefs).
+		Build()
+
+	r := NewReconcileClusterSonar(k8sClient, scheme, &failingApiClientProvider{}, "operators")
+
+	tests := []struct {
+		name      string
+		namespace string
+		want      []reconcile.Request
+	}{
+		{
+			name:      "secret from the operator namespace",
+			namespace: "operators",
+			want:      []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "sonar"}}},
+		},
+		{
+			name:      "secret from another namespace",
+			namespace: "default",
+			want:      nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, r.findClusterSonarsForSecret(context.Background(), &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "sonar-admin", Namespace: tt.namespace},
+			}))
+		})
+	}
+}
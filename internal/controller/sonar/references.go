@@ -0,0 +1,180 @@
+package sonar
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+const (
+	// secretRefsIndex is the field index of Sonar by the names of the referenced Secrets.
+	secretRefsIndex = "spec.secretRefs"
+
+	// configMapRefsIndex is the field index of Sonar by the names of the referenced ConfigMaps.
+	configMapRefsIndex = "spec.configMapRefs"
+)
+
+// setupReferenceIndexes registers the field indexes to find Sonar or ClusterSonar
+// by the referenced Secrets and ConfigMaps.
+func setupReferenceIndexes(ctx context.Context, mgr ctrl.Manager, obj client.Object) error {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, obj, secretRefsIndex, indexSecretRefs); err != nil {
+		return err
+	}
+
+	return mgr.GetFieldIndexer().IndexField(ctx, obj, configMapRefsIndex, indexConfigMapRefs)
+}
+
+func indexSecretRefs(obj client.Object) []string {
+	sonar := asSonar(obj)
+	if sonar == nil {
+		return nil
+	}
+
+	return getSecretRefs(sonar)
+}
+
+func indexConfigMapRefs(obj client.Object) []string {
+	sonar := asSonar(obj)
+	if sonar == nil {
+		return nil
+	}
+
+	return getConfigMapRefs(sonar)
+}
+
+// asSonar returns Sonar or the Sonar view of ClusterSonar to get the references from the spec.
+func asSonar(obj client.Object) *sonarApi.Sonar {
+	switch o := obj.(type) {
+	case *sonarApi.Sonar:
+		return o
+	case *sonarApi.ClusterSonar:
+		return o.ToSonar("")
+	default:
+		return nil
+	}
+}
+
+// getSecretRefs returns the names of the Secrets that are referenced by Sonar.
+// The Secret with the operator API token is not included because it is managed by the operator.
+func getSecretRefs(sonar *sonarApi.Sonar) []string {
+	refs := newRefSet()
+	refs.add(sonar.Spec.Secret)
+
+	for _, s := range sonar.Spec.Settings {
+		refs.addSecretSourceRef(s.ValueRef)
+	}
+
+	for _, h := range sonar.Spec.Headers {
+		refs.addSecretSourceRef(h.ValueRef)
+	}
+
+	if sonar.Spec.TLS != nil {
+		refs.addSecretSourceRef(sonar.Spec.TLS.CA)
+		refs.add(sonar.Spec.TLS.ClientCertSecret)
+	}
+
+	if sonar.Spec.Proxy != nil {
+		refs.add(sonar.Spec.Proxy.CredentialsSecret)
+	}
+
+	if sonar.Spec.AdminCredentials != nil {
+		refs.add(sonar.Spec.AdminCredentials.InitialSecret)
+		refs.add(sonar.Spec.AdminCredentials.DesiredSecret)
+	}
+
+	if sonar.Spec.License != nil {
+		refs.add(sonar.Spec.License.SecretKeyRef.Name)
+	}
+
+	return refs.names
+}
+
+// getConfigMapRefs returns the names of the ConfigMaps that are referenced by Sonar.
+func getConfigMapRefs(sonar *sonarApi.Sonar) []string {
+	refs := newRefSet()
+
+	for _, s := range sonar.Spec.Settings {
+		refs.addConfigMapSourceRef(s.ValueRef)
+	}
+
+	for _, h := range sonar.Spec.Headers {
+		refs.addConfigMapSourceRef(h.ValueRef)
+	}
+
+	if sonar.Spec.TLS != nil {
+		refs.addConfigMapSourceRef(sonar.Spec.TLS.CA)
+	}
+
+	return refs.names
+}
+
+// findSonarsForSecret returns the requests for Sonar that reference the Secret.
+func (r *ReconcileSonar) findSonarsForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.findSonarsByIndex(ctx, secretRefsIndex, obj)
+}
+
+// findSonarsForConfigMap returns the requests for Sonar that reference the ConfigMap.
+func (r *ReconcileSonar) findSonarsForConfigMap(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.findSonarsByIndex(ctx, configMapRefsIndex, obj)
+}
+
+func (r *ReconcileSonar) findSonarsByIndex(ctx context.Context, index string, obj client.Object) []reconcile.Request {
+	sonars := &sonarApi.SonarList{}
+	if err := r.client.List(
+		ctx,
+		sonars,
+		client.InNamespace(obj.GetNamespace()),
+		client.MatchingFields{index: obj.GetName()},
+	); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list Sonar by reference", "index", index, "name", obj.GetName())
+
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(sonars.Items))
+
+	for i := range sonars.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&sonars.Items[i])})
+	}
+
+	return requests
+}
+
+type refSet struct {
+	names []string
+	seen  map[string]struct{}
+}
+
+func newRefSet() *refSet {
+	return &refSet{seen: map[string]struct{}{}}
+}
+
+func (s *refSet) add(name string) {
+	if name == "" {
+		return
+	}
+
+	if _, ok := s.seen[name]; ok {
+		return
+	}
+
+	s.seen[name] = struct{}{}
+	s.names = append(s.names, name)
+}
+
+func (s *refSet) addSecretSourceRef(ref *common.SourceRef) {
+	if ref != nil && ref.SecretKeyRef != nil {
+		s.add(ref.SecretKeyRef.Name)
+	}
+}
+
+func (s *refSet) addConfigMapSourceRef(ref *common.SourceRef) {
+	if ref != nil && ref.ConfigMapKeyRef != nil {
+		s.add(ref.ConfigMapKeyRef.Name)
+	}
+}
@@ -0,0 +1,145 @@
+package sonar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestReconcileSonar_findSonarsForReferences(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	secretRef := func(name string) *common.SourceRef {
+		return &common.SourceRef{
+			SecretKeyRef: &common.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				Key:                  "key",
+			},
+		}
+	}
+
+	configMapRef := func(name string) *common.SourceRef {
+		return &common.SourceRef{
+			ConfigMapKeyRef: &common.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				Key:                  "key",
+			},
+		}
+	}
+
+	sonarWithRefs := &sonarApi.Sonar{
+		ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "default"},
+		Spec: sonarApi.SonarSpec{
+			Secret: "sonar-admin",
+			Settings: []sonarApi.SonarSetting{
+				{Key: "sonar.secret", ValueRef: secretRef("sonar-settings")},
+				{Key: "sonar.config", ValueRef: configMapRef("sonar-settings")},
+				{Key: "sonar.secret2", ValueRef: secretRef("sonar-settings")},
+			},
+			Headers: []sonarApi.SonarHeader{
+				{Name: "X-Gateway-Key", ValueRef: secretRef("gateway")},
+			},
+			TLS: &sonarApi.SonarTLS{
+				CA:               configMapRef("sonar-ca"),
+				ClientCertSecret: "sonar-client-cert",
+			},
+			Proxy:            &sonarApi.SonarProxy{Url: "http://proxy:3128", CredentialsSecret: "proxy"},
+			AdminCredentials: &sonarApi.AdminCredentials{DesiredSecret: "sonar-admin-password"},
+			TokenAuth:        &sonarApi.TokenAuth{Secret: "sonar-operator-token"},
+			License: &sonarApi.SonarLicense{
+				SecretKeyRef: common.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "sonar-license"},
+					Key:                  "license",
+				},
+			},
+		},
+	}
+
+	otherSonar := &sonarApi.Sonar{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+		Spec:       sonarApi.SonarSpec{Secret: "other-admin"},
+	}
+
+	assert.Equal(t,
+		[]string{
+			"sonar-admin", "sonar-settings", "gateway", "sonar-client-cert", "proxy", "sonar-admin-password", "sonar-license",
+		},
+		getSecretRefs(sonarWithRefs),
+	)
+	assert.Equal(t, []string{"sonar-settings", "sonar-ca"}, getConfigMapRefs(sonarWithRefs))
+
+	r := &ReconcileSonar{
+		client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(sonarWithRefs, otherSonar).
+			WithIndex(&sonarApi.Sonar{}, secretRefsIndex, indexSecretRefs).
+			WithIndex(&sonarApi.Sonar{}, configMapRefsIndex, indexConfigMapRefs).
+			Build(),
+	}
+
+	tests := []struct {
+		name string
+		find func(ctx context.Context) []reconcile.Request
+		want []reconcile.Request
+	}{
+		{
+			name: "secret is referenced by settings",
+			find: func(ctx context.Context) []reconcile.Request {
+				return r.findSonarsForSecret(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "sonar-settings", Namespace: "default"},
+				})
+			},
+			want: []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "sonar", Namespace: "default"}}},
+		},
+		{
+			name: "config map is referenced by tls",
+			find: func(ctx context.Context) []reconcile.Request {
+				return r.findSonarsForConfigMap(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "sonar-ca", Namespace: "default"},
+				})
+			},
+			want: []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "sonar", Namespace: "default"}}},
+		},
+		{
+			name: "operator token secret is not watched",
+			find: func(ctx context.Context) []reconcile.Request {
+				return r.findSonarsForSecret(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "sonar-operator-token", Namespace: "default"},
+				})
+			},
+			want: []reconcile.Request{},
+		},
+		{
+			name: "secret from another namespace",
+			find: func(ctx context.Context) []reconcile.Request {
+				return r.findSonarsForSecret(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "sonar-admin", Namespace: "other"},
+				})
+			},
+			want: []reconcile.Request{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.find(context.Background()))
+		})
+	}
+}
@@ -2,19 +2,27 @@ package sonar
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/epam/edp-sonar-operator/internal/controller/sonar/chain"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/epam/edp-sonar-operator/api/common"
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
 )
 
 const (
@@ -24,6 +32,7 @@ const (
 
 type apiClientProvider interface {
 	GetSonarApiClientFromSonar(ctx context.Context, sonar *sonarApi.Sonar) (*sonarclient.Client, error)
+	EvictClient(kind string, key types.NamespacedName)
 }
 
 func NewReconcileSonar(
@@ -45,15 +54,25 @@ type ReconcileSonar struct {
 }
 
 func (r *ReconcileSonar) SetupWithManager(mgr ctrl.Manager) error {
+	if err := setupReferenceIndexes(context.Background(), mgr, &sonarApi.Sonar{}); err != nil {
+		return fmt.Errorf("failed to setup Sonar reference indexes: %w", err)
+	}
+
+	// Secrets and ConfigMaps referenced by Sonar are watched to apply their changes without waiting for requeue.
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&sonarApi.Sonar{}).
-		Complete(r)
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findSonarsForSecret)).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.findSonarsForConfigMap)).
+		Complete(tracing.NewReconciler(common.SonarKind,
+			events.NewReconciler(mgr.GetEventRecorderFor(events.Component), r)))
 }
 
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=sonars,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=sonars/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=sonars/finalizers,verbs=update
-// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
 
 func (r *ReconcileSonar) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -62,52 +81,78 @@ func (r *ReconcileSonar) Reconcile(ctx context.Context, request reconcile.Reques
 	sonar := &sonarApi.Sonar{}
 	if err := r.client.Get(ctx, request.NamespacedName, sonar); err != nil {
 		if k8sErrors.IsNotFound(err) {
+			r.apiClientProvider.EvictClient(common.SonarKind, request.NamespacedName)
+
 			return reconcile.Result{}, nil
 		}
 
 		return reconcile.Result{}, err
 	}
 
-	oldStatus := sonar.Status
+	oldStatus := sonar.Status.DeepCopy()
+
+	result, err := r.syncSonar(ctx, sonar)
+
+	if statusErr := r.updateSonarStatus(ctx, sonar, oldStatus); statusErr != nil {
+		return reconcile.Result{}, statusErr
+	}
+
+	if err != nil {
+		return result, err
+	}
+
+	log.Info("Reconciling Sonar is finished")
 
+	return result, nil
+}
+
+// syncSonar connects to sonar and applies the spec, the result is reflected in the status.
+// It is shared by Sonar and ClusterSonar that is synced through its Sonar view.
+func (r *ReconcileSonar) syncSonar(ctx context.Context, sonar *sonarApi.Sonar) (reconcile.Result, error) {
 	sonarApiClient, err := r.apiClientProvider.GetSonarApiClientFromSonar(ctx, sonar)
 	if err != nil {
+		events.ConnectionFailed(ctx, sonar, err)
+
 		sonar.Status.Error = err.Error()
 		sonar.Status.Connected = false
-
-		if statusErr := r.updateSonarStatus(ctx, sonar, oldStatus); statusErr != nil {
-			return reconcile.Result{}, statusErr
-		}
+		sonar.Status.SetSonarConnected(sonar.Generation, err)
 
 		return reconcile.Result{RequeueAfter: defaultRequeueTime}, err
 	}
 
-	if err = chain.MakeChain(sonarApiClient, r.client).ServeRequest(ctx, sonar); err != nil {
-		sonar.Status.Error = err.Error()
+	makeChain := chain.MakeChain
+	if sonar.Spec.IsSonarCloud() {
+		makeChain = chain.MakeSonarCloudChain
+	}
 
-		if statusErr := r.updateSonarStatus(ctx, sonar, oldStatus); statusErr != nil {
-			return reconcile.Result{}, statusErr
+	if err = makeChain(sonarApiClient, r.client).ServeRequest(ctx, sonar); err != nil {
+		if errors.Is(err, chain.ErrSonarRestarting) || errors.Is(err, chain.ErrSonarNotUp) {
+			ctrl.LoggerFrom(ctx).Info("Waiting for sonar to be up", "reason", err.Error())
+
+			sonar.Status.SetSynced(sonar.Generation, err)
+
+			return reconcile.Result{RequeueAfter: defaultRequeueTime}, nil
 		}
 
+		events.Warning(ctx, sonar, events.ReasonSyncFailed, err)
+
+		sonar.Status.Error = err.Error()
+		sonar.Status.SetSynced(sonar.Generation, err)
+
 		return reconcile.Result{RequeueAfter: defaultRequeueTime}, err
 	}
 
 	sonar.Status.Connected = true
 	sonar.Status.Error = ""
-
-	if err = r.updateSonarStatus(ctx, sonar, oldStatus); err != nil {
-		return reconcile.Result{}, err
-	}
-
-	log.Info("Reconciling Sonar is finished")
+	sonar.Status.SetSynced(sonar.Generation, nil)
 
 	return reconcile.Result{
 		RequeueAfter: successRequeueTime,
 	}, nil
 }
 
-func (r *ReconcileSonar) updateSonarStatus(ctx context.Context, sonar *sonarApi.Sonar, oldStatus sonarApi.SonarStatus) error {
-	if sonar.Status == oldStatus {
+func (r *ReconcileSonar) updateSonarStatus(ctx context.Context, sonar *sonarApi.Sonar, oldStatus *sonarApi.SonarStatus) error {
+	if equality.Semantic.DeepEqual(&sonar.Status, oldStatus) {
 		return nil
 	}
 
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -85,12 +86,15 @@ var _ = Describe("Sonar controller", func() {
 
 			g.Expect(createdSonar.Status.Connected).Should(BeTrue(), "Sonar should be connected")
 			g.Expect(createdSonar.Status.Error).Should(BeEmpty(), "Error should be empty")
+			g.Expect(meta.IsStatusConditionTrue(createdSonar.Status.Conditions, common.ConditionReady)).
+				Should(BeTrue(), "Sonar should be ready")
 			g.Expect(createdSonar.Status.Value).ShouldNot(BeEmpty(), "Value should not be empty")
-			g.Expect(createdSonar.Status.ProcessedSettings).
-				Should(
-					Equal("email.smtp_password.secured,sonar.dbcleaner.hoursBeforeKeepingOnlyOneSnapshotByDay,sonar.global.exclusions,sonar.issue.ignore.block"),
-					"Processed settings should be equal",
-				)
+			g.Expect(createdSonar.Status.Settings).Should(HaveLen(4), "All settings should be processed")
+
+			for _, s := range createdSonar.Status.Settings {
+				g.Expect(s.Error).Should(BeEmpty(), "Setting %s should be applied", s.Key)
+				g.Expect(s.ValueHash).ShouldNot(BeEmpty(), "Setting %s should have value hash", s.Key)
+			}
 		}).WithTimeout(timeout).WithPolling(interval).Should(Succeed())
 	})
 })
@@ -0,0 +1,130 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/internal/controller/sonar/chain"
+	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+)
+
+type staticApiClientProvider struct {
+	client *sonarclient.Client
+}
+
+func (p *staticApiClientProvider) GetSonarApiClientFromSonar(
+	_ context.Context,
+	_ *sonarApi.Sonar,
+) (*sonarclient.Client, error) {
+	return p.client, nil
+}
+
+func (p *staticApiClientProvider) EvictClient(_ string, _ types.NamespacedName) {}
+
+func TestReconcileSonar_Reconcile_SonarRestarting(t *testing.T) {
+	t.Parallel()
+
+	restartRequested := []metav1.Condition{{
+		Type:    common.ConditionSynced,
+		Status:  metav1.ConditionFalse,
+		Reason:  common.ReasonSyncFailed,
+		Message: "failed to serve handler: " + chain.ErrSonarRestarting.Error(),
+	}}
+
+	tests := []struct {
+		name        string
+		conditions  []metav1.Condition
+		handler     http.HandlerFunc
+		wantMessage string
+	}{
+		{
+			name:       "sonar is not reachable after restart",
+			conditions: restartRequested,
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			wantMessage: chain.ErrSonarRestarting.Error(),
+		},
+		{
+			name:       "sonar is restarting",
+			conditions: restartRequested,
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"RESTARTING"}`))
+			},
+			wantMessage: chain.ErrSonarRestarting.Error(),
+		},
+		{
+			name: "sonar is migrating database",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"status":"DB_MIGRATION_RUNNING"}`))
+			},
+			wantMessage: chain.ErrSonarNotUp.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(tt.handler)
+			t.Cleanup(server.Close)
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, sonarApi.AddToScheme(scheme))
+
+			sonar := &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "default"},
+				Spec:       sonarApi.SonarSpec{Url: server.URL, Secret: "sonar-admin"},
+				Status: sonarApi.SonarStatus{
+					Connected:       true,
+					ReconcileStatus: common.ReconcileStatus{Conditions: tt.conditions},
+				},
+			}
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(sonar).
+				WithStatusSubresource(sonar).
+				Build()
+
+			r := NewReconcileSonar(k8sClient, scheme, &staticApiClientProvider{
+				client: sonarclient.NewClient(server.URL, "admin", "admin"),
+			})
+
+			result, err := r.Reconcile(
+				ctrl.LoggerInto(context.Background(), logr.Discard()),
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "sonar", Namespace: "default"}},
+			)
+
+			require.NoError(t, err)
+			assert.Equal(t, defaultRequeueTime, result.RequeueAfter)
+
+			got := &sonarApi.Sonar{}
+			require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(sonar), got))
+			assert.Empty(t, got.Status.Error)
+
+			synced := meta.FindStatusCondition(got.Status.Conditions, common.ConditionSynced)
+			require.NotNil(t, synced)
+			assert.Equal(t, metav1.ConditionFalse, synced.Status)
+			assert.Contains(t, synced.Message, tt.wantMessage)
+		})
+	}
+}
@@ -84,7 +84,7 @@ var _ = BeforeSuite(func() {
 	err = NewReconcileSonar(
 		k8sManager.GetClient(),
 		k8sManager.GetScheme(),
-		sonarclient.NewApiClientProvider(k8sManager.GetClient()),
+		sonarclient.NewApiClientProvider(k8sManager.GetClient(), "default"),
 	).
 		SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
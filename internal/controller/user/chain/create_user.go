@@ -3,7 +3,6 @@ package chain
 import (
 	"context"
 	"fmt"
-	"reflect"
 
 	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -11,6 +10,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 // CreateUser is handler for creating sonar user.
@@ -57,15 +57,14 @@ func (h CreateUser) ServeRequest(ctx context.Context, user *sonarApi.SonarUser)
 		}
 
 		log.Info("User has been created")
+		events.Normal(ctx, user, events.ReasonCreated, "User %s has been created", user.Spec.Login)
 
 		return nil
 	}
 
 	log.Info("User already exists, updating")
 
-	// to check if user needs to be updated we need to clear password as it is not returned by sonar
-	sonarUser.Password = ""
-	if reflect.DeepEqual(sonarUser, existingUser) {
+	if !userChanged(existingUser, sonarUser) {
 		log.Info("User already up to date")
 		return nil
 	}
@@ -75,6 +74,18 @@ func (h CreateUser) ServeRequest(ctx context.Context, user *sonarApi.SonarUser)
 	}
 
 	log.Info("User has been updated")
+	events.Normal(ctx, user, events.ReasonUpdated, "User %s has been updated", user.Spec.Login)
 
 	return nil
 }
+
+// userChanged checks if the user in sonar differs from the desired one.
+// The password and the ID are not compared because sonar doesn't return the password,
+// and the ID is generated by sonar. An empty email is not managed by the operator.
+func userChanged(existing, desired *sonar.User) bool {
+	if existing.Login != desired.Login || existing.Name != desired.Name {
+		return true
+	}
+
+	return desired.Email != "" && existing.Email != desired.Email
+}
@@ -102,6 +102,46 @@ func TestCreateUser_ServeRequest(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "user returned by v2 API is up to date",
+			user: &sonarApi.SonarUser{
+				Spec: sonarApi.SonarUserSpec{
+					Login:  "test-user",
+					Secret: "test-secret",
+					Name:   "test-name",
+				},
+			},
+			client: func(t *testing.T) client.Client {
+				s := runtime.NewScheme()
+
+				require.NoError(t, sonarApi.AddToScheme(s))
+				require.NoError(t, corev1.AddToScheme(s))
+
+				secret := corev1.Secret{
+					ObjectMeta: ctrl.ObjectMeta{
+						Name: "test-secret",
+					},
+					Data: map[string][]byte{
+						"password": []byte("test-password"),
+					},
+				}
+
+				return fake.NewClientBuilder().WithScheme(s).WithObjects(&secret).Build()
+			},
+			sonarApiClient: func(t *testing.T) sonar.UserInterface {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetUserByLogin", mock.Anything, "test-user").
+					Return(&sonar.User{
+						ID:    "user-id",
+						Login: "test-user",
+						Name:  "test-name",
+						Email: "external@example.com",
+					}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
 		{
 			name: "user already exists, update it",
 			user: &sonarApi.SonarUser{
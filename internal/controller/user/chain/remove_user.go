@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 // RemoveUser is handler for removing sonar user.
@@ -30,6 +31,7 @@ func (r RemoveUser) ServeRequest(ctx context.Context, user *sonarApi.SonarUser)
 	}
 
 	log.Info("User has been deleted")
+	events.Normal(ctx, user, events.ReasonDeleted, "User %s has been deactivated", user.Spec.Login)
 
 	return nil
 }
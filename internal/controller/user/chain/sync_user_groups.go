@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 	"github.com/epam/edp-sonar-operator/pkg/helper"
 )
 
@@ -49,6 +50,7 @@ func (h SyncUserGroups) ServeRequest(ctx context.Context, user *sonarApi.SonarUs
 		}
 
 		log.Info("User has been removed from group", "group", g)
+		events.Normal(ctx, user, events.ReasonRemoved, "User has been removed from group %s", g)
 	}
 
 	for g := range currentGroups {
@@ -57,6 +59,7 @@ func (h SyncUserGroups) ServeRequest(ctx context.Context, user *sonarApi.SonarUs
 		}
 
 		log.Info("User has been added to group", "group", g)
+		events.Normal(ctx, user, events.ReasonAdded, "User has been added to group %s", g)
 	}
 
 	log.Info("User groups have been synced")
@@ -6,13 +6,16 @@ import (
 	"testing"
 
 	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar/mocks"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 )
 
 func TestSyncUserGroups_ServeRequest(t *testing.T) {
@@ -23,6 +26,7 @@ func TestSyncUserGroups_ServeRequest(t *testing.T) {
 		user           *sonarApi.SonarUser
 		sonarApiClient func(t *testing.T) sonarApiUserGroupClient
 		wantErr        require.ErrorAssertionFunc
+		wantEvents     []string
 	}{
 		{
 			name: "user groups synced successfully",
@@ -50,6 +54,10 @@ func TestSyncUserGroups_ServeRequest(t *testing.T) {
 				return m
 			},
 			wantErr: require.NoError,
+			wantEvents: []string{
+				"Normal Removed User has been removed from group test-group-3",
+				"Normal Added User has been added to group test-group-2",
+			},
 		},
 		{
 			name: "failed to add user to group",
@@ -80,6 +88,9 @@ func TestSyncUserGroups_ServeRequest(t *testing.T) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "failed to add user to group")
 			},
+			wantEvents: []string{
+				"Normal Removed User has been removed from group test-group-3",
+			},
 		},
 		{
 			name: "failed to remove user from group",
@@ -135,9 +146,21 @@ func TestSyncUserGroups_ServeRequest(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
+			recorder := record.NewFakeRecorder(len(tt.wantEvents))
+			ctx := events.IntoContext(ctrl.LoggerInto(context.Background(), logr.Discard()), recorder)
+
 			h := NewSyncUserGroups(tt.sonarApiClient(t))
-			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.user)
+			err := h.ServeRequest(ctx, tt.user)
 			tt.wantErr(t, err)
+
+			close(recorder.Events)
+
+			var gotEvents []string
+			for e := range recorder.Events {
+				gotEvents = append(gotEvents, e)
+			}
+
+			assert.Equal(t, tt.wantEvents, gotEvents)
 		})
 	}
 }
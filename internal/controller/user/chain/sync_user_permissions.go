@@ -8,6 +8,7 @@ import (
 
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
 	"github.com/epam/edp-sonar-operator/pkg/helper"
 )
 
@@ -44,6 +45,7 @@ func (h SyncUserPermissions) ServeRequest(ctx context.Context, user *sonarApi.So
 		}
 
 		log.Info("User permission has been removed", "permission", p)
+		events.Normal(ctx, user, events.ReasonRemoved, "Permission %s has been removed from user", p)
 	}
 
 	for g := range currentPermissions {
@@ -52,6 +54,7 @@ func (h SyncUserPermissions) ServeRequest(ctx context.Context, user *sonarApi.So
 		}
 
 		log.Info("User permission has been added", "permission", g)
+		events.Normal(ctx, user, events.ReasonAdded, "Permission %s has been added to user", g)
 	}
 
 	log.Info("User permissions have been synced")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/epam/edp-sonar-operator/internal/controller/user/chain"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -16,6 +17,8 @@ import (
 	"github.com/epam/edp-sonar-operator/api/common"
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
 	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
 )
 
 const (
@@ -67,17 +70,44 @@ func (r *SonarUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	sonarApiClient, err := r.apiClientProvider.GetSonarApiClientFromSonarRef(ctx, req.Namespace, user)
 	if err != nil {
+		if user.GetDeletionTimestamp() != nil && sonarclient.IsErrSonarRefUnavailable(err) {
+			// the resource can't be cleaned up in sonar, so only the finalizer is removed to not block the deletion.
+			log.Info("Sonar is unavailable, removing finalizer without cleanup in sonar", "reason", err.Error())
+			events.Warning(ctx, user, events.ReasonCleanupSkipped, err)
+
+			if controllerutil.RemoveFinalizer(user, sonarOperatorFinalizer) {
+				if err = r.client.Update(ctx, user); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+
+			return ctrl.Result{}, nil
+		}
+
 		log.Error(err, "An error has occurred while getting sonar api client")
+		events.ConnectionFailed(ctx, user, err)
+
+		oldStatus := user.Status.DeepCopy()
+		user.Status.SetSonarConnected(user.Generation, err)
+
+		if err = r.updateSonarUserStatus(ctx, user, oldStatus); err != nil {
+			return ctrl.Result{}, err
+		}
 
 		return ctrl.Result{
 			RequeueAfter: errorRequeueTime,
 		}, nil
 	}
 
+	if sonarApiClient.IsSonarCloud() {
+		return ctrl.Result{}, r.rejectSonarCloudUser(ctx, user)
+	}
+
 	if user.GetDeletionTimestamp() != nil {
 		if controllerutil.ContainsFinalizer(user, sonarOperatorFinalizer) {
 			if err = chain.NewRemoveUser(sonarApiClient).ServeRequest(ctx, user); err != nil {
 				log.Error(err, "An error has occurred while deleting SonarUser")
+				events.Warning(ctx, user, events.ReasonDeletionFailed, err)
 
 				return ctrl.Result{
 					RequeueAfter: errorRequeueTime,
@@ -101,13 +131,16 @@ func (r *SonarUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		}
 	}
 
-	oldStatus := user.Status
+	oldStatus := user.Status.DeepCopy()
 
 	if err = chain.MakeChain(sonarApiClient, r.client).ServeRequest(ctx, user); err != nil {
 		log.Error(err, "An error has occurred while handling SonarUser")
+		events.Warning(ctx, user, events.ReasonSyncFailed, err)
 
 		user.Status.Value = "error"
 		user.Status.Error = err.Error()
+		user.Status.SetSynced(user.Generation, err)
+		sonarclient.SetConnectionCondition(&user.Status.ReconcileStatus, user.Generation, err)
 
 		if err = r.updateSonarUserStatus(ctx, user, oldStatus); err != nil {
 			return ctrl.Result{}, err
@@ -120,6 +153,8 @@ func (r *SonarUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 	user.Status.Value = common.StatusCreated
 	user.Status.Error = ""
+	user.Status.SetSynced(user.Generation, nil)
+	user.Status.SetSonarConnected(user.Generation, nil)
 
 	if err = r.updateSonarUserStatus(ctx, user, oldStatus); err != nil {
 		return ctrl.Result{}, err
@@ -132,11 +167,36 @@ func (r *SonarUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 func (r *SonarUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&sonarApi.SonarUser{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(common.SonarUserKind,
+			events.NewReconciler(mgr.GetEventRecorderFor(events.Component), r)))
+}
+
+// rejectSonarCloudUser sets the Synced condition of the user that references SonarCloud,
+// because SonarCloud users are managed by the identity provider and can't be created with the API.
+// The finalizer is removed on deletion since nothing was created in SonarCloud.
+func (r *SonarUserReconciler) rejectSonarCloudUser(ctx context.Context, user *sonarApi.SonarUser) error {
+	if user.GetDeletionTimestamp() != nil {
+		if controllerutil.RemoveFinalizer(user, sonarOperatorFinalizer) {
+			return r.client.Update(ctx, user)
+		}
+
+		return nil
+	}
+
+	err := fmt.Errorf("SonarUser is %w, users are managed by the identity provider", common.ErrNotSupportedBySonarCloud)
+	events.Warning(ctx, user, events.ReasonNotSupported, err)
+
+	oldStatus := user.Status.DeepCopy()
+	user.Status.Value = "error"
+	user.Status.Error = err.Error()
+	user.Status.SetSynced(user.Generation, err)
+	user.Status.SetSonarConnected(user.Generation, nil)
+
+	return r.updateSonarUserStatus(ctx, user, oldStatus)
 }
 
-func (r *SonarUserReconciler) updateSonarUserStatus(ctx context.Context, sonarUser *sonarApi.SonarUser, oldStatus sonarApi.SonarUserStatus) error {
-	if sonarUser.Status == oldStatus {
+func (r *SonarUserReconciler) updateSonarUserStatus(ctx context.Context, sonarUser *sonarApi.SonarUser, oldStatus *sonarApi.SonarUserStatus) error {
+	if equality.Semantic.DeepEqual(&sonarUser.Status, oldStatus) {
 		return nil
 	}
 
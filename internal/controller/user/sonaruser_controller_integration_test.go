@@ -6,6 +6,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -54,7 +55,9 @@ var _ = Describe("SonarUser controller", func() {
 				return false
 			}
 
-			return createdSonarUser.Status.Value == common.StatusCreated && createdSonarUser.Status.Error == ""
+			return createdSonarUser.Status.Value == common.StatusCreated &&
+				createdSonarUser.Status.Error == "" &&
+				meta.IsStatusConditionTrue(createdSonarUser.Status.Conditions, common.ConditionReady)
 		}, timeout, interval).Should(BeTrue())
 	})
 	It("Should delete SonarUser object", func() {
@@ -0,0 +1,118 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+)
+
+func TestSonarUserReconciler_Reconcile_SonarCloud(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		user  *sonarApi.SonarUser
+		check func(t *testing.T, k8sClient client.Client, user *sonarApi.SonarUser)
+	}{
+		{
+			name: "user is rejected",
+			user: &sonarApi.SonarUser{
+				ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "default"},
+				Spec: sonarApi.SonarUserSpec{
+					Login:    "user",
+					Name:     "User",
+					Secret:   "user-secret",
+					SonarRef: common.SonarRef{Name: "sonarcloud"},
+				},
+			},
+			check: func(t *testing.T, k8sClient client.Client, user *sonarApi.SonarUser) {
+				got := &sonarApi.SonarUser{}
+				require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(user), got))
+				assert.Empty(t, got.Finalizers)
+				assert.Contains(t, got.Status.Error, common.ErrNotSupportedBySonarCloud.Error())
+
+				synced := meta.FindStatusCondition(got.Status.Conditions, common.ConditionSynced)
+				require.NotNil(t, synced)
+				assert.Equal(t, common.ReasonNotSupported, synced.Reason)
+			},
+		},
+		{
+			name: "finalizer of the deleted user is removed",
+			user: &sonarApi.SonarUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "user",
+					Namespace:         "default",
+					Finalizers:        []string{sonarOperatorFinalizer},
+					DeletionTimestamp: &metav1.Time{Time: metav1.Now().Time},
+				},
+				Spec: sonarApi.SonarUserSpec{
+					Login:    "user",
+					Name:     "User",
+					Secret:   "user-secret",
+					SonarRef: common.SonarRef{Name: "sonarcloud"},
+				},
+			},
+			check: func(t *testing.T, k8sClient client.Client, user *sonarApi.SonarUser) {
+				err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(user), &sonarApi.SonarUser{})
+				assert.True(t, k8sErrors.IsNotFound(err), "user must be deleted after the finalizer is removed")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(scheme))
+			require.NoError(t, sonarApi.AddToScheme(scheme))
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					&sonarApi.Sonar{
+						ObjectMeta: metav1.ObjectMeta{Name: "sonarcloud", Namespace: "default"},
+						Spec: sonarApi.SonarSpec{
+							Url:          "https://sonarcloud.io",
+							Secret:       "sonar-admin",
+							Flavor:       sonarApi.FlavorSonarCloud,
+							Organization: "my-org",
+						},
+						Status: sonarApi.SonarStatus{Connected: true},
+					},
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "sonar-admin", Namespace: "default"},
+						Data:       map[string][]byte{"user": []byte("token"), "password": []byte("")},
+					},
+					tt.user,
+				).
+				WithStatusSubresource(tt.user).
+				Build()
+
+			r := NewSonarUserReconciler(k8sClient, scheme, sonarclient.NewApiClientProvider(k8sClient, "default"))
+
+			_, err := r.Reconcile(
+				ctrl.LoggerInto(context.Background(), logr.Discard()),
+				ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tt.user)},
+			)
+			require.NoError(t, err)
+
+			tt.check(t, k8sClient, tt.user)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
+)
+
+type SonarWebhookHandler interface {
+	ServeRequest(context.Context, *sonarApi.SonarWebhook) error
+}
+
+type chain struct {
+	handlers []SonarWebhookHandler
+}
+
+func (ch *chain) Use(handlers ...SonarWebhookHandler) {
+	ch.handlers = append(ch.handlers, handlers...)
+}
+
+func (ch *chain) ServeRequest(ctx context.Context, s *sonarApi.SonarWebhook) error {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Starting SonarWebhook chain")
+
+	for i := 0; i < len(ch.handlers); i++ {
+		h := ch.handlers[i]
+
+		handlerCtx, span := tracing.StartHandler(ctx, h)
+		err := h.ServeRequest(handlerCtx, s)
+
+		tracing.End(span, err)
+
+		if err != nil {
+			return fmt.Errorf("failed to serve handler: %w", err)
+		}
+	}
+
+	log.Info("Handling of SonarWebhook has been finished")
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package chain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+	"github.com/epam/edp-sonar-operator/pkg/sourceref"
+)
+
+type CreateWebhook struct {
+	sonarApiClient sonar.WebhookClient
+	k8sClient      client.Client
+}
+
+func NewCreateWebhook(sonarApiClient sonar.WebhookClient, k8sClient client.Client) *CreateWebhook {
+	return &CreateWebhook{sonarApiClient: sonarApiClient, k8sClient: k8sClient}
+}
+
+func (h *CreateWebhook) ServeRequest(ctx context.Context, webhook *sonarApi.SonarWebhook) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", webhook.Spec.Name, "project", webhook.Spec.ProjectKey)
+	log.Info("Start creating webhook")
+
+	secret, err := h.getSecret(ctx, webhook)
+	if err != nil {
+		return err
+	}
+
+	// sonar can't move a webhook to another project, so the old one is removed and a new one is created.
+	if webhook.Status.WebhookKey != "" && webhook.Status.ProjectKey != webhook.Spec.ProjectKey {
+		log.Info("Webhook project has been changed, removing old webhook", "oldProject", webhook.Status.ProjectKey)
+
+		if err = h.sonarApiClient.DeleteWebhook(ctx, webhook.Status.WebhookKey); err != nil && !sonar.IsErrNotFound(err) {
+			return fmt.Errorf("failed to delete webhook: %w", err)
+		}
+
+		events.Normal(ctx, webhook, events.ReasonDeleted,
+			"Webhook %s has been removed from project %s", webhook.Spec.Name, webhook.Status.ProjectKey)
+
+		webhook.Status.WebhookKey = ""
+	}
+
+	existingWebhook, err := h.findWebhook(ctx, webhook)
+	if err != nil {
+		return err
+	}
+
+	sonarWebhook := &sonar.Webhook{
+		Name:   webhook.Spec.Name,
+		URL:    webhook.Spec.Url,
+		Secret: secret,
+	}
+
+	if existingWebhook == nil {
+		log.Info("Webhook does not exist, creating")
+
+		createdWebhook, errCreate := h.sonarApiClient.CreateWebhook(ctx, webhook.Spec.ProjectKey, sonarWebhook)
+		if errCreate != nil {
+			return fmt.Errorf("failed to create webhook: %w", errCreate)
+		}
+
+		webhook.Status.WebhookKey = createdWebhook.Key
+		webhook.Status.ProjectKey = webhook.Spec.ProjectKey
+		webhook.Status.SecretHash = hashSecret(secret)
+
+		log.Info("Webhook has been created")
+		events.Normal(ctx, webhook, events.ReasonCreated, "Webhook %s has been created", webhook.Spec.Name)
+
+		return nil
+	}
+
+	webhook.Status.WebhookKey = existingWebhook.Key
+	webhook.Status.ProjectKey = webhook.Spec.ProjectKey
+
+	if !webhookChanged(existingWebhook, sonarWebhook, webhook.Status.SecretHash) {
+		log.Info("Webhook is up to date")
+
+		return nil
+	}
+
+	log.Info("Updating webhook")
+
+	sonarWebhook.Key = existingWebhook.Key
+
+	if err = h.sonarApiClient.UpdateWebhook(ctx, sonarWebhook); err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	webhook.Status.SecretHash = hashSecret(secret)
+
+	log.Info("Webhook has been updated")
+	events.Normal(ctx, webhook, events.ReasonUpdated, "Webhook %s has been updated", webhook.Spec.Name)
+
+	return nil
+}
+
+// findWebhook returns the webhook by the key from the status.
+// If the key is not set or the webhook was removed from sonar, the webhook is searched by name.
+func (h *CreateWebhook) findWebhook(ctx context.Context, webhook *sonarApi.SonarWebhook) (*sonar.Webhook, error) {
+	webhooks, err := h.sonarApiClient.GetWebhooks(ctx, webhook.Spec.ProjectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	if webhook.Status.WebhookKey != "" {
+		for i := range webhooks {
+			if webhooks[i].Key == webhook.Status.WebhookKey {
+				return &webhooks[i], nil
+			}
+		}
+	}
+
+	for i := range webhooks {
+		if webhooks[i].Name == webhook.Spec.Name {
+			return &webhooks[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (h *CreateWebhook) getSecret(ctx context.Context, webhook *sonarApi.SonarWebhook) (string, error) {
+	if webhook.Spec.Secret == nil {
+		return "", nil
+	}
+
+	secret, err := sourceref.GetValueFromSourceRef(
+		ctx,
+		&common.SourceRef{SecretKeyRef: webhook.Spec.Secret},
+		webhook.Namespace,
+		h.k8sClient,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to get webhook secret: %w", err)
+	}
+
+	if secret == "" {
+		return "", fmt.Errorf("webhook secret key %s is empty in secret %s", webhook.Spec.Secret.Key, webhook.Spec.Secret.Name)
+	}
+
+	return secret, nil
+}
+
+// webhookChanged checks if the webhook in sonar differs from the desired one.
+// Recent sonar versions don't return the secret value, so the secret is compared with the hash of the applied one.
+func webhookChanged(existing, desired *sonar.Webhook, appliedSecretHash string) bool {
+	if existing.Name != desired.Name || existing.URL != desired.URL {
+		return true
+	}
+
+	if desired.Secret == "" {
+		return existing.HasSecret || existing.Secret != ""
+	}
+
+	if existing.Secret != "" {
+		return existing.Secret != desired.Secret
+	}
+
+	return !existing.HasSecret || appliedSecretHash != hashSecret(desired.Secret)
+}
+
+func hashSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,460 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar/mocks"
+)
+
+func TestCreateWebhook_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	webhookSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "webhook-secret",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{"secret": []byte("hmac-secret")},
+	}
+
+	tests := []struct {
+		name           string
+		webhook        *sonarApi.SonarWebhook
+		sonarApiClient func(t *testing.T) sonar.WebhookClient
+		k8sClient      func(t *testing.T) client.Client
+		wantErr        require.ErrorAssertionFunc
+		wantStatus     sonarApi.SonarWebhookStatus
+	}{
+		{
+			name: "global webhook is created",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook",
+					Namespace: "default",
+				},
+				Spec: sonarApi.SonarWebhookSpec{
+					Name: "ci",
+					Url:  "https://ci.example.com",
+					Secret: &common.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "webhook-secret"},
+						Key:                  "secret",
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetWebhooks", mock.Anything, "").
+					Return([]sonar.Webhook{{Key: "other", Name: "other"}}, nil)
+				m.On("CreateWebhook", mock.Anything, "", &sonar.Webhook{
+					Name:   "ci",
+					URL:    "https://ci.example.com",
+					Secret: "hmac-secret",
+				}).
+					Return(&sonar.Webhook{Key: "AU-key", Name: "ci"}, nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(webhookSecret).Build()
+			},
+			wantErr: require.NoError,
+			wantStatus: sonarApi.SonarWebhookStatus{
+				WebhookKey: "AU-key",
+				SecretHash: hashSecret("hmac-secret"),
+			},
+		},
+		{
+			name: "webhook with secret is up to date",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook",
+					Namespace: "default",
+				},
+				Spec: sonarApi.SonarWebhookSpec{
+					Name: "ci",
+					Url:  "https://ci.example.com",
+					Secret: &common.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "webhook-secret"},
+						Key:                  "secret",
+					},
+				},
+				Status: sonarApi.SonarWebhookStatus{
+					WebhookKey: "AU-key",
+					SecretHash: hashSecret("hmac-secret"),
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetWebhooks", mock.Anything, "").
+					Return([]sonar.Webhook{{Key: "AU-key", Name: "ci", URL: "https://ci.example.com", HasSecret: true}}, nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(webhookSecret).Build()
+			},
+			wantErr: require.NoError,
+			wantStatus: sonarApi.SonarWebhookStatus{
+				WebhookKey: "AU-key",
+				SecretHash: hashSecret("hmac-secret"),
+			},
+		},
+		{
+			name: "webhook secret is changed",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook",
+					Namespace: "default",
+				},
+				Spec: sonarApi.SonarWebhookSpec{
+					Name: "ci",
+					Url:  "https://ci.example.com",
+					Secret: &common.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "webhook-secret"},
+						Key:                  "secret",
+					},
+				},
+				Status: sonarApi.SonarWebhookStatus{
+					WebhookKey: "AU-key",
+					SecretHash: hashSecret("old-secret"),
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetWebhooks", mock.Anything, "").
+					Return([]sonar.Webhook{{Key: "AU-key", Name: "ci", URL: "https://ci.example.com", HasSecret: true}}, nil)
+				m.On("UpdateWebhook", mock.Anything, &sonar.Webhook{
+					Key:    "AU-key",
+					Name:   "ci",
+					URL:    "https://ci.example.com",
+					Secret: "hmac-secret",
+				}).
+					Return(nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(webhookSecret).Build()
+			},
+			wantErr: require.NoError,
+			wantStatus: sonarApi.SonarWebhookStatus{
+				WebhookKey: "AU-key",
+				SecretHash: hashSecret("hmac-secret"),
+			},
+		},
+		{
+			name: "webhook secret is removed",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook",
+					Namespace: "default",
+				},
+				Spec: sonarApi.SonarWebhookSpec{
+					Name: "ci",
+					Url:  "https://ci.example.com",
+				},
+				Status: sonarApi.SonarWebhookStatus{
+					WebhookKey: "AU-key",
+					SecretHash: hashSecret("hmac-secret"),
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetWebhooks", mock.Anything, "").
+					Return([]sonar.Webhook{{Key: "AU-key", Name: "ci", URL: "https://ci.example.com", HasSecret: true}}, nil)
+				m.On("UpdateWebhook", mock.Anything, &sonar.Webhook{
+					Key:  "AU-key",
+					Name: "ci",
+					URL:  "https://ci.example.com",
+				}).
+					Return(nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: require.NoError,
+			wantStatus: sonarApi.SonarWebhookStatus{
+				WebhookKey: "AU-key",
+			},
+		},
+		{
+			name: "project webhook is up to date",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook",
+					Namespace: "default",
+				},
+				Spec: sonarApi.SonarWebhookSpec{
+					Name:       "ci",
+					Url:        "https://ci.example.com",
+					ProjectKey: "project",
+				},
+				Status: sonarApi.SonarWebhookStatus{
+					WebhookKey: "AU-key",
+					ProjectKey: "project",
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetWebhooks", mock.Anything, "project").
+					Return([]sonar.Webhook{{Key: "AU-key", Name: "ci", URL: "https://ci.example.com"}}, nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: require.NoError,
+			wantStatus: sonarApi.SonarWebhookStatus{
+				WebhookKey: "AU-key",
+				ProjectKey: "project",
+			},
+		},
+		{
+			name: "webhook is renamed",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook",
+					Namespace: "default",
+				},
+				Spec: sonarApi.SonarWebhookSpec{
+					Name: "ci-new",
+					Url:  "https://ci.example.com",
+				},
+				Status: sonarApi.SonarWebhookStatus{
+					WebhookKey: "AU-key",
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetWebhooks", mock.Anything, "").
+					Return([]sonar.Webhook{{Key: "AU-key", Name: "ci", URL: "https://ci.example.com", HasSecret: true}}, nil)
+				m.On("UpdateWebhook", mock.Anything, &sonar.Webhook{
+					Key:  "AU-key",
+					Name: "ci-new",
+					URL:  "https://ci.example.com",
+				}).
+					Return(nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: require.NoError,
+			wantStatus: sonarApi.SonarWebhookStatus{
+				WebhookKey: "AU-key",
+			},
+		},
+		{
+			name: "existing webhook with the same name is adopted",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook",
+					Namespace: "default",
+				},
+				Spec: sonarApi.SonarWebhookSpec{
+					Name: "ci",
+					Url:  "https://ci.example.com",
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetWebhooks", mock.Anything, "").
+					Return([]sonar.Webhook{{Key: "AU-key", Name: "ci", URL: "https://ci.example.com"}}, nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: require.NoError,
+			wantStatus: sonarApi.SonarWebhookStatus{
+				WebhookKey: "AU-key",
+			},
+		},
+		{
+			name: "webhook is moved to another project",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook",
+					Namespace: "default",
+				},
+				Spec: sonarApi.SonarWebhookSpec{
+					Name:       "ci",
+					Url:        "https://ci.example.com",
+					ProjectKey: "new-project",
+				},
+				Status: sonarApi.SonarWebhookStatus{
+					WebhookKey: "AU-key",
+					ProjectKey: "old-project",
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("DeleteWebhook", mock.Anything, "AU-key").
+					Return(nil)
+				m.On("GetWebhooks", mock.Anything, "new-project").
+					Return([]sonar.Webhook{}, nil)
+				m.On("CreateWebhook", mock.Anything, "new-project", mock.Anything).
+					Return(&sonar.Webhook{Key: "AU-new-key"}, nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: require.NoError,
+			wantStatus: sonarApi.SonarWebhookStatus{
+				WebhookKey: "AU-new-key",
+				ProjectKey: "new-project",
+			},
+		},
+		{
+			name: "secret not found",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook",
+					Namespace: "default",
+				},
+				Spec: sonarApi.SonarWebhookSpec{
+					Name: "ci",
+					Url:  "https://ci.example.com",
+					Secret: &common.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "webhook-secret"},
+						Key:                  "secret",
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				return mocks.NewMockClientInterface(t)
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get webhook secret")
+			},
+		},
+		{
+			name: "secret key is empty",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook",
+					Namespace: "default",
+				},
+				Spec: sonarApi.SonarWebhookSpec{
+					Name: "ci",
+					Url:  "https://ci.example.com",
+					Secret: &common.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "webhook-secret"},
+						Key:                  "missing",
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				return mocks.NewMockClientInterface(t)
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(webhookSecret).Build()
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "webhook secret key missing is empty")
+			},
+		},
+		{
+			name: "failed to get webhooks",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook",
+					Namespace: "default",
+				},
+				Spec: sonarApi.SonarWebhookSpec{
+					Name: "ci",
+					Url:  "https://ci.example.com",
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetWebhooks", mock.Anything, "").
+					Return(nil, errors.New("failed"))
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get webhooks")
+			},
+		},
+		{
+			name: "failed to create webhook",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhook",
+					Namespace: "default",
+				},
+				Spec: sonarApi.SonarWebhookSpec{
+					Name: "ci",
+					Url:  "https://ci.example.com",
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetWebhooks", mock.Anything, "").
+					Return([]sonar.Webhook{}, nil)
+				m.On("CreateWebhook", mock.Anything, "", mock.Anything).
+					Return(nil, sonar.NewHTTPError(http.StatusBadRequest, "bad request"))
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to create webhook")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewCreateWebhook(tt.sonarApiClient(t), tt.k8sClient(t))
+			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.webhook)
+
+			tt.wantErr(t, err)
+
+			if err == nil {
+				require.Equal(t, tt.wantStatus, tt.webhook.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package chain
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+)
+
+func MakeChain(sonarApiClient sonar.ClientInterface, k8sClient client.Client) SonarWebhookHandler {
+	ch := &chain{}
+	ch.Use(NewCreateWebhook(sonarApiClient, k8sClient))
+
+	return ch
+}
@@ -0,0 +1,52 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+)
+
+type RemoveWebhook struct {
+	sonarApiClient sonar.WebhookClient
+}
+
+func NewRemoveWebhook(sonarApiClient sonar.WebhookClient) *RemoveWebhook {
+	return &RemoveWebhook{sonarApiClient: sonarApiClient}
+}
+
+func (h *RemoveWebhook) ServeRequest(ctx context.Context, webhook *sonarApi.SonarWebhook) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("name", webhook.Spec.Name, "key", webhook.Status.WebhookKey)
+
+	if webhook.Status.WebhookKey == "" {
+		log.Info("Webhook was not created, nothing to delete")
+
+		return nil
+	}
+
+	_, err := h.sonarApiClient.GetWebhook(ctx, webhook.Status.WebhookKey, webhook.Status.ProjectKey)
+	if err != nil {
+		if sonar.IsErrNotFound(err) {
+			log.Info("Webhook does not exist, nothing to delete")
+
+			return nil
+		}
+
+		return fmt.Errorf("failed to check if webhook exists: %w", err)
+	}
+
+	log.Info("Deleting webhook")
+
+	if err = h.sonarApiClient.DeleteWebhook(ctx, webhook.Status.WebhookKey); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	log.Info("Webhook has been deleted")
+	events.Normal(ctx, webhook, events.ReasonDeleted, "Webhook %s has been deleted", webhook.Spec.Name)
+
+	return nil
+}
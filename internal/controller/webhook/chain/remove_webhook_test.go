@@ -0,0 +1,118 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar/mocks"
+)
+
+func TestRemoveWebhook_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		webhook        *sonarApi.SonarWebhook
+		sonarApiClient func(t *testing.T) sonar.WebhookClient
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name: "webhook is deleted",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "webhook",
+				},
+				Status: sonarApi.SonarWebhookStatus{
+					WebhookKey: "AU-key",
+					ProjectKey: "project",
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetWebhook", mock.Anything, "AU-key", "project").
+					Return(&sonar.Webhook{Key: "AU-key"}, nil)
+				m.On("DeleteWebhook", mock.Anything, "AU-key").
+					Return(nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "webhook was not created",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "webhook",
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				return mocks.NewMockClientInterface(t)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "webhook does not exist",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "webhook",
+				},
+				Status: sonarApi.SonarWebhookStatus{
+					WebhookKey: "AU-key",
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetWebhook", mock.Anything, "AU-key", "").
+					Return(nil, sonar.NewHTTPError(http.StatusNotFound, "not found"))
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "failed to delete webhook",
+			webhook: &sonarApi.SonarWebhook{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "webhook",
+				},
+				Status: sonarApi.SonarWebhookStatus{
+					WebhookKey: "AU-key",
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.WebhookClient {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetWebhook", mock.Anything, "AU-key", "").
+					Return(&sonar.Webhook{Key: "AU-key"}, nil)
+				m.On("DeleteWebhook", mock.Anything, "AU-key").
+					Return(errors.New("failed"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to delete webhook")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewRemoveWebhook(tt.sonarApiClient(t))
+			err := h.ServeRequest(ctrl.LoggerInto(context.Background(), logr.Discard()), tt.webhook)
+
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/epam/edp-sonar-operator/internal/controller/webhook/chain"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	sonarclient "github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+	"github.com/epam/edp-sonar-operator/pkg/helper"
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
+)
+
+const (
+	errorRequeueTime = time.Second * 30
+	// successRequeueTime is used to recreate webhooks that were lost, e.g. after sonar was rebuilt.
+	successRequeueTime = time.Minute * 10
+)
+
+type apiClientProvider interface {
+	GetSonarApiClientFromSonarRef(ctx context.Context, namespace string, sonarRef common.HasSonarRef) (*sonarclient.Client, error)
+}
+
+// SonarWebhookReconciler reconciles a SonarWebhook object.
+type SonarWebhookReconciler struct {
+	client            client.Client
+	scheme            *runtime.Scheme
+	apiClientProvider apiClientProvider
+}
+
+// NewSonarWebhookReconciler returns a new SonarWebhookReconciler instance.
+func NewSonarWebhookReconciler(
+	k8sClient client.Client,
+	scheme *runtime.Scheme,
+	apiClientProvider apiClientProvider,
+) *SonarWebhookReconciler {
+	return &SonarWebhookReconciler{client: k8sClient, scheme: scheme, apiClientProvider: apiClientProvider}
+}
+
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=sonarwebhooks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=sonarwebhooks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=edp.epam.com,namespace=placeholder,resources=sonarwebhooks/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *SonarWebhookReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling SonarWebhook")
+
+	webhook := &sonarApi.SonarWebhook{}
+
+	err := r.client.Get(ctx, req.NamespacedName, webhook)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	sonarApiClient, err := r.apiClientProvider.GetSonarApiClientFromSonarRef(ctx, req.Namespace, webhook)
+	if err != nil {
+		if webhook.GetDeletionTimestamp() != nil && sonarclient.IsErrSonarRefUnavailable(err) {
+			// the resource can't be cleaned up in sonar, so only the finalizer is removed to not block the deletion.
+			log.Info("Sonar is unavailable, removing finalizer without cleanup in sonar", "reason", err.Error())
+			events.Warning(ctx, webhook, events.ReasonCleanupSkipped, err)
+
+			if controllerutil.RemoveFinalizer(webhook, helper.FinalizerName) {
+				if err = r.client.Update(ctx, webhook); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "An error has occurred while getting sonar api client")
+		events.ConnectionFailed(ctx, webhook, err)
+
+		oldStatus := webhook.Status.DeepCopy()
+		webhook.Status.SetSonarConnected(webhook.Generation, err)
+
+		if err = r.updateSonarWebhookStatus(ctx, webhook, oldStatus); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{
+			RequeueAfter: errorRequeueTime,
+		}, nil
+	}
+
+	if webhook.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(webhook, helper.FinalizerName) {
+			if err = chain.NewRemoveWebhook(sonarApiClient).ServeRequest(ctx, webhook); err != nil {
+				log.Error(err, "An error has occurred while deleting SonarWebhook")
+				events.Warning(ctx, webhook, events.ReasonDeletionFailed, err)
+
+				return ctrl.Result{
+					RequeueAfter: errorRequeueTime,
+				}, nil
+			}
+
+			controllerutil.RemoveFinalizer(webhook, helper.FinalizerName)
+
+			if err = r.client.Update(ctx, webhook); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	if controllerutil.AddFinalizer(webhook, helper.FinalizerName) {
+		err = r.client.Update(ctx, webhook)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	oldStatus := webhook.Status.DeepCopy()
+
+	if err = chain.MakeChain(sonarApiClient, r.client).ServeRequest(ctx, webhook); err != nil {
+		log.Error(err, "An error has occurred while handling SonarWebhook")
+		events.Warning(ctx, webhook, events.ReasonSyncFailed, err)
+
+		webhook.Status.Value = "error"
+		webhook.Status.Error = err.Error()
+		webhook.Status.SetSynced(webhook.Generation, err)
+		sonarclient.SetConnectionCondition(&webhook.Status.ReconcileStatus, webhook.Generation, err)
+
+		if err = r.updateSonarWebhookStatus(ctx, webhook, oldStatus); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{
+			RequeueAfter: errorRequeueTime,
+		}, nil
+	}
+
+	webhook.Status.Value = common.StatusCreated
+	webhook.Status.Error = ""
+	webhook.Status.SetSynced(webhook.Generation, nil)
+	webhook.Status.SetSonarConnected(webhook.Generation, nil)
+
+	if err = r.updateSonarWebhookStatus(ctx, webhook, oldStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{
+		RequeueAfter: successRequeueTime,
+	}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SonarWebhookReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sonarApi.SonarWebhook{}).
+		Complete(tracing.NewReconciler(common.SonarWebhookKind,
+			events.NewReconciler(mgr.GetEventRecorderFor(events.Component), r)))
+}
+
+func (r *SonarWebhookReconciler) updateSonarWebhookStatus(
+	ctx context.Context,
+	webhook *sonarApi.SonarWebhook,
+	oldStatus *sonarApi.SonarWebhookStatus,
+) error {
+	if equality.Semantic.DeepEqual(&webhook.Status, oldStatus) {
+		return nil
+	}
+
+	if err := r.client.Status().Update(ctx, webhook); err != nil {
+		return fmt.Errorf("failed to update SonarWebhook status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+var _ = Describe("SonarWebhook controller", func() {
+	sonarWebhookCRName := "sonar-webhook"
+	It("Should create SonarWebhook object", func() {
+		By("By creating a new SonarWebhook object")
+		newSonarWebhook := &sonarApi.SonarWebhook{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      sonarWebhookCRName,
+				Namespace: namespace,
+			},
+			Spec: sonarApi.SonarWebhookSpec{
+				Name: "test-webhook",
+				Url:  "https://ci.example.com/sonar/webhook",
+				SonarRef: common.SonarRef{
+					Name: sonarName,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, newSonarWebhook)).Should(Succeed())
+		Eventually(func() bool {
+			createdSonarWebhook := &sonarApi.SonarWebhook{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: sonarWebhookCRName, Namespace: namespace}, createdSonarWebhook)
+			if err != nil {
+				return false
+			}
+
+			return createdSonarWebhook.Status.Value == common.StatusCreated &&
+				createdSonarWebhook.Status.Error == "" &&
+				meta.IsStatusConditionTrue(createdSonarWebhook.Status.Conditions, common.ConditionReady) &&
+				createdSonarWebhook.Status.WebhookKey != ""
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should update SonarWebhook object", func() {
+		By("Getting SonarWebhook object")
+		createdSonarWebhook := &sonarApi.SonarWebhook{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: sonarWebhookCRName, Namespace: namespace}, createdSonarWebhook)).
+			Should(Succeed())
+
+		By("Updating SonarWebhook object")
+		createdSonarWebhook.Spec.Url = "https://ci.example.com/sonar/webhook-updated"
+		Expect(k8sClient.Update(ctx, createdSonarWebhook)).Should(Succeed())
+		Consistently(func() bool {
+			updatedSonarWebhook := &sonarApi.SonarWebhook{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: sonarWebhookCRName, Namespace: namespace}, updatedSonarWebhook)
+			if err != nil {
+				return false
+			}
+
+			return updatedSonarWebhook.Status.Error == ""
+		}, timeout, interval).Should(BeTrue())
+	})
+	It("Should delete SonarWebhook object", func() {
+		By("Getting SonarWebhook object")
+		createdSonarWebhook := &sonarApi.SonarWebhook{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: sonarWebhookCRName, Namespace: namespace}, createdSonarWebhook)).
+			Should(Succeed())
+
+		By("Deleting SonarWebhook object")
+		Expect(k8sClient.Delete(ctx, createdSonarWebhook)).Should(Succeed())
+		Eventually(func() bool {
+			createdSonarWebhook := &sonarApi.SonarWebhook{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: sonarWebhookCRName, Namespace: namespace}, createdSonarWebhook)
+			return k8sErrors.IsNotFound(err)
+		}, timeout, interval).Should(BeTrue())
+	})
+})
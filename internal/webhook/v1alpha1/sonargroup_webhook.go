@@ -0,0 +1,69 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+// SetupSonarGroupWebhookWithManager registers the validating webhook for SonarGroup.
+func SetupSonarGroupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&sonarApi.SonarGroup{}).
+		WithValidator(&SonarGroupCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-edp-epam-com-v1alpha1-sonargroup,mutating=false,failurePolicy=fail,sideEffects=None,groups=edp.epam.com,resources=sonargroups,verbs=create;update,versions=v1alpha1,name=vsonargroup-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// SonarGroupCustomValidator validates SonarGroup on create and update.
+type SonarGroupCustomValidator struct{}
+
+var _ webhook.CustomValidator = &SonarGroupCustomValidator{}
+
+// ValidateCreate validates SonarGroup on create.
+func (v *SonarGroupCustomValidator) ValidateCreate(
+	_ context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	return nil, validateSonarGroup(obj)
+}
+
+// ValidateUpdate validates SonarGroup on update.
+// The update is allowed without validation if SonarGroup is being deleted or its spec is not changed.
+func (v *SonarGroupCustomValidator) ValidateUpdate(
+	_ context.Context,
+	oldObj, newObj runtime.Object,
+) (admission.Warnings, error) {
+	if skipUpdateValidation(oldObj, newObj, func(group *sonarApi.SonarGroup) any { return group.Spec }) {
+		return nil, nil
+	}
+
+	return nil, validateSonarGroup(newObj)
+}
+
+// ValidateDelete does nothing, deletion of SonarGroup is always allowed.
+func (v *SonarGroupCustomValidator) ValidateDelete(
+	_ context.Context,
+	_ runtime.Object,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateSonarGroup(obj runtime.Object) error {
+	group, ok := obj.(*sonarApi.SonarGroup)
+	if !ok {
+		return fmt.Errorf("expected a SonarGroup object but got %T", obj)
+	}
+
+	errs := validatePermissions(field.NewPath("spec", "permissions"), group.Spec.Permissions, globalPermissions)
+
+	return invalid("SonarGroup", group.Name, errs)
+}
@@ -0,0 +1,38 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestSonarGroupCustomValidator(t *testing.T) {
+	t.Parallel()
+
+	newGroup := func(permissions ...string) *sonarApi.SonarGroup {
+		return &sonarApi.SonarGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "group"},
+			Spec:       sonarApi.SonarGroupSpec{Name: "developers", Permissions: permissions},
+		}
+	}
+
+	v := &SonarGroupCustomValidator{}
+
+	_, err := v.ValidateCreate(context.Background(), newGroup("gateadmin", "profileadmin"))
+	require.NoError(t, err)
+
+	_, err = v.ValidateCreate(context.Background(), newGroup("administer"))
+	require.Error(t, err)
+	assert.True(t, apierrors.IsInvalid(err))
+	assert.Contains(t, err.Error(),
+		`SonarGroup.edp.epam.com "group" is invalid: spec.permissions[0]: Unsupported value: "administer"`)
+
+	_, err = v.ValidateDelete(context.Background(), newGroup("administer"))
+	require.NoError(t, err)
+}
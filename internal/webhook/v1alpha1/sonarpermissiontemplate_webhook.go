@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+// SetupSonarPermissionTemplateWebhookWithManager registers the validating webhook for SonarPermissionTemplate.
+func SetupSonarPermissionTemplateWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&sonarApi.SonarPermissionTemplate{}).
+		WithValidator(&SonarPermissionTemplateCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-edp-epam-com-v1alpha1-sonarpermissiontemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=edp.epam.com,resources=sonarpermissiontemplates,verbs=create;update,versions=v1alpha1,name=vsonarpermissiontemplate-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// SonarPermissionTemplateCustomValidator validates SonarPermissionTemplate on create and update.
+type SonarPermissionTemplateCustomValidator struct{}
+
+var _ webhook.CustomValidator = &SonarPermissionTemplateCustomValidator{}
+
+// ValidateCreate validates SonarPermissionTemplate on create.
+func (v *SonarPermissionTemplateCustomValidator) ValidateCreate(
+	_ context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	return nil, validateSonarPermissionTemplate(obj)
+}
+
+// ValidateUpdate validates SonarPermissionTemplate on update.
+// The update is allowed without validation if SonarPermissionTemplate is being deleted or its spec is not changed.
+func (v *SonarPermissionTemplateCustomValidator) ValidateUpdate(
+	_ context.Context,
+	oldObj, newObj runtime.Object,
+) (admission.Warnings, error) {
+	if skipUpdateValidation(oldObj, newObj, func(template *sonarApi.SonarPermissionTemplate) any { return template.Spec }) {
+		return nil, nil
+	}
+
+	return nil, validateSonarPermissionTemplate(newObj)
+}
+
+// ValidateDelete does nothing, deletion of SonarPermissionTemplate is always allowed.
+func (v *SonarPermissionTemplateCustomValidator) ValidateDelete(
+	_ context.Context,
+	_ runtime.Object,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateSonarPermissionTemplate(obj runtime.Object) error {
+	template, ok := obj.(*sonarApi.SonarPermissionTemplate)
+	if !ok {
+		return fmt.Errorf("expected a SonarPermissionTemplate object but got %T", obj)
+	}
+
+	errs := validateRegexp(field.NewPath("spec", "projectKeyPattern"), template.Spec.ProjectKeyPattern)
+
+	groupsPath := field.NewPath("spec", "groupsPermissions")
+
+	for _, group := range slices.Sorted(maps.Keys(template.Spec.GroupsPermissions)) {
+		errs = append(errs, validatePermissions(
+			groupsPath.Key(group), template.Spec.GroupsPermissions[group], projectPermissions)...)
+	}
+
+	return invalid("SonarPermissionTemplate", template.Name, errs)
+}
@@ -0,0 +1,82 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestSonarPermissionTemplateCustomValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		projectKeyPattern string
+		groupsPermissions map[string][]string
+		wantErr           []string
+	}{
+		{
+			name:              "valid template",
+			projectKeyPattern: "finance.*",
+			groupsPermissions: map[string][]string{
+				"sonar-users":    {"codeviewer", "user"},
+				"sonar-admins":   {"admin", "issueadmin", "securityhotspotadmin"},
+				"sonar-scanners": {"scan"},
+			},
+		},
+		{
+			name:              "invalid pattern and permissions",
+			projectKeyPattern: "finance[",
+			groupsPermissions: map[string][]string{
+				"sonar-users":  {"codeviewer", "provisioning"},
+				"sonar-admins": {"gateadmin"},
+			},
+			wantErr: []string{
+				`spec.projectKeyPattern: Invalid value: "finance["`,
+				`spec.groupsPermissions[sonar-admins][0]: Unsupported value: "gateadmin"`,
+				`spec.groupsPermissions[sonar-users][1]: Unsupported value: "provisioning"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			template := &sonarApi.SonarPermissionTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "template"},
+				Spec: sonarApi.SonarPermissionTemplateSpec{
+					Name:              "template",
+					ProjectKeyPattern: tt.projectKeyPattern,
+					GroupsPermissions: tt.groupsPermissions,
+				},
+			}
+
+			v := &SonarPermissionTemplateCustomValidator{}
+
+			_, errCreate := v.ValidateCreate(context.Background(), template)
+			_, errUpdate := v.ValidateUpdate(context.Background(), &sonarApi.SonarPermissionTemplate{}, template)
+
+			for _, err := range []error{errCreate, errUpdate} {
+				if len(tt.wantErr) == 0 {
+					require.NoError(t, err)
+
+					continue
+				}
+
+				require.Error(t, err)
+				assert.True(t, apierrors.IsInvalid(err))
+
+				for _, want := range tt.wantErr {
+					assert.Contains(t, err.Error(), want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+// SetupSonarProjectWebhookWithManager registers the validating webhook for SonarProject.
+func SetupSonarProjectWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&sonarApi.SonarProject{}).
+		WithValidator(&SonarProjectCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-edp-epam-com-v1alpha1-sonarproject,mutating=false,failurePolicy=fail,sideEffects=None,groups=edp.epam.com,resources=sonarprojects,verbs=create;update,versions=v1alpha1,name=vsonarproject-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// SonarProjectCustomValidator validates SonarProject on create and update.
+type SonarProjectCustomValidator struct{}
+
+var _ webhook.CustomValidator = &SonarProjectCustomValidator{}
+
+// ValidateCreate validates SonarProject on create.
+func (v *SonarProjectCustomValidator) ValidateCreate(
+	_ context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	return nil, validateSonarProject(obj)
+}
+
+// ValidateUpdate validates SonarProject on update.
+// The update is allowed without validation if SonarProject is being deleted or its spec is not changed.
+func (v *SonarProjectCustomValidator) ValidateUpdate(
+	_ context.Context,
+	oldObj, newObj runtime.Object,
+) (admission.Warnings, error) {
+	if skipUpdateValidation(oldObj, newObj, func(project *sonarApi.SonarProject) any { return project.Spec }) {
+		return nil, nil
+	}
+
+	return nil, validateSonarProject(newObj)
+}
+
+// ValidateDelete does nothing, deletion of SonarProject is always allowed.
+func (v *SonarProjectCustomValidator) ValidateDelete(
+	_ context.Context,
+	_ runtime.Object,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateSonarProject(obj runtime.Object) error {
+	project, ok := obj.(*sonarApi.SonarProject)
+	if !ok {
+		return fmt.Errorf("expected a SonarProject object but got %T", obj)
+	}
+
+	errs := validateProjectKey(field.NewPath("spec", "key"), project.Spec.Key)
+
+	return invalid("SonarProject", project.Name, errs)
+}
@@ -0,0 +1,63 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestSonarProjectCustomValidator(t *testing.T) {
+	t.Parallel()
+
+	newProject := func(key string) *sonarApi.SonarProject {
+		return &sonarApi.SonarProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "project"},
+			Spec:       sonarApi.SonarProjectSpec{Key: key, Name: "Project"},
+		}
+	}
+
+	v := &SonarProjectCustomValidator{}
+
+	_, err := v.ValidateCreate(context.Background(), newProject("my-project"))
+	require.NoError(t, err)
+
+	_, err = v.ValidateCreate(context.Background(), newProject("my project"))
+	require.Error(t, err)
+	assert.True(t, apierrors.IsInvalid(err))
+	assert.Contains(t, err.Error(), `SonarProject.edp.epam.com "project" is invalid: spec.key`)
+
+	_, err = v.ValidateUpdate(context.Background(), newProject("my-project"), newProject("123"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must contain at least one non-digit character")
+
+	// the project created before the validation can be updated if its spec is not changed.
+	invalidProject := newProject("my project")
+	labeledProject := invalidProject.DeepCopy()
+	labeledProject.Labels = map[string]string{"team": "dev"}
+
+	_, err = v.ValidateUpdate(context.Background(), invalidProject, labeledProject)
+	require.NoError(t, err)
+
+	// the finalizer of the invalid project can be removed when it is deleted.
+	invalidProject.Finalizers = []string{"edp.epam.com/finalizer"}
+	invalidProject.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	deletedProject := invalidProject.DeepCopy()
+	deletedProject.Finalizers = nil
+	deletedProject.Spec.Key = "123"
+
+	_, err = v.ValidateUpdate(context.Background(), invalidProject, deletedProject)
+	require.NoError(t, err)
+
+	_, err = v.ValidateCreate(context.Background(), &sonarApi.SonarGroup{})
+	require.ErrorContains(t, err, "expected a SonarProject object but got *v1alpha1.SonarGroup")
+
+	_, err = v.ValidateDelete(context.Background(), newProject("my project"))
+	require.NoError(t, err)
+}
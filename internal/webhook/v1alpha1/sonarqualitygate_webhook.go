@@ -0,0 +1,80 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+// SetupSonarQualityGateWebhookWithManager registers the validating webhook for SonarQualityGate.
+func SetupSonarQualityGateWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&sonarApi.SonarQualityGate{}).
+		WithValidator(&SonarQualityGateCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-edp-epam-com-v1alpha1-sonarqualitygate,mutating=false,failurePolicy=fail,sideEffects=None,groups=edp.epam.com,resources=sonarqualitygates,verbs=create;update,versions=v1alpha1,name=vsonarqualitygate-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// SonarQualityGateCustomValidator validates SonarQualityGate on create and update.
+type SonarQualityGateCustomValidator struct{}
+
+var _ webhook.CustomValidator = &SonarQualityGateCustomValidator{}
+
+// ValidateCreate validates SonarQualityGate on create.
+func (v *SonarQualityGateCustomValidator) ValidateCreate(
+	_ context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	return nil, validateSonarQualityGate(obj)
+}
+
+// ValidateUpdate validates SonarQualityGate on update.
+// The update is allowed without validation if SonarQualityGate is being deleted or its spec is not changed.
+func (v *SonarQualityGateCustomValidator) ValidateUpdate(
+	_ context.Context,
+	oldObj, newObj runtime.Object,
+) (admission.Warnings, error) {
+	if skipUpdateValidation(oldObj, newObj, func(gate *sonarApi.SonarQualityGate) any { return gate.Spec }) {
+		return nil, nil
+	}
+
+	return nil, validateSonarQualityGate(newObj)
+}
+
+// ValidateDelete does nothing, deletion of SonarQualityGate is always allowed.
+func (v *SonarQualityGateCustomValidator) ValidateDelete(
+	_ context.Context,
+	_ runtime.Object,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateSonarQualityGate(obj runtime.Object) error {
+	gate, ok := obj.(*sonarApi.SonarQualityGate)
+	if !ok {
+		return fmt.Errorf("expected a SonarQualityGate object but got %T", obj)
+	}
+
+	var errs field.ErrorList
+
+	conditionsPath := field.NewPath("spec", "conditions")
+
+	for _, metric := range slices.Sorted(maps.Keys(gate.Spec.Conditions)) {
+		op := gate.Spec.Conditions[metric].Op
+		if op != "" && !slices.Contains(conditionOperators, op) {
+			errs = append(errs, field.NotSupported(conditionsPath.Key(metric).Child("op"), op, conditionOperators))
+		}
+	}
+
+	return invalid("SonarQualityGate", gate.Name, errs)
+}
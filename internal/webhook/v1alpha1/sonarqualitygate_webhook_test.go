@@ -0,0 +1,74 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestSonarQualityGateCustomValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		conditions map[string]sonarApi.Condition
+		wantErr    []string
+	}{
+		{
+			name: "valid conditions",
+			conditions: map[string]sonarApi.Condition{
+				"new_coverage":    {Error: "80", Op: "LT"},
+				"new_code_smells": {Error: "10", Op: "GT"},
+				"new_violations":  {Error: "0"},
+			},
+		},
+		{
+			name: "invalid operators",
+			conditions: map[string]sonarApi.Condition{
+				"new_coverage":    {Error: "80", Op: "LE"},
+				"new_code_smells": {Error: "10", Op: "gt"},
+			},
+			wantErr: []string{
+				`spec.conditions[new_code_smells].op: Unsupported value: "gt"`,
+				`spec.conditions[new_coverage].op: Unsupported value: "LE"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gate := &sonarApi.SonarQualityGate{
+				ObjectMeta: metav1.ObjectMeta{Name: "gate"},
+				Spec:       sonarApi.SonarQualityGateSpec{Name: "gate", Conditions: tt.conditions},
+			}
+
+			v := &SonarQualityGateCustomValidator{}
+
+			_, errCreate := v.ValidateCreate(context.Background(), gate)
+			_, errUpdate := v.ValidateUpdate(context.Background(), &sonarApi.SonarQualityGate{}, gate)
+
+			for _, err := range []error{errCreate, errUpdate} {
+				if len(tt.wantErr) == 0 {
+					require.NoError(t, err)
+
+					continue
+				}
+
+				require.Error(t, err)
+				assert.True(t, apierrors.IsInvalid(err))
+
+				for _, want := range tt.wantErr {
+					assert.Contains(t, err.Error(), want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+// SetupSonarQualityProfileWebhookWithManager registers the validating webhook for SonarQualityProfile.
+func SetupSonarQualityProfileWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&sonarApi.SonarQualityProfile{}).
+		WithValidator(&SonarQualityProfileCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-edp-epam-com-v1alpha1-sonarqualityprofile,mutating=false,failurePolicy=fail,sideEffects=None,groups=edp.epam.com,resources=sonarqualityprofiles,verbs=create;update,versions=v1alpha1,name=vsonarqualityprofile-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// SonarQualityProfileCustomValidator validates SonarQualityProfile on create and update.
+type SonarQualityProfileCustomValidator struct{}
+
+var _ webhook.CustomValidator = &SonarQualityProfileCustomValidator{}
+
+// ValidateCreate validates SonarQualityProfile on create.
+func (v *SonarQualityProfileCustomValidator) ValidateCreate(
+	_ context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	return nil, validateSonarQualityProfile(obj)
+}
+
+// ValidateUpdate validates SonarQualityProfile on update.
+// The update is allowed without validation if SonarQualityProfile is being deleted or its spec is not changed.
+func (v *SonarQualityProfileCustomValidator) ValidateUpdate(
+	_ context.Context,
+	oldObj, newObj runtime.Object,
+) (admission.Warnings, error) {
+	if skipUpdateValidation(oldObj, newObj, func(profile *sonarApi.SonarQualityProfile) any { return profile.Spec }) {
+		return nil, nil
+	}
+
+	return nil, validateSonarQualityProfile(newObj)
+}
+
+// ValidateDelete does nothing, deletion of SonarQualityProfile is always allowed.
+func (v *SonarQualityProfileCustomValidator) ValidateDelete(
+	_ context.Context,
+	_ runtime.Object,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateSonarQualityProfile(obj runtime.Object) error {
+	profile, ok := obj.(*sonarApi.SonarQualityProfile)
+	if !ok {
+		return fmt.Errorf("expected a SonarQualityProfile object but got %T", obj)
+	}
+
+	var errs field.ErrorList
+
+	rulesPath := field.NewPath("spec", "rules")
+
+	for _, key := range slices.Sorted(maps.Keys(profile.Spec.Rules)) {
+		rule := profile.Spec.Rules[key]
+
+		if rule.Severity != "" && !slices.Contains(ruleSeverities, rule.Severity) {
+			errs = append(errs, field.NotSupported(rulesPath.Key(key).Child("severity"), rule.Severity, ruleSeverities))
+		}
+
+		errs = append(errs, validateRuleParams(rulesPath.Key(key).Child("params"), rule.Params)...)
+	}
+
+	return invalid("SonarQualityProfile", profile.Name, errs)
+}
@@ -0,0 +1,73 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestSonarQualityProfileCustomValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rules   map[string]sonarApi.Rule
+		wantErr []string
+	}{
+		{
+			name: "valid rules",
+			rules: map[string]sonarApi.Rule{
+				"S5547": {Severity: "MAJOR", Params: "key1=v1;key2=v2"},
+				"S1192": {},
+			},
+		},
+		{
+			name: "invalid severity and params",
+			rules: map[string]sonarApi.Rule{
+				"S5547": {Severity: "HIGH"},
+				"S1192": {Severity: "MINOR", Params: "threshold"},
+			},
+			wantErr: []string{
+				`spec.rules[S1192].params: Invalid value: "threshold"`,
+				`spec.rules[S5547].severity: Unsupported value: "HIGH"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			profile := &sonarApi.SonarQualityProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec:       sonarApi.SonarQualityProfileSpec{Name: "profile", Language: "go", Rules: tt.rules},
+			}
+
+			v := &SonarQualityProfileCustomValidator{}
+
+			_, errCreate := v.ValidateCreate(context.Background(), profile)
+			_, errUpdate := v.ValidateUpdate(context.Background(), &sonarApi.SonarQualityProfile{}, profile)
+
+			for _, err := range []error{errCreate, errUpdate} {
+				if len(tt.wantErr) == 0 {
+					require.NoError(t, err)
+
+					continue
+				}
+
+				require.Error(t, err)
+				assert.True(t, apierrors.IsInvalid(err))
+
+				for _, want := range tt.wantErr {
+					assert.Contains(t, err.Error(), want)
+				}
+			}
+		})
+	}
+}
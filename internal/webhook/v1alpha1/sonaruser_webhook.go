@@ -0,0 +1,69 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+// SetupSonarUserWebhookWithManager registers the validating webhook for SonarUser.
+func SetupSonarUserWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&sonarApi.SonarUser{}).
+		WithValidator(&SonarUserCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-edp-epam-com-v1alpha1-sonaruser,mutating=false,failurePolicy=fail,sideEffects=None,groups=edp.epam.com,resources=sonarusers,verbs=create;update,versions=v1alpha1,name=vsonaruser-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// SonarUserCustomValidator validates SonarUser on create and update.
+type SonarUserCustomValidator struct{}
+
+var _ webhook.CustomValidator = &SonarUserCustomValidator{}
+
+// ValidateCreate validates SonarUser on create.
+func (v *SonarUserCustomValidator) ValidateCreate(
+	_ context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	return nil, validateSonarUser(obj)
+}
+
+// ValidateUpdate validates SonarUser on update.
+// The update is allowed without validation if SonarUser is being deleted or its spec is not changed.
+func (v *SonarUserCustomValidator) ValidateUpdate(
+	_ context.Context,
+	oldObj, newObj runtime.Object,
+) (admission.Warnings, error) {
+	if skipUpdateValidation(oldObj, newObj, func(user *sonarApi.SonarUser) any { return user.Spec }) {
+		return nil, nil
+	}
+
+	return nil, validateSonarUser(newObj)
+}
+
+// ValidateDelete does nothing, deletion of SonarUser is always allowed.
+func (v *SonarUserCustomValidator) ValidateDelete(
+	_ context.Context,
+	_ runtime.Object,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateSonarUser(obj runtime.Object) error {
+	user, ok := obj.(*sonarApi.SonarUser)
+	if !ok {
+		return fmt.Errorf("expected a SonarUser object but got %T", obj)
+	}
+
+	errs := validatePermissions(field.NewPath("spec", "permissions"), user.Spec.Permissions, globalPermissions)
+
+	return invalid("SonarUser", user.Name, errs)
+}
@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestSonarUserCustomValidator(t *testing.T) {
+	t.Parallel()
+
+	newUser := func(permissions ...string) *sonarApi.SonarUser {
+		return &sonarApi.SonarUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "user"},
+			Spec: sonarApi.SonarUserSpec{
+				Login:       "user",
+				Name:        "User",
+				Secret:      "user-secret",
+				Permissions: permissions,
+			},
+		}
+	}
+
+	v := &SonarUserCustomValidator{}
+
+	_, err := v.ValidateCreate(context.Background(), newUser("admin", "provisioning", "scan"))
+	require.NoError(t, err)
+
+	_, err = v.ValidateUpdate(context.Background(), newUser(), newUser("admin", "codeviewer"))
+	require.Error(t, err)
+	assert.True(t, apierrors.IsInvalid(err))
+	assert.Contains(t, err.Error(), `spec.permissions[1]: Unsupported value: "codeviewer"`)
+
+	deletedUser := newUser("codeviewer")
+	deletedUser.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	_, err = v.ValidateUpdate(context.Background(), newUser("codeviewer"), deletedUser)
+	require.NoError(t, err, "finalizer of the deleted user must be removable")
+
+	_, err = v.ValidateCreate(context.Background(), &sonarApi.SonarGroup{})
+	require.ErrorContains(t, err, "expected a SonarUser object")
+}
@@ -0,0 +1,130 @@
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+var (
+	// globalPermissions are the permissions that can be granted to users and groups without a project.
+	globalPermissions = []string{
+		"admin",
+		"gateadmin",
+		"profileadmin",
+		"provisioning",
+		"scan",
+		"applicationcreator",
+		"portfoliocreator",
+	}
+
+	// projectPermissions are the permissions that can be granted to groups in permission templates.
+	projectPermissions = []string{
+		"admin",
+		"codeviewer",
+		"issueadmin",
+		"securityhotspotadmin",
+		"scan",
+		"user",
+	}
+
+	conditionOperators = []string{"LT", "GT"}
+
+	ruleSeverities = []string{"INFO", "MINOR", "MAJOR", "CRITICAL", "BLOCKER"}
+
+	projectKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9_.:-]+$`)
+)
+
+// validatePermissions checks that the permissions are in the list of allowed permissions.
+func validatePermissions(path *field.Path, permissions, allowed []string) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, p := range permissions {
+		if !slices.Contains(allowed, p) {
+			errs = append(errs, field.NotSupported(path.Index(i), p, allowed))
+		}
+	}
+
+	return errs
+}
+
+// validateProjectKey checks the project key against the sonar rules:
+// only alphanumeric, '-', '_', '.' and ':' characters with at least one non-digit.
+func validateProjectKey(path *field.Path, key string) field.ErrorList {
+	if !projectKeyRegexp.MatchString(key) {
+		return field.ErrorList{field.Invalid(path, key,
+			"must contain only alphanumeric characters, '-', '_', '.' and ':'")}
+	}
+
+	if strings.Trim(key, "0123456789") == "" {
+		return field.ErrorList{field.Invalid(path, key, "must contain at least one non-digit character")}
+	}
+
+	return nil
+}
+
+// validateRuleParams checks that the rule params are a semicolon separated list of key=value.
+func validateRuleParams(path *field.Path, params string) field.ErrorList {
+	for _, param := range strings.Split(params, ";") {
+		if param == "" {
+			continue
+		}
+
+		if key, _, ok := strings.Cut(param, "="); !ok || strings.TrimSpace(key) == "" {
+			return field.ErrorList{field.Invalid(path, params,
+				fmt.Sprintf("parameter %q must be in key=value format, parameters must be separated by ';'", param))}
+		}
+	}
+
+	return nil
+}
+
+// validateRegexp checks that the value is a valid regular expression.
+// Sonar uses Java regular expressions, so the Perl syntax that is not supported by Go, e.g. lookarounds, is allowed.
+func validateRegexp(path *field.Path, value string) field.ErrorList {
+	_, err := syntax.Parse(value, syntax.Perl)
+
+	var syntaxErr *syntax.Error
+	if err == nil || errors.As(err, &syntaxErr) && syntaxErr.Code == syntax.ErrInvalidPerlOp {
+		return nil
+	}
+
+	return field.ErrorList{field.Invalid(path, value, fmt.Sprintf("must be a valid regular expression: %v", err))}
+}
+
+// skipUpdateValidation checks if the update of the resource doesn't need validation.
+// The resource that is being deleted is not validated, so its finalizer can be removed even if its spec
+// was created before the validation rules. The update that doesn't change the spec is not validated for the same reason.
+func skipUpdateValidation[T client.Object](oldObj, newObj runtime.Object, spec func(T) any) bool {
+	oldRes, ok := oldObj.(T)
+	if !ok {
+		return false
+	}
+
+	newRes, ok := newObj.(T)
+	if !ok {
+		return false
+	}
+
+	return newRes.GetDeletionTimestamp() != nil || equality.Semantic.DeepEqual(spec(oldRes), spec(newRes))
+}
+
+// invalid returns the Invalid error for the resource if there are validation errors.
+func invalid(kind, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(sonarApi.SchemeGroupVersion.WithKind(kind).GroupKind(), name, errs)
+}
@@ -0,0 +1,108 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateProjectKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		key     string
+		wantErr string
+	}{
+		{name: "valid key", key: "org.example:my-project_1"},
+		{name: "digits with non-digit", key: "123a"},
+		{name: "invalid characters", key: "my project", wantErr: "must contain only alphanumeric characters"},
+		{name: "slash", key: "team/project", wantErr: "must contain only alphanumeric characters"},
+		{name: "only digits", key: "12345", wantErr: "must contain at least one non-digit character"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assertErrors(t, tt.wantErr, validateProjectKey(field.NewPath("spec", "key"), tt.key))
+		})
+	}
+}
+
+func TestValidateRuleParams(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		params  string
+		wantErr string
+	}{
+		{name: "empty", params: ""},
+		{name: "single param", params: "max=10"},
+		{name: "multiple params with trailing separator", params: "key1=v1;key2=v2;"},
+		{name: "value with equal sign", params: "format=^[a-z]+=$"},
+		{name: "empty value", params: "key1="},
+		{name: "missing equal sign", params: "key1=v1;key2", wantErr: `parameter "key2" must be in key=value format`},
+		{name: "empty key", params: "=v1", wantErr: `parameter "=v1" must be in key=value format`},
+		{name: "value with comma", params: "pattern=a,b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assertErrors(t, tt.wantErr, validateRuleParams(field.NewPath("spec", "rules").Key("S1"), tt.params))
+		})
+	}
+}
+
+func TestValidateRegexp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{name: "empty", value: ""},
+		{name: "valid", value: "finance.*"},
+		{name: "java lookahead", value: "^(?!test).*"},
+		{name: "unclosed group", value: "finance(.*", wantErr: "must be a valid regular expression"},
+		{name: "invalid repetition", value: "*finance", wantErr: "must be a valid regular expression"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assertErrors(t, tt.wantErr, validateRegexp(field.NewPath("spec", "projectKeyPattern"), tt.value))
+		})
+	}
+}
+
+func TestValidatePermissions(t *testing.T) {
+	t.Parallel()
+
+	errs := validatePermissions(field.NewPath("spec", "permissions"),
+		[]string{"admin", "codeviewer", "scan", "unknown"}, globalPermissions)
+
+	if assert.Len(t, errs, 2) {
+		assert.Equal(t, "spec.permissions[1]", errs[0].Field)
+		assert.Equal(t, field.ErrorTypeNotSupported, errs[0].Type)
+		assert.Equal(t, "spec.permissions[3]", errs[1].Field)
+	}
+}
+
+func assertErrors(t *testing.T, wantErr string, errs field.ErrorList) {
+	t.Helper()
+
+	if wantErr == "" {
+		assert.Empty(t, errs)
+
+		return
+	}
+
+	assert.ErrorContains(t, errs.ToAggregate(), wantErr)
+}
@@ -0,0 +1,25 @@
+// Package v1alpha1 contains the validating webhooks of the v1alpha1 custom resources.
+// They reject the specs that sonar would reject, so the errors are reported at apply time.
+package v1alpha1
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// SetupWebhooksWithManager registers all validating webhooks in the manager.
+func SetupWebhooksWithManager(mgr ctrl.Manager) error {
+	for _, setup := range []func(ctrl.Manager) error{
+		SetupSonarProjectWebhookWithManager,
+		SetupSonarQualityGateWebhookWithManager,
+		SetupSonarQualityProfileWebhookWithManager,
+		SetupSonarUserWebhookWithManager,
+		SetupSonarGroupWebhookWithManager,
+		SetupSonarPermissionTemplateWebhookWithManager,
+	} {
+		if err := setup(mgr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
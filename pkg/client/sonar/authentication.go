@@ -0,0 +1,105 @@
+package sonar
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+)
+
+const tokenExpirationDateLayout = "2006-01-02"
+
+type validateCredentialsResponse struct {
+	Valid bool `json:"valid"`
+}
+
+// ValidateCredentials checks if the user can log in to sonar with the given password.
+// The client credentials are not changed.
+func (sc *Client) ValidateCredentials(ctx context.Context, user, password string) (bool, error) {
+	// request level token takes precedence over both client basic auth and client token.
+	return sc.validate(ctx, "Basic", base64.StdEncoding.EncodeToString([]byte(user+":"+password)))
+}
+
+// ValidateToken checks if the token can be used to log in to sonar.
+// The client credentials are not changed.
+func (sc *Client) ValidateToken(ctx context.Context, token string) (bool, error) {
+	return sc.validate(ctx, "Bearer", token)
+}
+
+// IsAuthenticated checks if the client credentials can be used to log in to sonar.
+func (sc *Client) IsAuthenticated(ctx context.Context) (bool, error) {
+	var result validateCredentialsResponse
+
+	resp, err := sc.startRequest(ctx).
+		SetResult(&result).
+		Get("/authentication/validate")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return false, fmt.Errorf("failed to validate credentials: %w", err)
+	}
+
+	return result.Valid, nil
+}
+
+func (sc *Client) validate(ctx context.Context, scheme, token string) (bool, error) {
+	var result validateCredentialsResponse
+
+	resp, err := sc.startRequest(ctx).
+		SetAuthScheme(scheme).
+		SetAuthToken(token).
+		SetResult(&result).
+		Get("/authentication/validate")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return false, fmt.Errorf("failed to validate credentials: %w", err)
+	}
+
+	return result.Valid, nil
+}
+
+// SetCredentials sets the credentials that are used for all subsequent requests.
+func (sc *Client) SetCredentials(user, password string) {
+	sc.resty.SetAuthToken("")
+	sc.resty.SetBasicAuth(user, password)
+}
+
+// SetToken sets the token that is sent as a Bearer header in all subsequent requests.
+func (sc *Client) SetToken(token string) {
+	sc.resty.UserInfo = nil
+	sc.resty.SetAuthScheme("Bearer")
+	sc.resty.SetAuthToken(token)
+}
+
+// GenerateToken generates a token for the current user that expires at the given date.
+func (sc *Client) GenerateToken(ctx context.Context, name string, expirationDate time.Time) (*UserToken, error) {
+	var result UserToken
+
+	resp, err := sc.startRequest(ctx).
+		SetFormData(map[string]string{
+			nameField:        name,
+			"expirationDate": expirationDate.UTC().Format(tokenExpirationDateLayout),
+		}).
+		SetResult(&result).
+		Post("/user_tokens/generate")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to generate token %s: %w", name, err)
+	}
+
+	return &result, nil
+}
+
+// RevokeToken revokes the token of the current user.
+func (sc *Client) RevokeToken(ctx context.Context, name string) error {
+	resp, err := sc.startRequest(ctx).
+		SetFormData(map[string]string{
+			nameField: name,
+		}).
+		Post("/user_tokens/revoke")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return fmt.Errorf("failed to revoke token %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,291 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ValidateCredentials(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		serverBody     string
+		want           bool
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "valid credentials",
+			serverResponse: http.StatusOK,
+			serverBody:     `{"valid":true}`,
+			want:           true,
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "invalid credentials",
+			serverResponse: http.StatusOK,
+			serverBody:     `{"valid":false}`,
+			want:           false,
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "server error",
+			serverResponse: http.StatusInternalServerError,
+			serverBody:     `{"errors":[{"msg":"Internal server error"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to validate credentials")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/api/authentication/validate", r.URL.Path)
+
+				user, password, ok := r.BasicAuth()
+				assert.True(t, ok)
+				assert.Equal(t, "admin", user)
+				assert.Equal(t, "candidate", password)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err := w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "admin", "current")
+
+			got, err := client.ValidateCredentials(context.Background(), "admin", "candidate")
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_SetCredentials(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", user)
+		assert.Equal(t, "new-password", password)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "admin", "old-password")
+	client.SetCredentials("admin", "new-password")
+
+	require.NoError(t, client.DeleteWebhook(context.Background(), "AU-key"))
+}
+
+func TestClient_ValidateToken(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/authentication/validate", r.URL.Path)
+		assert.Equal(t, "Bearer candidate-token", r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"valid":true}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "admin", "password")
+
+	got, err := client.ValidateToken(context.Background(), "candidate-token")
+
+	require.NoError(t, err)
+	assert.True(t, got)
+}
+
+func TestClient_IsAuthenticated(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/authentication/validate", r.URL.Path)
+		assert.Equal(t, "Bearer client-token", r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"valid":false}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "", "")
+	client.SetToken("client-token")
+
+	got, err := client.IsAuthenticated(context.Background())
+
+	require.NoError(t, err)
+	assert.False(t, got)
+}
+
+func TestClient_SetToken(t *testing.T) {
+	t.Parallel()
+
+	var authHeaders []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"valid":true}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "admin", "password")
+	client.SetToken("operator-token")
+
+	require.NoError(t, client.DeleteWebhook(context.Background(), "AU-key"))
+
+	// explicit credentials are validated with basic auth even if the client uses a token.
+	_, err := client.ValidateCredentials(context.Background(), "admin", "candidate")
+	require.NoError(t, err)
+
+	client.SetCredentials("admin", "password")
+	require.NoError(t, client.DeleteWebhook(context.Background(), "AU-key"))
+
+	require.Len(t, authHeaders, 3)
+	assert.Equal(t, "Bearer operator-token", authHeaders[0])
+	assert.Equal(t, "Basic YWRtaW46Y2FuZGlkYXRl", authHeaders[1])
+	assert.Equal(t, "Basic YWRtaW46cGFzc3dvcmQ=", authHeaders[2])
+}
+
+func TestClient_GenerateToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		serverBody     string
+		want           *UserToken
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusOK,
+			serverBody: `{"login":"admin","name":"operator","token":"squ_token",` +
+				`"expirationDate":"2024-02-01T00:00:00+0000"}`,
+			want: &UserToken{
+				Login:          "admin",
+				Name:           "operator",
+				Token:          "squ_token",
+				ExpirationDate: "2024-02-01T00:00:00+0000",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:           "token already exists",
+			serverResponse: http.StatusBadRequest,
+			serverBody:     `{"errors":[{"msg":"A user token for login 'admin' and name 'operator' already exists"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to generate token operator")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/api/user_tokens/generate", r.URL.Path)
+
+				err := r.ParseForm()
+				require.NoError(t, err)
+
+				assert.Equal(t, "operator", r.FormValue("name"))
+				assert.Equal(t, "2024-02-01", r.FormValue("expirationDate"))
+				assert.Empty(t, r.FormValue("login"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err = w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "admin", "password")
+
+			got, err := client.GenerateToken(
+				context.Background(),
+				"operator",
+				time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			)
+
+			tt.wantErr(t, err)
+
+			if tt.want != nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_RevokeToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusNoContent,
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "token not found",
+			serverResponse: http.StatusNotFound,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.True(t, IsErrNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/api/user_tokens/revoke", r.URL.Path)
+
+				err := r.ParseForm()
+				require.NoError(t, err)
+
+				assert.Equal(t, "operator", r.FormValue("name"))
+
+				w.WriteHeader(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "admin", "password")
+
+			tt.wantErr(t, client.RevokeToken(context.Background(), "operator"))
+		})
+	}
+}
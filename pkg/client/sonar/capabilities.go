@@ -0,0 +1,115 @@
+package sonar
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// EditionCommunity is the free edition of SonarQube that doesn't support licenses.
+const EditionCommunity = "community"
+
+// Version is the version of the sonar server.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseVersion parses the version returned by sonar, e.g. 10.5.1.90531.
+// The build number is ignored.
+func ParseVersion(version string) (Version, error) {
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 {
+		return Version{}, fmt.Errorf("invalid sonar version %q", version)
+	}
+
+	nums := make([]int, 3)
+
+	for i := 0; i < len(parts) && i < len(nums); i++ {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid sonar version %q: %w", version, err)
+		}
+
+		nums[i] = n
+	}
+
+	return Version{Major: nums[0], Minor: nums[1], Patch: nums[2]}, nil
+}
+
+// AtLeast checks if the version is greater than or equal to the given major and minor version.
+func (v Version) AtLeast(major, minor int) bool {
+	if v.Major != major {
+		return v.Major > major
+	}
+
+	return v.Minor >= minor
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Capabilities describes which API the sonar server supports.
+// A nil Capabilities means that the server is unknown, and the v1 Web API is used.
+type Capabilities struct {
+	Version Version
+	// Edition is empty if it can't be detected.
+	Edition string
+}
+
+// UsersV2 checks if the users are managed with the v2 API that replaces /api/users in SonarQube 10.4.
+func (c *Capabilities) UsersV2() bool {
+	return c != nil && c.Version.AtLeast(10, 4)
+}
+
+// GroupsV2 checks if the groups and group memberships are managed with the v2 API
+// that replaces /api/user_groups in SonarQube 10.5.
+func (c *Capabilities) GroupsV2() bool {
+	return c != nil && c.Version.AtLeast(10, 5)
+}
+
+// QualityGatesByName checks if the quality gates are identified by name.
+// Before SonarQube 8.4 the quality gates API requires the quality gate ID.
+func (c *Capabilities) QualityGatesByName() bool {
+	return c == nil || c.Version.AtLeast(8, 4)
+}
+
+// Licensing checks if the server supports licenses.
+func (c *Capabilities) Licensing() bool {
+	return c == nil || c.Edition != EditionCommunity
+}
+
+// Capabilities returns the detected capabilities of the sonar server or nil if they are not detected.
+func (sc *Client) Capabilities() *Capabilities {
+	return sc.capabilities
+}
+
+// DetectCapabilities detects the version and edition of the sonar server.
+// The client uses them to choose the request shape for each endpoint family.
+func (sc *Client) DetectCapabilities(ctx context.Context) error {
+	status, err := sc.SystemStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	version, err := ParseVersion(status.Version)
+	if err != nil {
+		return err
+	}
+
+	// the global navigation requires authentication if sonar forces it,
+	// so the edition stays unknown until the credentials are valid.
+	edition, err := sc.Edition(ctx)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).Info("Unable to detect sonar edition", "error", err.Error())
+	}
+
+	sc.capabilities = &Capabilities{Version: version, Edition: edition}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		version string
+		want    Version
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:    "version with build number",
+			version: "10.5.1.90531",
+			want:    Version{Major: 10, Minor: 5, Patch: 1},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "calendar version",
+			version: "2025.1",
+			want:    Version{Major: 2025, Minor: 1},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "only major version",
+			version: "10",
+			wantErr: require.Error,
+		},
+		{
+			name:    "not a number",
+			version: "10.x",
+			wantErr: require.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseVersion(tt.version)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                   string
+		capabilities           *Capabilities
+		wantUsersV2            bool
+		wantGroupsV2           bool
+		wantQualityGatesByName bool
+		wantLicensing          bool
+	}{
+		{
+			name:                   "unknown server",
+			wantQualityGatesByName: true,
+			wantLicensing:          true,
+		},
+		{
+			name:          "legacy server",
+			capabilities:  &Capabilities{Version: Version{Major: 8, Minor: 3}, Edition: "developer"},
+			wantLicensing: true,
+		},
+		{
+			name:                   "users v2 only",
+			capabilities:           &Capabilities{Version: Version{Major: 10, Minor: 4}, Edition: EditionCommunity},
+			wantUsersV2:            true,
+			wantQualityGatesByName: true,
+		},
+		{
+			name:                   "calendar version",
+			capabilities:           &Capabilities{Version: Version{Major: 2025, Minor: 1}, Edition: "enterprise"},
+			wantUsersV2:            true,
+			wantGroupsV2:           true,
+			wantQualityGatesByName: true,
+			wantLicensing:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantUsersV2, tt.capabilities.UsersV2())
+			assert.Equal(t, tt.wantGroupsV2, tt.capabilities.GroupsV2())
+			assert.Equal(t, tt.wantQualityGatesByName, tt.capabilities.QualityGatesByName())
+			assert.Equal(t, tt.wantLicensing, tt.capabilities.Licensing())
+		})
+	}
+}
+
+func TestClient_DetectCapabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		status  string
+		edition int
+		want    *Capabilities
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:    "version and edition are detected",
+			status:  `{"id":"server-id","version":"10.5.1.90531","status":"UP"}`,
+			edition: http.StatusOK,
+			want:    &Capabilities{Version: Version{Major: 10, Minor: 5, Patch: 1}, Edition: "developer"},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "edition requires authentication",
+			status:  `{"id":"server-id","version":"9.9.0.65466","status":"UP"}`,
+			edition: http.StatusUnauthorized,
+			want:    &Capabilities{Version: Version{Major: 9, Minor: 9}},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "invalid version",
+			status:  `{"id":"server-id","version":"","status":"STARTING"}`,
+			edition: http.StatusOK,
+			wantErr: require.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/system/status", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, err := w.Write([]byte(tt.status))
+				require.NoError(t, err)
+			})
+			mux.HandleFunc("/api/navigation/global", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.edition)
+				_, err := w.Write([]byte(`{"edition":"developer"}`))
+				require.NoError(t, err)
+			})
+
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			c := NewClient(server.URL, "user", "password")
+			err := c.DetectCapabilities(context.Background())
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, c.Capabilities())
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package sonar
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+// clientCache keeps a client per sonar UID, so the connections and the rate limiter
+// are shared by all resources referencing sonar.
+type clientCache struct {
+	mu      sync.Mutex
+	clients map[types.UID]*cachedClient
+}
+
+// cachedClient is the client with the hash of the sonar spec and the Secrets and ConfigMaps
+// that were read to create it. The client is recreated when the hash changes.
+type cachedClient struct {
+	client       *Client
+	hash         string
+	kind         string
+	key          types.NamespacedName
+	dependencies map[objectRef]func() client.Object
+}
+
+// objectRef identifies a Secret or ConfigMap read to create a client.
+type objectRef struct {
+	kind string
+	key  types.NamespacedName
+}
+
+func newClientCache() *clientCache {
+	return &clientCache{clients: make(map[types.UID]*cachedClient)}
+}
+
+func (c *clientCache) get(uid types.UID) *cachedClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.clients[uid]
+}
+
+func (c *clientCache) set(uid types.UID, entry *cachedClient) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clients[uid] = entry
+}
+
+// evict removes the clients of the deleted sonar.
+func (c *clientCache) evict(kind string, key types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for uid, entry := range c.clients {
+		if entry.kind == kind && entry.key == key {
+			delete(c.clients, uid)
+		}
+	}
+}
+
+// EvictClient removes the cached client, the rate limiter and the metrics of the deleted Sonar or ClusterSonar.
+// The namespace is ignored for ClusterSonar.
+func (p *ApiClientProvider) EvictClient(kind string, key types.NamespacedName) {
+	if kind == common.ClusterSonarKind {
+		key.Namespace = ""
+	}
+
+	instance := instanceNameFromKey(kind, key.Namespace, key.Name)
+
+	p.cache.evict(kind, key)
+	p.limiters.delete(instance)
+	deleteInstanceMetrics(instance)
+}
+
+// getCachedClient returns the cached client of sonar if neither sonar spec and version nor the Secrets and ConfigMaps
+// that were read to create the client have changed since then. Otherwise, a new client is created and cached.
+// The client whose capabilities were not detected is not cached, so the detection is retried with the next call.
+func (p *ApiClientProvider) getCachedClient(ctx context.Context, sonar *sonarApi.Sonar) (*Client, error) {
+	if entry := p.cache.get(sonar.UID); entry != nil {
+		hash, err := p.clientHash(ctx, sonar, entry.dependencies)
+		if err != nil {
+			return nil, err
+		}
+
+		if hash == entry.hash {
+			return entry.client, nil
+		}
+	}
+
+	recorder := &versionRecorder{
+		Client:   p.k8sClient,
+		versions: make(map[objectRef]string),
+		objects:  make(map[objectRef]func() client.Object),
+	}
+
+	c, err := p.buildClient(ctx, recorder, sonar)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.capabilities == nil && !sonar.Spec.IsSonarCloud() {
+		return c, nil
+	}
+
+	hash, err := hashClient(sonar, recorder.versions)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &cachedClient{
+		client:       c,
+		hash:         hash,
+		kind:         common.SonarKind,
+		key:          types.NamespacedName{Namespace: sonar.Namespace, Name: sonar.Name},
+		dependencies: recorder.objects,
+	}
+
+	if sonar.IsClusterSonarView() {
+		entry.kind = common.ClusterSonarKind
+		entry.key.Namespace = ""
+	}
+
+	p.cache.set(sonar.UID, entry)
+
+	return c, nil
+}
+
+// clientHash returns the hash of sonar spec and the current versions of the given Secrets and ConfigMaps.
+func (p *ApiClientProvider) clientHash(
+	ctx context.Context,
+	sonar *sonarApi.Sonar,
+	dependencies map[objectRef]func() client.Object,
+) (string, error) {
+	versions := make(map[objectRef]string, len(dependencies))
+
+	for ref, newObject := range dependencies {
+		obj := newObject()
+		if err := p.k8sClient.Get(ctx, ref.key, obj); err != nil {
+			if !k8sErrors.IsNotFound(err) {
+				return "", fmt.Errorf("failed to get %s %s: %w", ref.kind, ref.key, err)
+			}
+		}
+
+		versions[ref] = obj.GetResourceVersion()
+	}
+
+	return hashClient(sonar, versions)
+}
+
+// hashClient returns the hash of sonar URL, spec, server version and the versions of the Secrets and ConfigMaps
+// with credentials. The server version is included to detect the capabilities again after sonar upgrade.
+func hashClient(sonar *sonarApi.Sonar, versions map[objectRef]string) (string, error) {
+	spec, err := json.Marshal(sonar.Spec)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal sonar spec: %w", err)
+	}
+
+	refs := make([]string, 0, len(versions))
+	for ref, version := range versions {
+		refs = append(refs, fmt.Sprintf("%s/%s=%s", ref.kind, ref.key, version))
+	}
+
+	slices.Sort(refs)
+
+	h := sha256.New()
+	h.Write([]byte(sonar.Namespace))
+	h.Write(spec)
+	h.Write([]byte(sonar.Status.Version))
+
+	for _, ref := range refs {
+		h.Write([]byte(ref))
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// versionRecorder records the resource versions of the objects read while a client is created.
+// The missing objects are recorded with an empty version, so the client is recreated when they appear.
+type versionRecorder struct {
+	client.Client
+	versions map[objectRef]string
+	objects  map[objectRef]func() client.Object
+}
+
+// Get reads the object with the wrapped client and records its version.
+// The error of the wrapped client is returned as is, so the callers can check it.
+func (r *versionRecorder) Get(
+	ctx context.Context,
+	key client.ObjectKey,
+	obj client.Object,
+	opts ...client.GetOption,
+) error {
+	err := r.Client.Get(ctx, key, obj, opts...)
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return err
+	}
+
+	objType := reflect.TypeOf(obj).Elem()
+	ref := objectRef{kind: objType.Name(), key: key}
+
+	r.versions[ref] = obj.GetResourceVersion()
+	r.objects[ref] = func() client.Object {
+		return reflect.New(objType).Interface().(client.Object)
+	}
+
+	return err
+}
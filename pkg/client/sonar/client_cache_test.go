@@ -0,0 +1,281 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestApiClientProvider_ClientCache(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if r.URL.Path == "/api/system/status" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"UP","version":"10.5.0.89998"}`))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	spec := sonarApi.SonarSpec{
+		Url:           server.URL,
+		Secret:        "sonar-admin",
+		RequestPolicy: &sonarApi.SonarRequestPolicy{MaxRetries: ptr.To(0)},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&sonarApi.Sonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "default", UID: "sonar-uid"},
+			Spec:       spec,
+			Status:     sonarApi.SonarStatus{Connected: true},
+		},
+		&sonarApi.ClusterSonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared", UID: "shared-uid"},
+			Spec:       spec,
+			Status:     sonarApi.SonarStatus{Connected: true},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar-admin", Namespace: "default"},
+			Data:       map[string][]byte{"user": []byte("admin"), "password": []byte("password")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar-admin", Namespace: "operators"},
+			Data:       map[string][]byte{"user": []byte("admin"), "password": []byte("password")},
+		},
+	).Build()
+
+	ctx := context.Background()
+	provider := NewApiClientProvider(k8sClient, "operators")
+
+	getClient := func(ref common.SonarRef) *Client {
+		c, err := provider.GetSonarApiClientFromSonarRef(ctx, "default", &sonarApi.SonarProject{
+			Spec: sonarApi.SonarProjectSpec{SonarRef: ref},
+		})
+		require.NoError(t, err)
+
+		return c
+	}
+
+	sonarRef := common.SonarRef{Name: "sonar"}
+	clusterSonarRef := common.SonarRef{Name: "shared", Kind: common.ClusterSonarKind}
+
+	c := getClient(sonarRef)
+	detectRequests := requests.Load()
+
+	assert.Same(t, c, getClient(sonarRef), "client must be reused")
+	assert.Equal(t, detectRequests, requests.Load(), "capabilities must be detected once")
+	assert.NotSame(t, c, getClient(clusterSonarRef), "cluster sonar must have its own client")
+
+	secret := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Name: "sonar-admin", Namespace: "default"}, secret))
+	secret.Data["password"] = []byte("new-password")
+	require.NoError(t, k8sClient.Update(ctx, secret))
+
+	changed := getClient(sonarRef)
+	assert.NotSame(t, c, changed, "client must be recreated when the secret changes")
+	assert.Same(t, changed, getClient(sonarRef))
+
+	sonar := &sonarApi.Sonar{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Name: "sonar", Namespace: "default"}, sonar))
+	sonar.Spec.Url += "/"
+	require.NoError(t, k8sClient.Update(ctx, sonar))
+
+	updated := getClient(sonarRef)
+	assert.NotSame(t, changed, updated, "client must be recreated when sonar changes")
+
+	provider.EvictClient(common.SonarKind, types.NamespacedName{Name: "sonar", Namespace: "default"})
+	assert.NotSame(t, updated, getClient(sonarRef), "client must be recreated after eviction")
+
+	shared := getClient(clusterSonarRef)
+	provider.EvictClient(common.ClusterSonarKind, types.NamespacedName{Name: "shared", Namespace: "operators"})
+	assert.NotSame(t, shared, getClient(clusterSonarRef), "cluster sonar client must be recreated after eviction")
+
+	require.NoError(t, k8sClient.Delete(ctx, secret))
+
+	_, err := provider.GetSonarApiClientFromSonarRef(ctx, "default", &sonarApi.SonarProject{
+		Spec: sonarApi.SonarProjectSpec{SonarRef: sonarRef},
+	})
+	require.Error(t, err, "client must not be reused when the secret is deleted")
+}
+
+func TestApiClientProvider_ClientCacheCapabilities(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	var sonarUp atomic.Bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/system/status" && sonarUp.Load() {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"UP","version":"10.5.0.89998"}`))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&sonarApi.Sonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "default", UID: "sonar-uid"},
+			Spec: sonarApi.SonarSpec{
+				Url:           server.URL,
+				Secret:        "sonar-admin",
+				RequestPolicy: &sonarApi.SonarRequestPolicy{MaxRetries: ptr.To(0)},
+			},
+			Status: sonarApi.SonarStatus{Connected: true, Version: "9.9.0.65466"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar-admin", Namespace: "default"},
+			Data:       map[string][]byte{"user": []byte("admin"), "password": []byte("password")},
+		},
+	).Build()
+
+	ctx := context.Background()
+	provider := NewApiClientProvider(k8sClient, "operators")
+
+	getClient := func() *Client {
+		c, err := provider.GetSonarApiClientFromSonarRef(ctx, "default", &sonarApi.SonarProject{
+			Spec: sonarApi.SonarProjectSpec{SonarRef: common.SonarRef{Name: "sonar"}},
+		})
+		require.NoError(t, err)
+
+		return c
+	}
+
+	undetected := getClient()
+	assert.Nil(t, undetected.capabilities)
+	assert.NotSame(t, undetected, getClient(), "client without capabilities must not be cached")
+
+	sonarUp.Store(true)
+
+	c := getClient()
+	require.NotNil(t, c.capabilities)
+	assert.Same(t, c, getClient(), "client with capabilities must be reused")
+
+	sonar := &sonarApi.Sonar{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Name: "sonar", Namespace: "default"}, sonar))
+	sonar.Status.Version = "10.5.0.89998"
+	require.NoError(t, k8sClient.Update(ctx, sonar))
+
+	assert.NotSame(t, c, getClient(), "client must be recreated when sonar version changes")
+}
+
+func TestVersionRecorder_Get(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "default"},
+	}).Build()
+
+	recorder := &versionRecorder{
+		Client:   k8sClient,
+		versions: make(map[objectRef]string),
+		objects:  make(map[objectRef]func() client.Object),
+	}
+
+	require.NoError(t, recorder.Get(context.Background(), types.NamespacedName{Name: "ca", Namespace: "default"},
+		&corev1.ConfigMap{}))
+	require.Error(t, recorder.Get(context.Background(), types.NamespacedName{Name: "token", Namespace: "default"},
+		&corev1.Secret{}))
+
+	configMapRef := objectRef{kind: "ConfigMap", key: types.NamespacedName{Name: "ca", Namespace: "default"}}
+	secretRef := objectRef{kind: "Secret", key: types.NamespacedName{Name: "token", Namespace: "default"}}
+
+	assert.NotEmpty(t, recorder.versions[configMapRef])
+	assert.Contains(t, recorder.versions, secretRef)
+	assert.Empty(t, recorder.versions[secretRef])
+	assert.IsType(t, &corev1.Secret{}, recorder.objects[secretRef]())
+}
+
+func TestApiClientProvider_SharedRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	sonar := &sonarApi.Sonar{
+		ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "default", UID: "sonar-uid"},
+		Spec: sonarApi.SonarSpec{
+			Url:    server.URL,
+			Secret: "sonar-admin",
+			// the capabilities are not detected for SonarCloud, so the clients send only the requests of the test.
+			Flavor:        sonarApi.FlavorSonarCloud,
+			RequestPolicy: &sonarApi.SonarRequestPolicy{RateLimit: 1, Burst: 1, MaxRetries: ptr.To(0)},
+		},
+		Status: sonarApi.SonarStatus{Connected: true},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		sonar,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar-admin", Namespace: "default"},
+			Data:       map[string][]byte{"user": []byte("admin"), "password": []byte("password")},
+		},
+	).Build()
+
+	ctx := context.Background()
+	provider := NewApiClientProvider(k8sClient, "operators")
+
+	// the sonar controller creates a new client on every reconciliation.
+	first, err := provider.GetSonarApiClientFromSonar(ctx, sonar)
+	require.NoError(t, err)
+
+	_, err = first.Health(ctx)
+	require.NotContains(t, err.Error(), "rate limit wait failed")
+
+	second, err := provider.GetSonarApiClientFromSonar(ctx, sonar)
+	require.NoError(t, err)
+	require.NotSame(t, first, second)
+
+	// the first client has used the burst, so the request of the second client has to wait.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	_, err = second.Health(timeoutCtx)
+	require.ErrorContains(t, err, "rate limit wait failed")
+
+	provider.EvictClient(common.SonarKind, types.NamespacedName{Name: "sonar", Namespace: "default"})
+	assert.Empty(t, provider.limiters.limiters, "rate limiter must be removed after eviction")
+}
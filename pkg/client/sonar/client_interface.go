@@ -3,23 +3,40 @@ package sonar
 import (
 	"context"
 	"net/url"
+	"time"
 )
 
 // ClientInterface is an interface for Sonar client.
 type ClientInterface interface {
 	ConfigureGeneralSettings(settings ...SettingRequest) error
-	InstallPlugins(plugins []string) error
 	SetProjectsDefaultVisibility(visibility string) error
 
+	Authentication
 	UserInterface
 	GroupInterface
 	PermissionTemplateInterface
 	ProjectInterface
 	Settings
 	System
+	OrganizationClient
+	LicenseClient
+	PluginClient
+	WebhookClient
 	QualityGateClient
 	QualityProfileClient
 	RuleClient
+	MeasureClient
+}
+
+type Authentication interface {
+	ValidateCredentials(ctx context.Context, user, password string) (bool, error)
+	SetCredentials(user, password string)
+	ValidateToken(ctx context.Context, token string) (bool, error)
+	IsAuthenticated(ctx context.Context) (bool, error)
+	SetToken(token string)
+	GenerateToken(ctx context.Context, name string, expirationDate time.Time) (*UserToken, error)
+	RevokeToken(ctx context.Context, name string) error
+	ChangePassword(ctx context.Context, user string, oldPassword string, newPassword string) error
 }
 
 type UserInterface interface {
@@ -60,12 +77,44 @@ type PermissionTemplateInterface interface {
 }
 
 type Settings interface {
+	GetSettings(ctx context.Context, component string, settingsKeys []string) ([]Setting, error)
 	SetSetting(ctx context.Context, setting url.Values) error
-	ResetSettings(ctx context.Context, settingsKeys []string) error
+	ResetSettings(ctx context.Context, component string, settingsKeys []string) error
 }
 
 type System interface {
 	Health(ctx context.Context) (*SystemHealth, error)
+	SystemStatus(ctx context.Context) (*SystemStatusResponse, error)
+	Edition(ctx context.Context) (string, error)
+	Reboot() error
+	WaitForStatusIsUp(ctx context.Context, retryCount int, timeout time.Duration) error
+}
+
+type OrganizationClient interface {
+	GetOrganization(ctx context.Context, key string) (*Organization, error)
+}
+
+type LicenseClient interface {
+	GetLicense(ctx context.Context) (*License, error)
+	SetLicense(ctx context.Context, license string) error
+}
+
+type PluginClient interface {
+	GetPlugins(ctx context.Context) ([]Plugin, error)
+	GetAvailablePlugins(ctx context.Context) ([]AvailablePlugin, error)
+	GetPluginUpdates(ctx context.Context) ([]UpdatablePlugin, error)
+	GetPendingPlugins(ctx context.Context) (*PendingPlugins, error)
+	InstallPlugin(ctx context.Context, key string) error
+	UpdatePlugin(ctx context.Context, key string) error
+	UninstallPlugin(ctx context.Context, key string) error
+}
+
+type WebhookClient interface {
+	GetWebhooks(ctx context.Context, projectKey string) ([]Webhook, error)
+	GetWebhook(ctx context.Context, key, projectKey string) (*Webhook, error)
+	CreateWebhook(ctx context.Context, projectKey string, webhook *Webhook) (*Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook *Webhook) error
+	DeleteWebhook(ctx context.Context, key string) error
 }
 
 type QualityGateClient interface {
@@ -97,3 +146,8 @@ type ProjectInterface interface {
 	UpdateProject(ctx context.Context, project *Project) error
 	DeleteProject(ctx context.Context, projectKey string) error
 }
+
+type MeasureClient interface {
+	GetProjectQualityGateStatus(ctx context.Context, projectKey string) (string, error)
+	GetProjectMeasures(ctx context.Context, projectKey string, metricKeys []string) (map[string]float64, error)
+}
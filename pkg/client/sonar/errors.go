@@ -4,8 +4,20 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+
+	"github.com/epam/edp-sonar-operator/api/common"
 )
 
+// ErrSonarNotFound is returned when the sonar referenced by the resource doesn't exist.
+var ErrSonarNotFound = errors.New("referenced sonar is not found")
+
+// IsErrSonarRefUnavailable checks if the referenced sonar is deleted or doesn't allow references
+// from the namespace of the resource anymore. Such a resource can't be cleaned up in sonar.
+func IsErrSonarRefUnavailable(err error) bool {
+	return errors.Is(err, ErrSonarNotFound) || errors.Is(err, common.ErrNamespaceNotAllowed)
+}
+
 func IsErrNotFound(err error) bool {
 	return IsHTTPErrorCode(err, http.StatusNotFound)
 }
@@ -34,3 +46,29 @@ func IsHTTPErrorCode(err error, code int) bool {
 
 	return httpError.code == code
 }
+
+// IsErrConnection checks if the request failed because sonar is not reachable:
+// the request was not delivered, or sonar is unavailable behind a proxy.
+func IsErrConnection(err error) bool {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+
+	return IsHTTPErrorCode(err, http.StatusBadGateway) ||
+		IsHTTPErrorCode(err, http.StatusServiceUnavailable) ||
+		IsHTTPErrorCode(err, http.StatusGatewayTimeout)
+}
+
+// SetConnectionCondition sets the SonarConnected condition from the result of the requests to sonar.
+// The condition is not changed if the error is not caused by a request to sonar.
+func SetConnectionCondition(status *common.ReconcileStatus, generation int64, err error) {
+	var httpError HTTPError
+
+	switch {
+	case IsErrConnection(err):
+		status.SetSonarConnected(generation, err)
+	case err == nil, errors.As(err, &httpError):
+		status.SetSonarConnected(generation, nil)
+	}
+}
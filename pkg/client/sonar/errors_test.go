@@ -1,10 +1,22 @@
 package sonar
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/epam/edp-sonar-operator/api/common"
 )
 
 func TestIsErrNotFoundFalse(t *testing.T) {
@@ -22,3 +34,66 @@ func TestIsHTTPErrorCode(t *testing.T) {
 	}
 	assert.True(t, IsHTTPErrorCode(httpError, http.StatusOK))
 }
+
+func TestIsErrConnection(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	serverURL := server.URL
+	server.Close()
+
+	_, err := NewClient(serverURL, "user", "password").Health(context.Background())
+	assert.True(t, IsErrConnection(err))
+
+	assert.True(t, IsErrConnection(fmt.Errorf("failed: %w", NewHTTPError(http.StatusServiceUnavailable, ""))))
+	assert.False(t, IsErrConnection(NewHTTPError(http.StatusBadRequest, "")))
+	assert.False(t, IsErrConnection(errors.New("failed")))
+	assert.False(t, IsErrConnection(nil))
+}
+
+func TestIsErrSonarRefUnavailable(t *testing.T) {
+	assert.True(t, IsErrSonarRefUnavailable(sonarGetError("sonar", k8sErrors.NewNotFound(schema.GroupResource{}, "sonar"))))
+	assert.True(t, IsErrSonarRefUnavailable(fmt.Errorf("failed: %w", common.ErrNamespaceNotAllowed)))
+	assert.False(t, IsErrSonarRefUnavailable(sonarGetError("sonar", errors.New("timeout"))))
+	assert.False(t, IsErrSonarRefUnavailable(k8sErrors.NewNotFound(schema.GroupResource{}, "sonar-admin")))
+}
+
+func TestSetConnectionCondition(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     common.ReconcileStatus
+		err        error
+		wantStatus metav1.ConditionStatus
+	}{
+		{
+			name:       "requests succeeded",
+			wantStatus: metav1.ConditionTrue,
+		},
+		{
+			name:       "sonar responded with error",
+			err:        fmt.Errorf("failed: %w", NewHTTPError(http.StatusBadRequest, "")),
+			wantStatus: metav1.ConditionTrue,
+		},
+		{
+			name:       "sonar is not reachable",
+			err:        fmt.Errorf("failed: %w", &url.Error{Op: "Get", URL: "https://sonar", Err: errors.New("refused")}),
+			wantStatus: metav1.ConditionFalse,
+		},
+		{
+			name: "error is not related to sonar",
+			status: common.ReconcileStatus{
+				Conditions: []metav1.Condition{{Type: common.ConditionSonarConnected, Status: metav1.ConditionFalse}},
+			},
+			err:        errors.New("failed to get secret"),
+			wantStatus: metav1.ConditionFalse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetConnectionCondition(&tt.status, 1, tt.err)
+
+			c := meta.FindStatusCondition(tt.status.Conditions, common.ConditionSonarConnected)
+			require.NotNil(t, c)
+			assert.Equal(t, tt.wantStatus, c.Status)
+		})
+	}
+}
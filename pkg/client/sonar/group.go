@@ -6,6 +6,14 @@ import (
 	"net/http"
 )
 
+const (
+	// groupsV2Path is the v2 API of groups that replaces /api/user_groups in SonarQube 10.5.
+	groupsV2Path = "/v2/authorizations/groups"
+	// groupMembershipsV2Path is the v2 API of group memberships that replaces
+	// /api/user_groups/add_user and /api/user_groups/remove_user in SonarQube 10.5.
+	groupMembershipsV2Path = "/v2/authorizations/group-memberships"
+)
+
 type Group struct {
 	ID          string `json:"id,omitempty"`
 	Name        string `json:"name"`
@@ -14,24 +22,47 @@ type Group struct {
 
 type groupSearchResponse struct {
 	Groups []Group `json:"groups"`
+	pageInfo
+}
+
+type groupMembership struct {
+	ID      string `json:"id"`
+	GroupID string `json:"groupId"`
+	UserID  string `json:"userId"`
+}
+
+type groupMembershipSearchResponse struct {
+	GroupMemberships []groupMembership `json:"groupMemberships"`
+	pageInfo
 }
 
 func (sc *Client) SearchGroups(ctx context.Context, groupName string) ([]Group, error) {
-	var groupResponse groupSearchResponse
-	rsp, err := sc.startRequest(ctx).
-		SetResult(&groupResponse).
-		SetQueryParams(map[string]string{
-			"q":  groupName,
-			"f":  "name,description",
-			"ps": "500",
-		}).
-		Get("/user_groups/search")
+	if sc.capabilities.GroupsV2() {
+		return sc.searchGroupsV2(ctx, groupName)
+	}
 
-	if err = sc.checkError(rsp, err); err != nil {
+	groups, err := searchAll(ctx, v1Pager, func(ctx context.Context, params map[string]string) ([]Group, pageInfo, error) {
+		var groupResponse groupSearchResponse
+		rsp, err := sc.startOrgRequest(ctx).
+			SetResult(&groupResponse).
+			SetQueryParams(map[string]string{
+				"q": groupName,
+				"f": "name,description",
+			}).
+			SetQueryParams(params).
+			Get("/user_groups/search")
+
+		if err = sc.checkError(rsp, err); err != nil {
+			return nil, pageInfo{}, err
+		}
+
+		return groupResponse.Groups, groupResponse.pageInfo, nil
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to search for groups: %w", err)
 	}
 
-	return groupResponse.Groups, nil
+	return groups, nil
 }
 
 func (sc *Client) GetGroup(ctx context.Context, groupName string) (*Group, error) {
@@ -54,9 +85,13 @@ type createGroupResponse struct {
 }
 
 func (sc *Client) CreateGroup(ctx context.Context, group *Group) error {
+	if sc.capabilities.GroupsV2() {
+		return sc.createGroupV2(ctx, group)
+	}
+
 	var createGroupRsp createGroupResponse
 
-	rsp, err := sc.startRequest(ctx).
+	rsp, err := sc.startOrgRequest(ctx).
 		SetResult(&createGroupRsp).
 		SetFormData(map[string]string{
 			"name":        group.Name,
@@ -74,6 +109,10 @@ func (sc *Client) CreateGroup(ctx context.Context, group *Group) error {
 }
 
 func (sc *Client) UpdateGroup(ctx context.Context, currentName string, group *Group) error {
+	if sc.capabilities.GroupsV2() {
+		return sc.updateGroupV2(ctx, currentName, group)
+	}
+
 	rqParams := map[string]string{
 		"currentName": currentName,
 		"description": group.Description,
@@ -83,7 +122,7 @@ func (sc *Client) UpdateGroup(ctx context.Context, currentName string, group *Gr
 		rqParams["name"] = group.Name
 	}
 
-	rsp, err := sc.startRequest(ctx).SetFormData(rqParams).Post("/user_groups/update")
+	rsp, err := sc.startOrgRequest(ctx).SetFormData(rqParams).Post("/user_groups/update")
 	if err = sc.checkError(rsp, err); err != nil {
 		return fmt.Errorf("failed to update group: %w", err)
 	}
@@ -92,7 +131,11 @@ func (sc *Client) UpdateGroup(ctx context.Context, currentName string, group *Gr
 }
 
 func (sc *Client) DeleteGroup(ctx context.Context, groupName string) error {
-	rsp, err := sc.startRequest(ctx).SetFormData(map[string]string{
+	if sc.capabilities.GroupsV2() {
+		return sc.deleteGroupV2(ctx, groupName)
+	}
+
+	rsp, err := sc.startOrgRequest(ctx).SetFormData(map[string]string{
 		"name": groupName,
 	}).Post("/user_groups/delete")
 	if err = sc.checkError(rsp, err); err != nil {
@@ -103,7 +146,11 @@ func (sc *Client) DeleteGroup(ctx context.Context, groupName string) error {
 }
 
 func (sc *Client) AddUserToGroup(ctx context.Context, user, groupName string) error {
-	resp, err := sc.startRequest(ctx).
+	if sc.capabilities.GroupsV2() {
+		return sc.addUserToGroupV2(ctx, user, groupName)
+	}
+
+	resp, err := sc.startOrgRequest(ctx).
 		SetQueryParams(map[string]string{
 			nameField:  groupName,
 			loginField: user,
@@ -121,7 +168,11 @@ func (sc *Client) AddUserToGroup(ctx context.Context, user, groupName string) er
 }
 
 func (sc *Client) RemoveUserFromGroup(ctx context.Context, user, groupName string) error {
-	resp, err := sc.startRequest(ctx).
+	if sc.capabilities.GroupsV2() {
+		return sc.removeUserFromGroupV2(ctx, user, groupName)
+	}
+
+	resp, err := sc.startOrgRequest(ctx).
 		SetQueryParams(map[string]string{
 			nameField:  groupName,
 			loginField: user,
@@ -137,3 +188,152 @@ func (sc *Client) RemoveUserFromGroup(ctx context.Context, user, groupName strin
 
 	return nil
 }
+
+func (sc *Client) searchGroupsV2(ctx context.Context, groupName string) ([]Group, error) {
+	groups, err := searchAll(ctx, v2Pager, func(ctx context.Context, params map[string]string) ([]Group, pageInfo, error) {
+		var groupResponse groupSearchResponse
+
+		rsp, err := sc.startJSONRequest(ctx).
+			SetResult(&groupResponse).
+			SetQueryParam("q", groupName).
+			SetQueryParams(params).
+			Get(groupsV2Path)
+		if err = sc.checkError(rsp, err); err != nil {
+			return nil, pageInfo{}, err
+		}
+
+		return groupResponse.Groups, groupResponse.pageInfo, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+func (sc *Client) createGroupV2(ctx context.Context, group *Group) error {
+	created := &Group{}
+
+	rsp, err := sc.startJSONRequest(ctx).
+		SetBody(map[string]string{
+			"name":        group.Name,
+			"description": group.Description,
+		}).
+		SetResult(created).
+		Post(groupsV2Path)
+	if err = sc.checkError(rsp, err); err != nil {
+		return fmt.Errorf("failed to create user group: %w", err)
+	}
+
+	group.ID = created.ID
+
+	return nil
+}
+
+func (sc *Client) updateGroupV2(ctx context.Context, currentName string, group *Group) error {
+	current, err := sc.GetGroup(ctx, currentName)
+	if err != nil {
+		return fmt.Errorf("failed to update group: %w", err)
+	}
+
+	rsp, err := sc.startJSONRequest(ctx).
+		SetHeader(contentTypeField, mergePatchContentType).
+		SetBody(map[string]string{
+			"name":        group.Name,
+			"description": group.Description,
+		}).
+		Patch(fmt.Sprintf("%s/%s", groupsV2Path, current.ID))
+	if err = sc.checkError(rsp, err); err != nil {
+		return fmt.Errorf("failed to update group: %w", err)
+	}
+
+	return nil
+}
+
+func (sc *Client) deleteGroupV2(ctx context.Context, groupName string) error {
+	group, err := sc.GetGroup(ctx, groupName)
+	if err != nil {
+		return fmt.Errorf("failed to delete group: %w", err)
+	}
+
+	rsp, err := sc.startJSONRequest(ctx).
+		Delete(fmt.Sprintf("%s/%s", groupsV2Path, group.ID))
+	if err = sc.checkError(rsp, err); err != nil {
+		return fmt.Errorf("failed to delete group: %w", err)
+	}
+
+	return nil
+}
+
+func (sc *Client) addUserToGroupV2(ctx context.Context, user, groupName string) error {
+	userID, groupID, err := sc.getMembershipIDs(ctx, user, groupName)
+	if err != nil {
+		return fmt.Errorf("failed to add user %s to group %s: %w", user, groupName, err)
+	}
+
+	rsp, err := sc.startJSONRequest(ctx).
+		SetBody(map[string]string{
+			"groupId": groupID,
+			"userId":  userID,
+		}).
+		Post(groupMembershipsV2Path)
+	if err = sc.checkError(rsp, err); err != nil {
+		return fmt.Errorf("failed to add user %s to group %s: %w", user, groupName, err)
+	}
+
+	return nil
+}
+
+func (sc *Client) removeUserFromGroupV2(ctx context.Context, user, groupName string) error {
+	userID, groupID, err := sc.getMembershipIDs(ctx, user, groupName)
+	if err != nil {
+		return fmt.Errorf("failed to remove user %s from group %s: %w", user, groupName, err)
+	}
+
+	memberships, err := searchAll(ctx, v2Pager,
+		func(ctx context.Context, params map[string]string) ([]groupMembership, pageInfo, error) {
+			var membershipResponse groupMembershipSearchResponse
+
+			rsp, err := sc.startJSONRequest(ctx).
+				SetResult(&membershipResponse).
+				SetQueryParams(map[string]string{
+					"userId":  userID,
+					"groupId": groupID,
+				}).
+				SetQueryParams(params).
+				Get(groupMembershipsV2Path)
+			if err = sc.checkError(rsp, err); err != nil {
+				return nil, pageInfo{}, err
+			}
+
+			return membershipResponse.GroupMemberships, membershipResponse.pageInfo, nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to get membership of user %s in group %s: %w", user, groupName, err)
+	}
+
+	for _, m := range memberships {
+		rsp, err := sc.startJSONRequest(ctx).
+			Delete(fmt.Sprintf("%s/%s", groupMembershipsV2Path, m.ID))
+		if err = sc.checkError(rsp, err); err != nil {
+			return fmt.Errorf("failed to remove user %s from group %s: %w", user, groupName, err)
+		}
+	}
+
+	return nil
+}
+
+// getMembershipIDs returns the IDs of the user and the group that are required by the v2 group memberships API.
+func (sc *Client) getMembershipIDs(ctx context.Context, user, groupName string) (userID, groupID string, err error) {
+	u, err := sc.GetUserByLogin(ctx, user)
+	if err != nil {
+		return "", "", err
+	}
+
+	g, err := sc.GetGroup(ctx, groupName)
+	if err != nil {
+		return "", "", err
+	}
+
+	return u.ID, g.ID, nil
+}
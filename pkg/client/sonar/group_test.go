@@ -2,11 +2,14 @@ package sonar
 
 import (
 	"context"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -146,3 +149,84 @@ func TestSonarClient_GetGroup(t *testing.T) {
 		t.Fatalf("wrong error returned: %s", err.Error())
 	}
 }
+
+func TestClient_GroupsV2(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+
+	writeJSON := func(t *testing.T, w http.ResponseWriter, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(body))
+		require.NoError(t, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/authorizations/groups", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "group1", r.URL.Query().Get("q"))
+		writeJSON(t, w, `{"groups":[{"id":"group-id","name":"group1","description":"desc"}]}`)
+	})
+	mux.HandleFunc("POST /api/v2/authorizations/groups", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "create")
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"group1","description":"desc"}`, string(body))
+
+		writeJSON(t, w, `{"id":"group-id","name":"group1","description":"desc"}`)
+	})
+	mux.HandleFunc("PATCH /api/v2/authorizations/groups/group-id", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "update")
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"group2","description":"new desc"}`, string(body))
+	})
+	mux.HandleFunc("DELETE /api/v2/authorizations/groups/group-id", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "delete")
+	})
+	mux.HandleFunc("GET /api/v2/users-management/users", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, `{"users":[{"id":"user-id","login":"user1"}]}`)
+	})
+	mux.HandleFunc("POST /api/v2/authorizations/group-memberships", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "add member")
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"groupId":"group-id","userId":"user-id"}`, string(body))
+
+		writeJSON(t, w, `{"id":"membership-id","groupId":"group-id","userId":"user-id"}`)
+	})
+	mux.HandleFunc("GET /api/v2/authorizations/group-memberships", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "user-id", r.URL.Query().Get("userId"))
+		assert.Equal(t, "group-id", r.URL.Query().Get("groupId"))
+		writeJSON(t, w, `{"groupMemberships":[{"id":"membership-id","groupId":"group-id","userId":"user-id"}]}`)
+	})
+	mux.HandleFunc("DELETE /api/v2/authorizations/group-memberships/membership-id",
+		func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, "remove member")
+		},
+	)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	c := NewClient(server.URL, "user", "password")
+	c.capabilities = &Capabilities{Version: Version{Major: 2025, Minor: 1}}
+
+	group := &Group{Name: "group1", Description: "desc"}
+	require.NoError(t, c.CreateGroup(ctx, group))
+	assert.Equal(t, "group-id", group.ID)
+
+	got, err := c.GetGroup(ctx, "group1")
+	require.NoError(t, err)
+	assert.Equal(t, &Group{ID: "group-id", Name: "group1", Description: "desc"}, got)
+
+	require.NoError(t, c.UpdateGroup(ctx, "group1", &Group{Name: "group2", Description: "new desc"}))
+	require.NoError(t, c.AddUserToGroup(ctx, "user1", "group1"))
+	require.NoError(t, c.RemoveUserFromGroup(ctx, "user1", "group1"))
+	require.NoError(t, c.DeleteGroup(ctx, "group1"))
+
+	assert.Equal(t, []string{"create", "update", "add member", "remove member", "delete"}, requests)
+}
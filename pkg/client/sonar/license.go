@@ -0,0 +1,54 @@
+package sonar
+
+import (
+	"context"
+	"fmt"
+)
+
+// License represents the license installed in a commercial edition of SonarQube.
+// https://next.sonarqube.com/sonarqube/web_api/api/editions
+type License struct {
+	Edition        string `json:"edition"`
+	Type           string `json:"type"`
+	ExpiresAt      string `json:"expiresAt"`
+	IsExpired      bool   `json:"isExpired"`
+	IsValidEdition bool   `json:"isValidEdition"`
+	Loc            int64  `json:"loc"`
+	MaxLoc         int64  `json:"maxLoc"`
+}
+
+// GetLicense returns the installed license.
+// It returns the not found error if no license is installed.
+func (sc *Client) GetLicense(ctx context.Context) (*License, error) {
+	var license License
+
+	resp, err := sc.startRequest(ctx).
+		SetResult(&license).
+		Get("/editions/show_license")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to get license: %w", err)
+	}
+
+	return &license, nil
+}
+
+// SetLicense installs the license.
+// The editions API is available only in the commercial editions of SonarQube.
+func (sc *Client) SetLicense(ctx context.Context, license string) error {
+	if !sc.capabilities.Licensing() {
+		return fmt.Errorf("failed to set license: %s edition doesn't support licenses", sc.capabilities.Edition)
+	}
+
+	resp, err := sc.startRequest(ctx).
+		SetFormData(map[string]string{
+			"license": license,
+		}).
+		Post("/editions/set_license")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return fmt.Errorf("failed to set license: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetLicense(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		serverBody     string
+		want           *License
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusOK,
+			serverBody: `{"edition":"developer","type":"PRODUCTION","expiresAt":"2030-01-01",` +
+				`"isExpired":false,"isValidEdition":true,"loc":1500,"maxLoc":100000}`,
+			want: &License{
+				Edition:        "developer",
+				Type:           "PRODUCTION",
+				ExpiresAt:      "2030-01-01",
+				IsValidEdition: true,
+				Loc:            1500,
+				MaxLoc:         100000,
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:           "license not found",
+			serverResponse: http.StatusNotFound,
+			serverBody:     `{"errors":[{"msg":"License not found"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.True(t, IsErrNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/api/editions/show_license", r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err := w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(server.Close)
+
+			client := NewClient(server.URL, "user", "password")
+
+			got, err := client.GetLicense(context.Background())
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_SetLicense(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		capabilities   *Capabilities
+		serverResponse int
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusNoContent,
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "invalid license",
+			serverResponse: http.StatusBadRequest,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to set license")
+			},
+		},
+		{
+			name:         "community edition",
+			capabilities: &Capabilities{Version: Version{Major: 10, Minor: 5}, Edition: EditionCommunity},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "community edition doesn't support licenses")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/api/editions/set_license", r.URL.Path)
+				assert.NoError(t, r.ParseForm())
+				assert.Equal(t, "license-key", r.PostForm.Get("license"))
+
+				w.WriteHeader(tt.serverResponse)
+			}))
+			t.Cleanup(server.Close)
+
+			client := NewClient(server.URL, "user", "password")
+			client.capabilities = tt.capabilities
+
+			tt.wantErr(t, client.SetLicense(context.Background(), "license-key"))
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package sonar
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Quality gate statuses of a project.
+const (
+	QualityGateStatusOK    = "OK"
+	QualityGateStatusWarn  = "WARN"
+	QualityGateStatusError = "ERROR"
+	QualityGateStatusNone  = "NONE"
+)
+
+type projectStatusResponse struct {
+	ProjectStatus struct {
+		Status string `json:"status"`
+	} `json:"projectStatus"`
+}
+
+// measureValue is the value of a measure, the measures of the new code have the value in the period field.
+type measureValue struct {
+	Metric string `json:"metric"`
+	Value  string `json:"value"`
+	Period *struct {
+		Value string `json:"value"`
+	} `json:"period"`
+}
+
+type componentMeasuresResponse struct {
+	Component struct {
+		Measures []measureValue `json:"measures"`
+	} `json:"component"`
+}
+
+// GetProjectQualityGateStatus returns the quality gate status of the project.
+// https://next.sonarqube.com/sonarqube/web_api/api/qualitygates/project_status
+func (sc *Client) GetProjectQualityGateStatus(ctx context.Context, projectKey string) (string, error) {
+	var status projectStatusResponse
+
+	resp, err := sc.startRequest(ctx).
+		SetResult(&status).
+		SetQueryParam("projectKey", projectKey).
+		Get("/qualitygates/project_status")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return "", fmt.Errorf("failed to get project quality gate status: %w", err)
+	}
+
+	return status.ProjectStatus.Status, nil
+}
+
+// GetProjectMeasures returns the numeric measures of the project by the metric keys.
+// The metrics without a value, e.g. coverage of a project without tests, and the non-numeric metrics are skipped.
+// https://next.sonarqube.com/sonarqube/web_api/api/measures/component
+func (sc *Client) GetProjectMeasures(
+	ctx context.Context,
+	projectKey string,
+	metricKeys []string,
+) (map[string]float64, error) {
+	var measures componentMeasuresResponse
+
+	resp, err := sc.startRequest(ctx).
+		SetResult(&measures).
+		SetQueryParams(map[string]string{
+			"component":  projectKey,
+			"metricKeys": strings.Join(metricKeys, ","),
+		}).
+		Get("/measures/component")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to get project measures: %w", err)
+	}
+
+	values := make(map[string]float64, len(measures.Component.Measures))
+
+	for _, m := range measures.Component.Measures {
+		value := m.Value
+		if value == "" && m.Period != nil {
+			value = m.Period.Value
+		}
+
+		v, parseErr := strconv.ParseFloat(value, 64)
+		if parseErr != nil {
+			continue
+		}
+
+		values[m.Metric] = v
+	}
+
+	return values, nil
+}
@@ -0,0 +1,125 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetProjectQualityGateStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		serverBody     string
+		want           string
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusOK,
+			serverBody:     `{"projectStatus":{"status":"ERROR","conditions":[]}}`,
+			want:           QualityGateStatusError,
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "project not found",
+			serverResponse: http.StatusNotFound,
+			serverBody:     `{"errors":[{"msg":"Project 'project' not found"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.True(t, IsErrNotFound(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/api/qualitygates/project_status", r.URL.Path)
+				assert.Equal(t, "project", r.URL.Query().Get("projectKey"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err := w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(server.Close)
+
+			client := NewClient(server.URL, "user", "password")
+
+			got, err := client.GetProjectQualityGateStatus(context.Background(), "project")
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetProjectMeasures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		serverBody     string
+		want           map[string]float64
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusOK,
+			serverBody: `{"component":{"key":"project","measures":[` +
+				`{"metric":"coverage","value":"81.5"},` +
+				`{"metric":"bugs","value":"3"},` +
+				`{"metric":"new_bugs","period":{"index":1,"value":"1"}},` +
+				`{"metric":"alert_status","value":"OK"}]}}`,
+			want:    map[string]float64{"coverage": 81.5, "bugs": 3, "new_bugs": 1},
+			wantErr: require.NoError,
+		},
+		{
+			name:           "project not found",
+			serverResponse: http.StatusNotFound,
+			serverBody:     `{"errors":[{"msg":"Component key 'project' not found"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get project measures")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/api/measures/component", r.URL.Path)
+				assert.Equal(t, "project", r.URL.Query().Get("component"))
+				assert.Equal(t, "coverage,bugs,new_bugs,alert_status", r.URL.Query().Get("metricKeys"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err := w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(server.Close)
+
+			client := NewClient(server.URL, "user", "password")
+
+			got, err := client.GetProjectMeasures(context.Background(), "project",
+				[]string{"coverage", "bugs", "new_bugs", "alert_status"})
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
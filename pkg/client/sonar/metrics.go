@@ -0,0 +1,177 @@
+package sonar
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+const (
+	metricsNamespace = "sonar_operator"
+
+	instanceLabel    = "sonar"
+	endpointLabel    = "endpoint"
+	methodLabel      = "method"
+	statusClassLabel = "status_class"
+	healthLabel      = "health"
+
+	// statusClassError is the status class of the requests that failed without a response.
+	statusClassError = "error"
+)
+
+// healthStatuses are the health statuses of sonar reported by the health gauge.
+var healthStatuses = []string{"GREEN", "YELLOW", "RED"}
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "api",
+		Name:      "requests_total",
+		Help:      "Number of requests to the sonar API, including the retries.",
+	}, []string{instanceLabel, endpointLabel, methodLabel, statusClassLabel})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of the requests to the sonar API in seconds.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{instanceLabel, endpointLabel, methodLabel, statusClassLabel})
+
+	instanceUp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "instance_up",
+		Help:      "Whether the last connection check of the sonar instance succeeded (1) or failed (0).",
+	}, []string{instanceLabel})
+
+	instanceHealth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "instance_health",
+		Help:      "Health status of the sonar instance, 1 for the current status and 0 for others.",
+	}, []string{instanceLabel, healthLabel})
+)
+
+// RegisterMetrics registers the sonar client metrics in the registry.
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{requestsTotal, requestDuration, instanceUp, instanceHealth} {
+		if err := registerer.Register(c); err != nil {
+			var alreadyRegistered prometheus.AlreadyRegisteredError
+			if errors.As(err, &alreadyRegistered) {
+				continue
+			}
+
+			return fmt.Errorf("failed to register sonar metrics: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// InstanceName returns the name of the sonar instance that is used as the metrics label.
+// Sonar is named as namespace/name, ClusterSonar is named by its name.
+func InstanceName(sonar *sonarApi.Sonar) string {
+	return instanceNameFromKey(sonar.Kind, sonar.Namespace, sonar.Name)
+}
+
+// SetInstanceHealth sets the health gauges of the sonar instance from the result of the connection check.
+// The health is empty if it is unknown, e.g. for SonarCloud.
+func SetInstanceHealth(instance string, up bool, health string) {
+	if up {
+		instanceUp.WithLabelValues(instance).Set(1)
+	} else {
+		instanceUp.WithLabelValues(instance).Set(0)
+	}
+
+	for _, status := range healthStatuses {
+		if status == health {
+			instanceHealth.WithLabelValues(instance, status).Set(1)
+		} else {
+			instanceHealth.WithLabelValues(instance, status).Set(0)
+		}
+	}
+}
+
+// deleteInstanceMetrics removes the metrics of the deleted sonar instance.
+func deleteInstanceMetrics(instance string) {
+	labels := prometheus.Labels{instanceLabel: instance}
+
+	requestsTotal.DeletePartialMatch(labels)
+	requestDuration.DeletePartialMatch(labels)
+	instanceUp.DeletePartialMatch(labels)
+	instanceHealth.DeletePartialMatch(labels)
+}
+
+// WithMetrics records the metrics of the requests to the sonar instance.
+// Every attempt of a request is recorded, so the retries are counted separately.
+// It wraps the transport of the client, so it must be the last option
+// to keep the TLS and proxy settings of the previous options.
+func WithMetrics(instance string) ClientOption {
+	return func(c *resty.Client) {
+		base := c.GetClient().Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+
+		c.SetTransport(&metricsTransport{base: base, instance: instance})
+	}
+}
+
+// metricsTransport records the metrics of the requests sent with the base transport.
+type metricsTransport struct {
+	base     http.RoundTripper
+	instance string
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+
+	statusClass := statusClassError
+	if err == nil {
+		statusClass = fmt.Sprintf("%dxx", resp.StatusCode/100)
+	}
+
+	labels := []string{t.instance, endpointFamily(req.URL.Path), req.Method, statusClass}
+
+	requestsTotal.WithLabelValues(labels...).Inc()
+	requestDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
+
+// endpointFamily returns the endpoint family of the sonar API path, e.g. users for /api/users/search.
+// The v2 Web API families include the version, e.g. v2/users-management for /api/v2/users-management/users.
+func endpointFamily(path string) string {
+	if _, after, found := strings.Cut(path, "/api/"); found {
+		path = after
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	if segments[0] == "v2" && len(segments) > 1 {
+		return "v2/" + segments[1]
+	}
+
+	if segments[0] == "" {
+		return "unknown"
+	}
+
+	return segments[0]
+}
+
+// instanceNameFromKey returns the name of the sonar instance from the kind and the key of sonar.
+func instanceNameFromKey(kind, namespace, name string) string {
+	if kind == common.ClusterSonarKind {
+		return name
+	}
+
+	return namespace + "/" + name
+}
@@ -0,0 +1,161 @@
+package sonar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestWithMetrics(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/system/health" && attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"health":"GREEN"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	// the metrics are global, so the instance is unique for every run of the test.
+	instance := fmt.Sprintf("default/metrics-%d", time.Now().UnixNano())
+
+	c := NewClient(server.URL, "admin", "admin",
+		WithRequestPolicy(RequestPolicy{MaxRetries: 1, RetryWaitTime: time.Millisecond, MaxRetryWaitTime: time.Millisecond}),
+		WithMetrics(instance),
+	)
+
+	_, err := c.Health(context.Background())
+	require.NoError(t, err)
+
+	_, err = c.SearchUsers(context.Background(), "user")
+	require.NoError(t, err)
+
+	assert.InDelta(t, 1, testutil.ToFloat64(requestsTotal.WithLabelValues(instance, "system", http.MethodGet, "5xx")), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(requestsTotal.WithLabelValues(instance, "system", http.MethodGet, "2xx")), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(requestsTotal.WithLabelValues(instance, "users", http.MethodGet, "2xx")), 0)
+
+	server.Close()
+
+	_, err = c.Health(context.Background())
+	require.Error(t, err)
+
+	assert.InDelta(t, 2, testutil.ToFloat64(requestsTotal.WithLabelValues(instance, "system", http.MethodGet, "error")), 0,
+		"every attempt must be recorded")
+	assert.Equal(t, 4, countInstanceSeries(t, requestDuration, instance))
+}
+
+func TestSetInstanceHealth(t *testing.T) {
+	t.Parallel()
+
+	const instance = "default/health"
+
+	SetInstanceHealth(instance, true, "YELLOW")
+
+	assert.InDelta(t, 1, testutil.ToFloat64(instanceUp.WithLabelValues(instance)), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(instanceHealth.WithLabelValues(instance, "YELLOW")), 0)
+	assert.InDelta(t, 0, testutil.ToFloat64(instanceHealth.WithLabelValues(instance, "GREEN")), 0)
+
+	SetInstanceHealth(instance, false, "")
+
+	assert.InDelta(t, 0, testutil.ToFloat64(instanceUp.WithLabelValues(instance)), 0)
+	assert.InDelta(t, 0, testutil.ToFloat64(instanceHealth.WithLabelValues(instance, "YELLOW")), 0)
+
+	provider := NewApiClientProvider(nil, "operators")
+	provider.EvictClient(common.SonarKind, types.NamespacedName{Namespace: "default", Name: "health"})
+
+	assert.Zero(t, countInstanceSeries(t, instanceUp, instance))
+	assert.Zero(t, countInstanceSeries(t, instanceHealth, instance))
+}
+
+// countInstanceSeries returns the number of series of the collector with the given instance label.
+func countInstanceSeries(t *testing.T, c prometheus.Collector, instance string) int {
+	t.Helper()
+
+	ch := make(chan prometheus.Metric)
+
+	go func() {
+		c.Collect(ch)
+		close(ch)
+	}()
+
+	count := 0
+
+	for m := range ch {
+		pb := &dto.Metric{}
+		require.NoError(t, m.Write(pb))
+
+		for _, l := range pb.GetLabel() {
+			if l.GetName() == instanceLabel && l.GetValue() == instance {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+func TestRegisterMetrics(t *testing.T) {
+	t.Parallel()
+
+	registry := prometheus.NewRegistry()
+
+	require.NoError(t, RegisterMetrics(registry))
+	require.NoError(t, RegisterMetrics(registry), "metrics can be registered twice")
+}
+
+func TestInstanceName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "default/sonar", InstanceName(&sonarApi.Sonar{
+		ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "default"},
+	}))
+	assert.Equal(t, "shared", InstanceName((&sonarApi.ClusterSonar{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+	}).ToSonar("operators")))
+}
+
+func TestEndpointFamily(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/api/users/search", want: "users"},
+		{path: "/sonar/api/qualitygates/show", want: "qualitygates"},
+		{path: "/api/v2/users-management/users/1", want: "v2/users-management"},
+		{path: "/api/v2", want: "v2"},
+		{path: "/api/", want: "unknown"},
+		{path: "/", want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, endpointFamily(tt.path))
+		})
+	}
+}
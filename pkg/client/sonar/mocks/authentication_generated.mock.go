@@ -0,0 +1,524 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAuthentication creates a new instance of MockAuthentication. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthentication(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthentication {
+	mock := &MockAuthentication{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuthentication is an autogenerated mock type for the Authentication type
+type MockAuthentication struct {
+	mock.Mock
+}
+
+type MockAuthentication_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthentication) EXPECT() *MockAuthentication_Expecter {
+	return &MockAuthentication_Expecter{mock: &_m.Mock}
+}
+
+// ChangePassword provides a mock function for the type MockAuthentication
+func (_mock *MockAuthentication) ChangePassword(ctx context.Context, user string, oldPassword string, newPassword string) error {
+	ret := _mock.Called(ctx, user, oldPassword, newPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, user, oldPassword, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthentication_ChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangePassword'
+type MockAuthentication_ChangePassword_Call struct {
+	*mock.Call
+}
+
+// ChangePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+//   - oldPassword string
+//   - newPassword string
+func (_e *MockAuthentication_Expecter) ChangePassword(ctx interface{}, user interface{}, oldPassword interface{}, newPassword interface{}) *MockAuthentication_ChangePassword_Call {
+	return &MockAuthentication_ChangePassword_Call{Call: _e.mock.On("ChangePassword", ctx, user, oldPassword, newPassword)}
+}
+
+func (_c *MockAuthentication_ChangePassword_Call) Run(run func(ctx context.Context, user string, oldPassword string, newPassword string)) *MockAuthentication_ChangePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthentication_ChangePassword_Call) Return(err error) *MockAuthentication_ChangePassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthentication_ChangePassword_Call) RunAndReturn(run func(ctx context.Context, user string, oldPassword string, newPassword string) error) *MockAuthentication_ChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateToken provides a mock function for the type MockAuthentication
+func (_mock *MockAuthentication) GenerateToken(ctx context.Context, name string, expirationDate time.Time) (*sonar.UserToken, error) {
+	ret := _mock.Called(ctx, name, expirationDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateToken")
+	}
+
+	var r0 *sonar.UserToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (*sonar.UserToken, error)); ok {
+		return returnFunc(ctx, name, expirationDate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) *sonar.UserToken); ok {
+		r0 = returnFunc(ctx, name, expirationDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.UserToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, name, expirationDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthentication_GenerateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateToken'
+type MockAuthentication_GenerateToken_Call struct {
+	*mock.Call
+}
+
+// GenerateToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - expirationDate time.Time
+func (_e *MockAuthentication_Expecter) GenerateToken(ctx interface{}, name interface{}, expirationDate interface{}) *MockAuthentication_GenerateToken_Call {
+	return &MockAuthentication_GenerateToken_Call{Call: _e.mock.On("GenerateToken", ctx, name, expirationDate)}
+}
+
+func (_c *MockAuthentication_GenerateToken_Call) Run(run func(ctx context.Context, name string, expirationDate time.Time)) *MockAuthentication_GenerateToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthentication_GenerateToken_Call) Return(userToken *sonar.UserToken, err error) *MockAuthentication_GenerateToken_Call {
+	_c.Call.Return(userToken, err)
+	return _c
+}
+
+func (_c *MockAuthentication_GenerateToken_Call) RunAndReturn(run func(ctx context.Context, name string, expirationDate time.Time) (*sonar.UserToken, error)) *MockAuthentication_GenerateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsAuthenticated provides a mock function for the type MockAuthentication
+func (_mock *MockAuthentication) IsAuthenticated(ctx context.Context) (bool, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAuthenticated")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthentication_IsAuthenticated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAuthenticated'
+type MockAuthentication_IsAuthenticated_Call struct {
+	*mock.Call
+}
+
+// IsAuthenticated is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAuthentication_Expecter) IsAuthenticated(ctx interface{}) *MockAuthentication_IsAuthenticated_Call {
+	return &MockAuthentication_IsAuthenticated_Call{Call: _e.mock.On("IsAuthenticated", ctx)}
+}
+
+func (_c *MockAuthentication_IsAuthenticated_Call) Run(run func(ctx context.Context)) *MockAuthentication_IsAuthenticated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthentication_IsAuthenticated_Call) Return(b bool, err error) *MockAuthentication_IsAuthenticated_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockAuthentication_IsAuthenticated_Call) RunAndReturn(run func(ctx context.Context) (bool, error)) *MockAuthentication_IsAuthenticated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeToken provides a mock function for the type MockAuthentication
+func (_mock *MockAuthentication) RevokeToken(ctx context.Context, name string) error {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuthentication_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type MockAuthentication_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockAuthentication_Expecter) RevokeToken(ctx interface{}, name interface{}) *MockAuthentication_RevokeToken_Call {
+	return &MockAuthentication_RevokeToken_Call{Call: _e.mock.On("RevokeToken", ctx, name)}
+}
+
+func (_c *MockAuthentication_RevokeToken_Call) Run(run func(ctx context.Context, name string)) *MockAuthentication_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthentication_RevokeToken_Call) Return(err error) *MockAuthentication_RevokeToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuthentication_RevokeToken_Call) RunAndReturn(run func(ctx context.Context, name string) error) *MockAuthentication_RevokeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCredentials provides a mock function for the type MockAuthentication
+func (_mock *MockAuthentication) SetCredentials(user string, password string) {
+	_mock.Called(user, password)
+	return
+}
+
+// MockAuthentication_SetCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCredentials'
+type MockAuthentication_SetCredentials_Call struct {
+	*mock.Call
+}
+
+// SetCredentials is a helper method to define mock.On call
+//   - user string
+//   - password string
+func (_e *MockAuthentication_Expecter) SetCredentials(user interface{}, password interface{}) *MockAuthentication_SetCredentials_Call {
+	return &MockAuthentication_SetCredentials_Call{Call: _e.mock.On("SetCredentials", user, password)}
+}
+
+func (_c *MockAuthentication_SetCredentials_Call) Run(run func(user string, password string)) *MockAuthentication_SetCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthentication_SetCredentials_Call) Return() *MockAuthentication_SetCredentials_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockAuthentication_SetCredentials_Call) RunAndReturn(run func(user string, password string)) *MockAuthentication_SetCredentials_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetToken provides a mock function for the type MockAuthentication
+func (_mock *MockAuthentication) SetToken(token string) {
+	_mock.Called(token)
+	return
+}
+
+// MockAuthentication_SetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetToken'
+type MockAuthentication_SetToken_Call struct {
+	*mock.Call
+}
+
+// SetToken is a helper method to define mock.On call
+//   - token string
+func (_e *MockAuthentication_Expecter) SetToken(token interface{}) *MockAuthentication_SetToken_Call {
+	return &MockAuthentication_SetToken_Call{Call: _e.mock.On("SetToken", token)}
+}
+
+func (_c *MockAuthentication_SetToken_Call) Run(run func(token string)) *MockAuthentication_SetToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthentication_SetToken_Call) Return() *MockAuthentication_SetToken_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockAuthentication_SetToken_Call) RunAndReturn(run func(token string)) *MockAuthentication_SetToken_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ValidateCredentials provides a mock function for the type MockAuthentication
+func (_mock *MockAuthentication) ValidateCredentials(ctx context.Context, user string, password string) (bool, error) {
+	ret := _mock.Called(ctx, user, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateCredentials")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, user, password)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, user, password)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, user, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthentication_ValidateCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateCredentials'
+type MockAuthentication_ValidateCredentials_Call struct {
+	*mock.Call
+}
+
+// ValidateCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+//   - password string
+func (_e *MockAuthentication_Expecter) ValidateCredentials(ctx interface{}, user interface{}, password interface{}) *MockAuthentication_ValidateCredentials_Call {
+	return &MockAuthentication_ValidateCredentials_Call{Call: _e.mock.On("ValidateCredentials", ctx, user, password)}
+}
+
+func (_c *MockAuthentication_ValidateCredentials_Call) Run(run func(ctx context.Context, user string, password string)) *MockAuthentication_ValidateCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthentication_ValidateCredentials_Call) Return(b bool, err error) *MockAuthentication_ValidateCredentials_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockAuthentication_ValidateCredentials_Call) RunAndReturn(run func(ctx context.Context, user string, password string) (bool, error)) *MockAuthentication_ValidateCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateToken provides a mock function for the type MockAuthentication
+func (_mock *MockAuthentication) ValidateToken(ctx context.Context, token string) (bool, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateToken")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthentication_ValidateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateToken'
+type MockAuthentication_ValidateToken_Call struct {
+	*mock.Call
+}
+
+// ValidateToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockAuthentication_Expecter) ValidateToken(ctx interface{}, token interface{}) *MockAuthentication_ValidateToken_Call {
+	return &MockAuthentication_ValidateToken_Call{Call: _e.mock.On("ValidateToken", ctx, token)}
+}
+
+func (_c *MockAuthentication_ValidateToken_Call) Run(run func(ctx context.Context, token string)) *MockAuthentication_ValidateToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthentication_ValidateToken_Call) Return(b bool, err error) *MockAuthentication_ValidateToken_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockAuthentication_ValidateToken_Call) RunAndReturn(run func(ctx context.Context, token string) (bool, error)) *MockAuthentication_ValidateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
 	mock "github.com/stretchr/testify/mock"
@@ -417,6 +418,75 @@ func (_c *MockClientInterface_AddUserToGroup_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// ChangePassword provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) ChangePassword(ctx context.Context, user string, oldPassword string, newPassword string) error {
+	ret := _mock.Called(ctx, user, oldPassword, newPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, user, oldPassword, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClientInterface_ChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangePassword'
+type MockClientInterface_ChangePassword_Call struct {
+	*mock.Call
+}
+
+// ChangePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+//   - oldPassword string
+//   - newPassword string
+func (_e *MockClientInterface_Expecter) ChangePassword(ctx interface{}, user interface{}, oldPassword interface{}, newPassword interface{}) *MockClientInterface_ChangePassword_Call {
+	return &MockClientInterface_ChangePassword_Call{Call: _e.mock.On("ChangePassword", ctx, user, oldPassword, newPassword)}
+}
+
+func (_c *MockClientInterface_ChangePassword_Call) Run(run func(ctx context.Context, user string, oldPassword string, newPassword string)) *MockClientInterface_ChangePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_ChangePassword_Call) Return(err error) *MockClientInterface_ChangePassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClientInterface_ChangePassword_Call) RunAndReturn(run func(ctx context.Context, user string, oldPassword string, newPassword string) error) *MockClientInterface_ChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConfigureGeneralSettings provides a mock function for the type MockClientInterface
 func (_mock *MockClientInterface) ConfigureGeneralSettings(settings ...sonar.SettingRequest) error {
 	// sonar.SettingRequest
@@ -924,6 +994,80 @@ func (_c *MockClientInterface_CreateUser_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// CreateWebhook provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) CreateWebhook(ctx context.Context, projectKey string, webhook *sonar.Webhook) (*sonar.Webhook, error) {
+	ret := _mock.Called(ctx, projectKey, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 *sonar.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *sonar.Webhook) (*sonar.Webhook, error)); ok {
+		return returnFunc(ctx, projectKey, webhook)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *sonar.Webhook) *sonar.Webhook); ok {
+		r0 = returnFunc(ctx, projectKey, webhook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *sonar.Webhook) error); ok {
+		r1 = returnFunc(ctx, projectKey, webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type MockClientInterface_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectKey string
+//   - webhook *sonar.Webhook
+func (_e *MockClientInterface_Expecter) CreateWebhook(ctx interface{}, projectKey interface{}, webhook interface{}) *MockClientInterface_CreateWebhook_Call {
+	return &MockClientInterface_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, projectKey, webhook)}
+}
+
+func (_c *MockClientInterface_CreateWebhook_Call) Run(run func(ctx context.Context, projectKey string, webhook *sonar.Webhook)) *MockClientInterface_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *sonar.Webhook
+		if args[2] != nil {
+			arg2 = args[2].(*sonar.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_CreateWebhook_Call) Return(webhook *sonar.Webhook, err error) *MockClientInterface_CreateWebhook_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *MockClientInterface_CreateWebhook_Call) RunAndReturn(run func(ctx context.Context, projectKey string, webhook *sonar.Webhook) (*sonar.Webhook, error)) *MockClientInterface_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeactivateQualityProfileRule provides a mock function for the type MockClientInterface
 func (_mock *MockClientInterface) DeactivateQualityProfileRule(ctx context.Context, profileKey string, ruleKey string) error {
 	ret := _mock.Called(ctx, profileKey, ruleKey)
@@ -1392,177 +1536,165 @@ func (_c *MockClientInterface_DeleteQualityProfile_Call) RunAndReturn(run func(c
 	return _c
 }
 
-// GenerateUserToken provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) GenerateUserToken(userName string) (*string, error) {
-	ret := _mock.Called(userName)
+// DeleteWebhook provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) DeleteWebhook(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenerateUserToken")
+		panic("no return value specified for DeleteWebhook")
 	}
 
-	var r0 *string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*string, error)); ok {
-		return returnFunc(userName)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string) *string); ok {
-		r0 = returnFunc(userName)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*string)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(userName)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockClientInterface_GenerateUserToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateUserToken'
-type MockClientInterface_GenerateUserToken_Call struct {
+// MockClientInterface_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockClientInterface_DeleteWebhook_Call struct {
 	*mock.Call
 }
 
-// GenerateUserToken is a helper method to define mock.On call
-//   - userName string
-func (_e *MockClientInterface_Expecter) GenerateUserToken(userName interface{}) *MockClientInterface_GenerateUserToken_Call {
-	return &MockClientInterface_GenerateUserToken_Call{Call: _e.mock.On("GenerateUserToken", userName)}
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockClientInterface_Expecter) DeleteWebhook(ctx interface{}, key interface{}) *MockClientInterface_DeleteWebhook_Call {
+	return &MockClientInterface_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, key)}
 }
 
-func (_c *MockClientInterface_GenerateUserToken_Call) Run(run func(userName string)) *MockClientInterface_GenerateUserToken_Call {
+func (_c *MockClientInterface_DeleteWebhook_Call) Run(run func(ctx context.Context, key string)) *MockClientInterface_DeleteWebhook_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClientInterface_GenerateUserToken_Call) Return(s *string, err error) *MockClientInterface_GenerateUserToken_Call {
-	_c.Call.Return(s, err)
+func (_c *MockClientInterface_DeleteWebhook_Call) Return(err error) *MockClientInterface_DeleteWebhook_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClientInterface_GenerateUserToken_Call) RunAndReturn(run func(userName string) (*string, error)) *MockClientInterface_GenerateUserToken_Call {
+func (_c *MockClientInterface_DeleteWebhook_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockClientInterface_DeleteWebhook_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetGroup provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) GetGroup(ctx context.Context, groupName string) (*sonar.Group, error) {
-	ret := _mock.Called(ctx, groupName)
+// Edition provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) Edition(ctx context.Context) (string, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGroup")
+		panic("no return value specified for Edition")
 	}
 
-	var r0 *sonar.Group
+	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.Group, error)); ok {
-		return returnFunc(ctx, groupName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.Group); ok {
-		r0 = returnFunc(ctx, groupName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sonar.Group)
-		}
+		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, groupName)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClientInterface_GetGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroup'
-type MockClientInterface_GetGroup_Call struct {
+// MockClientInterface_Edition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Edition'
+type MockClientInterface_Edition_Call struct {
 	*mock.Call
 }
 
-// GetGroup is a helper method to define mock.On call
+// Edition is a helper method to define mock.On call
 //   - ctx context.Context
-//   - groupName string
-func (_e *MockClientInterface_Expecter) GetGroup(ctx interface{}, groupName interface{}) *MockClientInterface_GetGroup_Call {
-	return &MockClientInterface_GetGroup_Call{Call: _e.mock.On("GetGroup", ctx, groupName)}
+func (_e *MockClientInterface_Expecter) Edition(ctx interface{}) *MockClientInterface_Edition_Call {
+	return &MockClientInterface_Edition_Call{Call: _e.mock.On("Edition", ctx)}
 }
 
-func (_c *MockClientInterface_GetGroup_Call) Run(run func(ctx context.Context, groupName string)) *MockClientInterface_GetGroup_Call {
+func (_c *MockClientInterface_Edition_Call) Run(run func(ctx context.Context)) *MockClientInterface_Edition_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClientInterface_GetGroup_Call) Return(group *sonar.Group, err error) *MockClientInterface_GetGroup_Call {
-	_c.Call.Return(group, err)
+func (_c *MockClientInterface_Edition_Call) Return(s string, err error) *MockClientInterface_Edition_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockClientInterface_GetGroup_Call) RunAndReturn(run func(ctx context.Context, groupName string) (*sonar.Group, error)) *MockClientInterface_GetGroup_Call {
+func (_c *MockClientInterface_Edition_Call) RunAndReturn(run func(ctx context.Context) (string, error)) *MockClientInterface_Edition_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetGroupPermissions provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) GetGroupPermissions(ctx context.Context, groupName string) ([]string, error) {
-	ret := _mock.Called(ctx, groupName)
+// GenerateToken provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GenerateToken(ctx context.Context, name string, expirationDate time.Time) (*sonar.UserToken, error) {
+	ret := _mock.Called(ctx, name, expirationDate)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGroupPermissions")
+		panic("no return value specified for GenerateToken")
 	}
 
-	var r0 []string
+	var r0 *sonar.UserToken
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
-		return returnFunc(ctx, groupName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (*sonar.UserToken, error)); ok {
+		return returnFunc(ctx, name, expirationDate)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
-		r0 = returnFunc(ctx, groupName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) *sonar.UserToken); ok {
+		r0 = returnFunc(ctx, name, expirationDate)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).(*sonar.UserToken)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, groupName)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, name, expirationDate)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClientInterface_GetGroupPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupPermissions'
-type MockClientInterface_GetGroupPermissions_Call struct {
+// MockClientInterface_GenerateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateToken'
+type MockClientInterface_GenerateToken_Call struct {
 	*mock.Call
 }
 
-// GetGroupPermissions is a helper method to define mock.On call
+// GenerateToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - groupName string
-func (_e *MockClientInterface_Expecter) GetGroupPermissions(ctx interface{}, groupName interface{}) *MockClientInterface_GetGroupPermissions_Call {
-	return &MockClientInterface_GetGroupPermissions_Call{Call: _e.mock.On("GetGroupPermissions", ctx, groupName)}
+//   - name string
+//   - expirationDate time.Time
+func (_e *MockClientInterface_Expecter) GenerateToken(ctx interface{}, name interface{}, expirationDate interface{}) *MockClientInterface_GenerateToken_Call {
+	return &MockClientInterface_GenerateToken_Call{Call: _e.mock.On("GenerateToken", ctx, name, expirationDate)}
 }
 
-func (_c *MockClientInterface_GetGroupPermissions_Call) Run(run func(ctx context.Context, groupName string)) *MockClientInterface_GetGroupPermissions_Call {
+func (_c *MockClientInterface_GenerateToken_Call) Run(run func(ctx context.Context, name string, expirationDate time.Time)) *MockClientInterface_GenerateToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1572,201 +1704,194 @@ func (_c *MockClientInterface_GetGroupPermissions_Call) Run(run func(ctx context
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClientInterface_GetGroupPermissions_Call) Return(strings []string, err error) *MockClientInterface_GetGroupPermissions_Call {
-	_c.Call.Return(strings, err)
+func (_c *MockClientInterface_GenerateToken_Call) Return(userToken *sonar.UserToken, err error) *MockClientInterface_GenerateToken_Call {
+	_c.Call.Return(userToken, err)
 	return _c
 }
 
-func (_c *MockClientInterface_GetGroupPermissions_Call) RunAndReturn(run func(ctx context.Context, groupName string) ([]string, error)) *MockClientInterface_GetGroupPermissions_Call {
+func (_c *MockClientInterface_GenerateToken_Call) RunAndReturn(run func(ctx context.Context, name string, expirationDate time.Time) (*sonar.UserToken, error)) *MockClientInterface_GenerateToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPermissionTemplate provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) GetPermissionTemplate(ctx context.Context, name string) (*sonar.PermissionTemplate, error) {
-	ret := _mock.Called(ctx, name)
+// GenerateUserToken provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GenerateUserToken(userName string) (*string, error) {
+	ret := _mock.Called(userName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPermissionTemplate")
+		panic("no return value specified for GenerateUserToken")
 	}
 
-	var r0 *sonar.PermissionTemplate
+	var r0 *string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.PermissionTemplate, error)); ok {
-		return returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(string) (*string, error)); ok {
+		return returnFunc(userName)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.PermissionTemplate); ok {
-		r0 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(string) *string); ok {
+		r0 = returnFunc(userName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sonar.PermissionTemplate)
+			r0 = ret.Get(0).(*string)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userName)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClientInterface_GetPermissionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPermissionTemplate'
-type MockClientInterface_GetPermissionTemplate_Call struct {
+// MockClientInterface_GenerateUserToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateUserToken'
+type MockClientInterface_GenerateUserToken_Call struct {
 	*mock.Call
 }
 
-// GetPermissionTemplate is a helper method to define mock.On call
-//   - ctx context.Context
-//   - name string
-func (_e *MockClientInterface_Expecter) GetPermissionTemplate(ctx interface{}, name interface{}) *MockClientInterface_GetPermissionTemplate_Call {
-	return &MockClientInterface_GetPermissionTemplate_Call{Call: _e.mock.On("GetPermissionTemplate", ctx, name)}
+// GenerateUserToken is a helper method to define mock.On call
+//   - userName string
+func (_e *MockClientInterface_Expecter) GenerateUserToken(userName interface{}) *MockClientInterface_GenerateUserToken_Call {
+	return &MockClientInterface_GenerateUserToken_Call{Call: _e.mock.On("GenerateUserToken", userName)}
 }
 
-func (_c *MockClientInterface_GetPermissionTemplate_Call) Run(run func(ctx context.Context, name string)) *MockClientInterface_GetPermissionTemplate_Call {
+func (_c *MockClientInterface_GenerateUserToken_Call) Run(run func(userName string)) *MockClientInterface_GenerateUserToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg0 = args[0].(string)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClientInterface_GetPermissionTemplate_Call) Return(permissionTemplate *sonar.PermissionTemplate, err error) *MockClientInterface_GetPermissionTemplate_Call {
-	_c.Call.Return(permissionTemplate, err)
+func (_c *MockClientInterface_GenerateUserToken_Call) Return(s *string, err error) *MockClientInterface_GenerateUserToken_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockClientInterface_GetPermissionTemplate_Call) RunAndReturn(run func(ctx context.Context, name string) (*sonar.PermissionTemplate, error)) *MockClientInterface_GetPermissionTemplate_Call {
+func (_c *MockClientInterface_GenerateUserToken_Call) RunAndReturn(run func(userName string) (*string, error)) *MockClientInterface_GenerateUserToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPermissionTemplateGroups provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) GetPermissionTemplateGroups(ctx context.Context, templateID string) (map[string][]string, error) {
-	ret := _mock.Called(ctx, templateID)
+// GetAvailablePlugins provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetAvailablePlugins(ctx context.Context) ([]sonar.AvailablePlugin, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPermissionTemplateGroups")
+		panic("no return value specified for GetAvailablePlugins")
 	}
 
-	var r0 map[string][]string
+	var r0 []sonar.AvailablePlugin
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (map[string][]string, error)); ok {
-		return returnFunc(ctx, templateID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]sonar.AvailablePlugin, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) map[string][]string); ok {
-		r0 = returnFunc(ctx, templateID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []sonar.AvailablePlugin); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string][]string)
+			r0 = ret.Get(0).([]sonar.AvailablePlugin)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, templateID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClientInterface_GetPermissionTemplateGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPermissionTemplateGroups'
-type MockClientInterface_GetPermissionTemplateGroups_Call struct {
+// MockClientInterface_GetAvailablePlugins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailablePlugins'
+type MockClientInterface_GetAvailablePlugins_Call struct {
 	*mock.Call
 }
 
-// GetPermissionTemplateGroups is a helper method to define mock.On call
+// GetAvailablePlugins is a helper method to define mock.On call
 //   - ctx context.Context
-//   - templateID string
-func (_e *MockClientInterface_Expecter) GetPermissionTemplateGroups(ctx interface{}, templateID interface{}) *MockClientInterface_GetPermissionTemplateGroups_Call {
-	return &MockClientInterface_GetPermissionTemplateGroups_Call{Call: _e.mock.On("GetPermissionTemplateGroups", ctx, templateID)}
+func (_e *MockClientInterface_Expecter) GetAvailablePlugins(ctx interface{}) *MockClientInterface_GetAvailablePlugins_Call {
+	return &MockClientInterface_GetAvailablePlugins_Call{Call: _e.mock.On("GetAvailablePlugins", ctx)}
 }
 
-func (_c *MockClientInterface_GetPermissionTemplateGroups_Call) Run(run func(ctx context.Context, templateID string)) *MockClientInterface_GetPermissionTemplateGroups_Call {
+func (_c *MockClientInterface_GetAvailablePlugins_Call) Run(run func(ctx context.Context)) *MockClientInterface_GetAvailablePlugins_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClientInterface_GetPermissionTemplateGroups_Call) Return(stringToStrings map[string][]string, err error) *MockClientInterface_GetPermissionTemplateGroups_Call {
-	_c.Call.Return(stringToStrings, err)
+func (_c *MockClientInterface_GetAvailablePlugins_Call) Return(availablePlugins []sonar.AvailablePlugin, err error) *MockClientInterface_GetAvailablePlugins_Call {
+	_c.Call.Return(availablePlugins, err)
 	return _c
 }
 
-func (_c *MockClientInterface_GetPermissionTemplateGroups_Call) RunAndReturn(run func(ctx context.Context, templateID string) (map[string][]string, error)) *MockClientInterface_GetPermissionTemplateGroups_Call {
+func (_c *MockClientInterface_GetAvailablePlugins_Call) RunAndReturn(run func(ctx context.Context) ([]sonar.AvailablePlugin, error)) *MockClientInterface_GetAvailablePlugins_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProject provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) GetProject(ctx context.Context, projectKey string) (*sonar.Project, error) {
-	ret := _mock.Called(ctx, projectKey)
+// GetGroup provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetGroup(ctx context.Context, groupName string) (*sonar.Group, error) {
+	ret := _mock.Called(ctx, groupName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProject")
+		panic("no return value specified for GetGroup")
 	}
 
-	var r0 *sonar.Project
+	var r0 *sonar.Group
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.Project, error)); ok {
-		return returnFunc(ctx, projectKey)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.Group, error)); ok {
+		return returnFunc(ctx, groupName)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.Project); ok {
-		r0 = returnFunc(ctx, projectKey)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.Group); ok {
+		r0 = returnFunc(ctx, groupName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sonar.Project)
+			r0 = ret.Get(0).(*sonar.Group)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, projectKey)
+		r1 = returnFunc(ctx, groupName)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClientInterface_GetProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProject'
-type MockClientInterface_GetProject_Call struct {
+// MockClientInterface_GetGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroup'
+type MockClientInterface_GetGroup_Call struct {
 	*mock.Call
 }
 
-// GetProject is a helper method to define mock.On call
+// GetGroup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - projectKey string
-func (_e *MockClientInterface_Expecter) GetProject(ctx interface{}, projectKey interface{}) *MockClientInterface_GetProject_Call {
-	return &MockClientInterface_GetProject_Call{Call: _e.mock.On("GetProject", ctx, projectKey)}
+//   - groupName string
+func (_e *MockClientInterface_Expecter) GetGroup(ctx interface{}, groupName interface{}) *MockClientInterface_GetGroup_Call {
+	return &MockClientInterface_GetGroup_Call{Call: _e.mock.On("GetGroup", ctx, groupName)}
 }
 
-func (_c *MockClientInterface_GetProject_Call) Run(run func(ctx context.Context, projectKey string)) *MockClientInterface_GetProject_Call {
+func (_c *MockClientInterface_GetGroup_Call) Run(run func(ctx context.Context, groupName string)) *MockClientInterface_GetGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1784,57 +1909,57 @@ func (_c *MockClientInterface_GetProject_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockClientInterface_GetProject_Call) Return(project *sonar.Project, err error) *MockClientInterface_GetProject_Call {
-	_c.Call.Return(project, err)
+func (_c *MockClientInterface_GetGroup_Call) Return(group *sonar.Group, err error) *MockClientInterface_GetGroup_Call {
+	_c.Call.Return(group, err)
 	return _c
 }
 
-func (_c *MockClientInterface_GetProject_Call) RunAndReturn(run func(ctx context.Context, projectKey string) (*sonar.Project, error)) *MockClientInterface_GetProject_Call {
+func (_c *MockClientInterface_GetGroup_Call) RunAndReturn(run func(ctx context.Context, groupName string) (*sonar.Group, error)) *MockClientInterface_GetGroup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetQualityGate provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) GetQualityGate(ctx context.Context, name string) (*sonar.QualityGate, error) {
-	ret := _mock.Called(ctx, name)
+// GetGroupPermissions provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetGroupPermissions(ctx context.Context, groupName string) ([]string, error) {
+	ret := _mock.Called(ctx, groupName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetQualityGate")
+		panic("no return value specified for GetGroupPermissions")
 	}
 
-	var r0 *sonar.QualityGate
+	var r0 []string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.QualityGate, error)); ok {
-		return returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, groupName)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.QualityGate); ok {
-		r0 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, groupName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sonar.QualityGate)
+			r0 = ret.Get(0).([]string)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, name)
+		r1 = returnFunc(ctx, groupName)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClientInterface_GetQualityGate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQualityGate'
-type MockClientInterface_GetQualityGate_Call struct {
+// MockClientInterface_GetGroupPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupPermissions'
+type MockClientInterface_GetGroupPermissions_Call struct {
 	*mock.Call
 }
 
-// GetQualityGate is a helper method to define mock.On call
+// GetGroupPermissions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockClientInterface_Expecter) GetQualityGate(ctx interface{}, name interface{}) *MockClientInterface_GetQualityGate_Call {
-	return &MockClientInterface_GetQualityGate_Call{Call: _e.mock.On("GetQualityGate", ctx, name)}
+//   - groupName string
+func (_e *MockClientInterface_Expecter) GetGroupPermissions(ctx interface{}, groupName interface{}) *MockClientInterface_GetGroupPermissions_Call {
+	return &MockClientInterface_GetGroupPermissions_Call{Call: _e.mock.On("GetGroupPermissions", ctx, groupName)}
 }
 
-func (_c *MockClientInterface_GetQualityGate_Call) Run(run func(ctx context.Context, name string)) *MockClientInterface_GetQualityGate_Call {
+func (_c *MockClientInterface_GetGroupPermissions_Call) Run(run func(ctx context.Context, groupName string)) *MockClientInterface_GetGroupPermissions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1852,125 +1977,119 @@ func (_c *MockClientInterface_GetQualityGate_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockClientInterface_GetQualityGate_Call) Return(qualityGate *sonar.QualityGate, err error) *MockClientInterface_GetQualityGate_Call {
-	_c.Call.Return(qualityGate, err)
+func (_c *MockClientInterface_GetGroupPermissions_Call) Return(strings []string, err error) *MockClientInterface_GetGroupPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *MockClientInterface_GetQualityGate_Call) RunAndReturn(run func(ctx context.Context, name string) (*sonar.QualityGate, error)) *MockClientInterface_GetQualityGate_Call {
+func (_c *MockClientInterface_GetGroupPermissions_Call) RunAndReturn(run func(ctx context.Context, groupName string) ([]string, error)) *MockClientInterface_GetGroupPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetQualityProfile provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) GetQualityProfile(ctx context.Context, name string) (*sonar.QualityProfile, error) {
-	ret := _mock.Called(ctx, name)
+// GetLicense provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetLicense(ctx context.Context) (*sonar.License, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetQualityProfile")
+		panic("no return value specified for GetLicense")
 	}
 
-	var r0 *sonar.QualityProfile
+	var r0 *sonar.License
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.QualityProfile, error)); ok {
-		return returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*sonar.License, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.QualityProfile); ok {
-		r0 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *sonar.License); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sonar.QualityProfile)
+			r0 = ret.Get(0).(*sonar.License)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClientInterface_GetQualityProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQualityProfile'
-type MockClientInterface_GetQualityProfile_Call struct {
+// MockClientInterface_GetLicense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLicense'
+type MockClientInterface_GetLicense_Call struct {
 	*mock.Call
 }
 
-// GetQualityProfile is a helper method to define mock.On call
+// GetLicense is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockClientInterface_Expecter) GetQualityProfile(ctx interface{}, name interface{}) *MockClientInterface_GetQualityProfile_Call {
-	return &MockClientInterface_GetQualityProfile_Call{Call: _e.mock.On("GetQualityProfile", ctx, name)}
+func (_e *MockClientInterface_Expecter) GetLicense(ctx interface{}) *MockClientInterface_GetLicense_Call {
+	return &MockClientInterface_GetLicense_Call{Call: _e.mock.On("GetLicense", ctx)}
 }
 
-func (_c *MockClientInterface_GetQualityProfile_Call) Run(run func(ctx context.Context, name string)) *MockClientInterface_GetQualityProfile_Call {
+func (_c *MockClientInterface_GetLicense_Call) Run(run func(ctx context.Context)) *MockClientInterface_GetLicense_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClientInterface_GetQualityProfile_Call) Return(qualityProfile *sonar.QualityProfile, err error) *MockClientInterface_GetQualityProfile_Call {
-	_c.Call.Return(qualityProfile, err)
+func (_c *MockClientInterface_GetLicense_Call) Return(license *sonar.License, err error) *MockClientInterface_GetLicense_Call {
+	_c.Call.Return(license, err)
 	return _c
 }
 
-func (_c *MockClientInterface_GetQualityProfile_Call) RunAndReturn(run func(ctx context.Context, name string) (*sonar.QualityProfile, error)) *MockClientInterface_GetQualityProfile_Call {
+func (_c *MockClientInterface_GetLicense_Call) RunAndReturn(run func(ctx context.Context) (*sonar.License, error)) *MockClientInterface_GetLicense_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetQualityProfileActiveRules provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) GetQualityProfileActiveRules(ctx context.Context, profileKey string) ([]sonar.Rule, error) {
-	ret := _mock.Called(ctx, profileKey)
+// GetOrganization provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetOrganization(ctx context.Context, key string) (*sonar.Organization, error) {
+	ret := _mock.Called(ctx, key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetQualityProfileActiveRules")
+		panic("no return value specified for GetOrganization")
 	}
 
-	var r0 []sonar.Rule
+	var r0 *sonar.Organization
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]sonar.Rule, error)); ok {
-		return returnFunc(ctx, profileKey)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.Organization, error)); ok {
+		return returnFunc(ctx, key)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []sonar.Rule); ok {
-		r0 = returnFunc(ctx, profileKey)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.Organization); ok {
+		r0 = returnFunc(ctx, key)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]sonar.Rule)
+			r0 = ret.Get(0).(*sonar.Organization)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, profileKey)
+		r1 = returnFunc(ctx, key)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClientInterface_GetQualityProfileActiveRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQualityProfileActiveRules'
-type MockClientInterface_GetQualityProfileActiveRules_Call struct {
+// MockClientInterface_GetOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganization'
+type MockClientInterface_GetOrganization_Call struct {
 	*mock.Call
 }
 
-// GetQualityProfileActiveRules is a helper method to define mock.On call
+// GetOrganization is a helper method to define mock.On call
 //   - ctx context.Context
-//   - profileKey string
-func (_e *MockClientInterface_Expecter) GetQualityProfileActiveRules(ctx interface{}, profileKey interface{}) *MockClientInterface_GetQualityProfileActiveRules_Call {
-	return &MockClientInterface_GetQualityProfileActiveRules_Call{Call: _e.mock.On("GetQualityProfileActiveRules", ctx, profileKey)}
+//   - key string
+func (_e *MockClientInterface_Expecter) GetOrganization(ctx interface{}, key interface{}) *MockClientInterface_GetOrganization_Call {
+	return &MockClientInterface_GetOrganization_Call{Call: _e.mock.On("GetOrganization", ctx, key)}
 }
 
-func (_c *MockClientInterface_GetQualityProfileActiveRules_Call) Run(run func(ctx context.Context, profileKey string)) *MockClientInterface_GetQualityProfileActiveRules_Call {
+func (_c *MockClientInterface_GetOrganization_Call) Run(run func(ctx context.Context, key string)) *MockClientInterface_GetOrganization_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1988,125 +2107,119 @@ func (_c *MockClientInterface_GetQualityProfileActiveRules_Call) Run(run func(ct
 	return _c
 }
 
-func (_c *MockClientInterface_GetQualityProfileActiveRules_Call) Return(rules []sonar.Rule, err error) *MockClientInterface_GetQualityProfileActiveRules_Call {
-	_c.Call.Return(rules, err)
+func (_c *MockClientInterface_GetOrganization_Call) Return(organization *sonar.Organization, err error) *MockClientInterface_GetOrganization_Call {
+	_c.Call.Return(organization, err)
 	return _c
 }
 
-func (_c *MockClientInterface_GetQualityProfileActiveRules_Call) RunAndReturn(run func(ctx context.Context, profileKey string) ([]sonar.Rule, error)) *MockClientInterface_GetQualityProfileActiveRules_Call {
+func (_c *MockClientInterface_GetOrganization_Call) RunAndReturn(run func(ctx context.Context, key string) (*sonar.Organization, error)) *MockClientInterface_GetOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByLogin provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) GetUserByLogin(ctx context.Context, userLogin string) (*sonar.User, error) {
-	ret := _mock.Called(ctx, userLogin)
+// GetPendingPlugins provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetPendingPlugins(ctx context.Context) (*sonar.PendingPlugins, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByLogin")
+		panic("no return value specified for GetPendingPlugins")
 	}
 
-	var r0 *sonar.User
+	var r0 *sonar.PendingPlugins
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.User, error)); ok {
-		return returnFunc(ctx, userLogin)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*sonar.PendingPlugins, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.User); ok {
-		r0 = returnFunc(ctx, userLogin)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *sonar.PendingPlugins); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sonar.User)
+			r0 = ret.Get(0).(*sonar.PendingPlugins)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userLogin)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClientInterface_GetUserByLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByLogin'
-type MockClientInterface_GetUserByLogin_Call struct {
+// MockClientInterface_GetPendingPlugins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingPlugins'
+type MockClientInterface_GetPendingPlugins_Call struct {
 	*mock.Call
 }
 
-// GetUserByLogin is a helper method to define mock.On call
+// GetPendingPlugins is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userLogin string
-func (_e *MockClientInterface_Expecter) GetUserByLogin(ctx interface{}, userLogin interface{}) *MockClientInterface_GetUserByLogin_Call {
-	return &MockClientInterface_GetUserByLogin_Call{Call: _e.mock.On("GetUserByLogin", ctx, userLogin)}
+func (_e *MockClientInterface_Expecter) GetPendingPlugins(ctx interface{}) *MockClientInterface_GetPendingPlugins_Call {
+	return &MockClientInterface_GetPendingPlugins_Call{Call: _e.mock.On("GetPendingPlugins", ctx)}
 }
 
-func (_c *MockClientInterface_GetUserByLogin_Call) Run(run func(ctx context.Context, userLogin string)) *MockClientInterface_GetUserByLogin_Call {
+func (_c *MockClientInterface_GetPendingPlugins_Call) Run(run func(ctx context.Context)) *MockClientInterface_GetPendingPlugins_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClientInterface_GetUserByLogin_Call) Return(user *sonar.User, err error) *MockClientInterface_GetUserByLogin_Call {
-	_c.Call.Return(user, err)
+func (_c *MockClientInterface_GetPendingPlugins_Call) Return(pendingPlugins *sonar.PendingPlugins, err error) *MockClientInterface_GetPendingPlugins_Call {
+	_c.Call.Return(pendingPlugins, err)
 	return _c
 }
 
-func (_c *MockClientInterface_GetUserByLogin_Call) RunAndReturn(run func(ctx context.Context, userLogin string) (*sonar.User, error)) *MockClientInterface_GetUserByLogin_Call {
+func (_c *MockClientInterface_GetPendingPlugins_Call) RunAndReturn(run func(ctx context.Context) (*sonar.PendingPlugins, error)) *MockClientInterface_GetPendingPlugins_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserGroups provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) GetUserGroups(ctx context.Context, userLogin string) ([]sonar.Group, error) {
-	ret := _mock.Called(ctx, userLogin)
+// GetPermissionTemplate provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetPermissionTemplate(ctx context.Context, name string) (*sonar.PermissionTemplate, error) {
+	ret := _mock.Called(ctx, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserGroups")
+		panic("no return value specified for GetPermissionTemplate")
 	}
 
-	var r0 []sonar.Group
+	var r0 *sonar.PermissionTemplate
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]sonar.Group, error)); ok {
-		return returnFunc(ctx, userLogin)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.PermissionTemplate, error)); ok {
+		return returnFunc(ctx, name)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []sonar.Group); ok {
-		r0 = returnFunc(ctx, userLogin)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.PermissionTemplate); ok {
+		r0 = returnFunc(ctx, name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]sonar.Group)
+			r0 = ret.Get(0).(*sonar.PermissionTemplate)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userLogin)
+		r1 = returnFunc(ctx, name)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClientInterface_GetUserGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserGroups'
-type MockClientInterface_GetUserGroups_Call struct {
+// MockClientInterface_GetPermissionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPermissionTemplate'
+type MockClientInterface_GetPermissionTemplate_Call struct {
 	*mock.Call
 }
 
-// GetUserGroups is a helper method to define mock.On call
+// GetPermissionTemplate is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userLogin string
-func (_e *MockClientInterface_Expecter) GetUserGroups(ctx interface{}, userLogin interface{}) *MockClientInterface_GetUserGroups_Call {
-	return &MockClientInterface_GetUserGroups_Call{Call: _e.mock.On("GetUserGroups", ctx, userLogin)}
+//   - name string
+func (_e *MockClientInterface_Expecter) GetPermissionTemplate(ctx interface{}, name interface{}) *MockClientInterface_GetPermissionTemplate_Call {
+	return &MockClientInterface_GetPermissionTemplate_Call{Call: _e.mock.On("GetPermissionTemplate", ctx, name)}
 }
 
-func (_c *MockClientInterface_GetUserGroups_Call) Run(run func(ctx context.Context, userLogin string)) *MockClientInterface_GetUserGroups_Call {
+func (_c *MockClientInterface_GetPermissionTemplate_Call) Run(run func(ctx context.Context, name string)) *MockClientInterface_GetPermissionTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2124,19 +2237,833 @@ func (_c *MockClientInterface_GetUserGroups_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockClientInterface_GetUserGroups_Call) Return(groups []sonar.Group, err error) *MockClientInterface_GetUserGroups_Call {
-	_c.Call.Return(groups, err)
+func (_c *MockClientInterface_GetPermissionTemplate_Call) Return(permissionTemplate *sonar.PermissionTemplate, err error) *MockClientInterface_GetPermissionTemplate_Call {
+	_c.Call.Return(permissionTemplate, err)
 	return _c
 }
 
-func (_c *MockClientInterface_GetUserGroups_Call) RunAndReturn(run func(ctx context.Context, userLogin string) ([]sonar.Group, error)) *MockClientInterface_GetUserGroups_Call {
+func (_c *MockClientInterface_GetPermissionTemplate_Call) RunAndReturn(run func(ctx context.Context, name string) (*sonar.PermissionTemplate, error)) *MockClientInterface_GetPermissionTemplate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserPermissions provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) GetUserPermissions(ctx context.Context, userLogin string) ([]string, error) {
-	ret := _mock.Called(ctx, userLogin)
+// GetPermissionTemplateGroups provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetPermissionTemplateGroups(ctx context.Context, templateID string) (map[string][]string, error) {
+	ret := _mock.Called(ctx, templateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPermissionTemplateGroups")
+	}
+
+	var r0 map[string][]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (map[string][]string, error)); ok {
+		return returnFunc(ctx, templateID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) map[string][]string); ok {
+		r0 = returnFunc(ctx, templateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, templateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetPermissionTemplateGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPermissionTemplateGroups'
+type MockClientInterface_GetPermissionTemplateGroups_Call struct {
+	*mock.Call
+}
+
+// GetPermissionTemplateGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - templateID string
+func (_e *MockClientInterface_Expecter) GetPermissionTemplateGroups(ctx interface{}, templateID interface{}) *MockClientInterface_GetPermissionTemplateGroups_Call {
+	return &MockClientInterface_GetPermissionTemplateGroups_Call{Call: _e.mock.On("GetPermissionTemplateGroups", ctx, templateID)}
+}
+
+func (_c *MockClientInterface_GetPermissionTemplateGroups_Call) Run(run func(ctx context.Context, templateID string)) *MockClientInterface_GetPermissionTemplateGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetPermissionTemplateGroups_Call) Return(stringToStrings map[string][]string, err error) *MockClientInterface_GetPermissionTemplateGroups_Call {
+	_c.Call.Return(stringToStrings, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetPermissionTemplateGroups_Call) RunAndReturn(run func(ctx context.Context, templateID string) (map[string][]string, error)) *MockClientInterface_GetPermissionTemplateGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPluginUpdates provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetPluginUpdates(ctx context.Context) ([]sonar.UpdatablePlugin, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPluginUpdates")
+	}
+
+	var r0 []sonar.UpdatablePlugin
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]sonar.UpdatablePlugin, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []sonar.UpdatablePlugin); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sonar.UpdatablePlugin)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetPluginUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPluginUpdates'
+type MockClientInterface_GetPluginUpdates_Call struct {
+	*mock.Call
+}
+
+// GetPluginUpdates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockClientInterface_Expecter) GetPluginUpdates(ctx interface{}) *MockClientInterface_GetPluginUpdates_Call {
+	return &MockClientInterface_GetPluginUpdates_Call{Call: _e.mock.On("GetPluginUpdates", ctx)}
+}
+
+func (_c *MockClientInterface_GetPluginUpdates_Call) Run(run func(ctx context.Context)) *MockClientInterface_GetPluginUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetPluginUpdates_Call) Return(updatablePlugins []sonar.UpdatablePlugin, err error) *MockClientInterface_GetPluginUpdates_Call {
+	_c.Call.Return(updatablePlugins, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetPluginUpdates_Call) RunAndReturn(run func(ctx context.Context) ([]sonar.UpdatablePlugin, error)) *MockClientInterface_GetPluginUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPlugins provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetPlugins(ctx context.Context) ([]sonar.Plugin, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlugins")
+	}
+
+	var r0 []sonar.Plugin
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]sonar.Plugin, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []sonar.Plugin); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sonar.Plugin)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetPlugins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlugins'
+type MockClientInterface_GetPlugins_Call struct {
+	*mock.Call
+}
+
+// GetPlugins is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockClientInterface_Expecter) GetPlugins(ctx interface{}) *MockClientInterface_GetPlugins_Call {
+	return &MockClientInterface_GetPlugins_Call{Call: _e.mock.On("GetPlugins", ctx)}
+}
+
+func (_c *MockClientInterface_GetPlugins_Call) Run(run func(ctx context.Context)) *MockClientInterface_GetPlugins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetPlugins_Call) Return(plugins []sonar.Plugin, err error) *MockClientInterface_GetPlugins_Call {
+	_c.Call.Return(plugins, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetPlugins_Call) RunAndReturn(run func(ctx context.Context) ([]sonar.Plugin, error)) *MockClientInterface_GetPlugins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProject provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetProject(ctx context.Context, projectKey string) (*sonar.Project, error) {
+	ret := _mock.Called(ctx, projectKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProject")
+	}
+
+	var r0 *sonar.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.Project, error)); ok {
+		return returnFunc(ctx, projectKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.Project); ok {
+		r0 = returnFunc(ctx, projectKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, projectKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProject'
+type MockClientInterface_GetProject_Call struct {
+	*mock.Call
+}
+
+// GetProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectKey string
+func (_e *MockClientInterface_Expecter) GetProject(ctx interface{}, projectKey interface{}) *MockClientInterface_GetProject_Call {
+	return &MockClientInterface_GetProject_Call{Call: _e.mock.On("GetProject", ctx, projectKey)}
+}
+
+func (_c *MockClientInterface_GetProject_Call) Run(run func(ctx context.Context, projectKey string)) *MockClientInterface_GetProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetProject_Call) Return(project *sonar.Project, err error) *MockClientInterface_GetProject_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetProject_Call) RunAndReturn(run func(ctx context.Context, projectKey string) (*sonar.Project, error)) *MockClientInterface_GetProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectMeasures provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetProjectMeasures(ctx context.Context, projectKey string, metricKeys []string) (map[string]float64, error) {
+	ret := _mock.Called(ctx, projectKey, metricKeys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectMeasures")
+	}
+
+	var r0 map[string]float64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) (map[string]float64, error)); ok {
+		return returnFunc(ctx, projectKey, metricKeys)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) map[string]float64); ok {
+		r0 = returnFunc(ctx, projectKey, metricKeys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]float64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, projectKey, metricKeys)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetProjectMeasures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectMeasures'
+type MockClientInterface_GetProjectMeasures_Call struct {
+	*mock.Call
+}
+
+// GetProjectMeasures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectKey string
+//   - metricKeys []string
+func (_e *MockClientInterface_Expecter) GetProjectMeasures(ctx interface{}, projectKey interface{}, metricKeys interface{}) *MockClientInterface_GetProjectMeasures_Call {
+	return &MockClientInterface_GetProjectMeasures_Call{Call: _e.mock.On("GetProjectMeasures", ctx, projectKey, metricKeys)}
+}
+
+func (_c *MockClientInterface_GetProjectMeasures_Call) Run(run func(ctx context.Context, projectKey string, metricKeys []string)) *MockClientInterface_GetProjectMeasures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetProjectMeasures_Call) Return(stringToFloat64 map[string]float64, err error) *MockClientInterface_GetProjectMeasures_Call {
+	_c.Call.Return(stringToFloat64, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetProjectMeasures_Call) RunAndReturn(run func(ctx context.Context, projectKey string, metricKeys []string) (map[string]float64, error)) *MockClientInterface_GetProjectMeasures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectQualityGateStatus provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetProjectQualityGateStatus(ctx context.Context, projectKey string) (string, error) {
+	ret := _mock.Called(ctx, projectKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectQualityGateStatus")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, projectKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, projectKey)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, projectKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetProjectQualityGateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectQualityGateStatus'
+type MockClientInterface_GetProjectQualityGateStatus_Call struct {
+	*mock.Call
+}
+
+// GetProjectQualityGateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectKey string
+func (_e *MockClientInterface_Expecter) GetProjectQualityGateStatus(ctx interface{}, projectKey interface{}) *MockClientInterface_GetProjectQualityGateStatus_Call {
+	return &MockClientInterface_GetProjectQualityGateStatus_Call{Call: _e.mock.On("GetProjectQualityGateStatus", ctx, projectKey)}
+}
+
+func (_c *MockClientInterface_GetProjectQualityGateStatus_Call) Run(run func(ctx context.Context, projectKey string)) *MockClientInterface_GetProjectQualityGateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetProjectQualityGateStatus_Call) Return(s string, err error) *MockClientInterface_GetProjectQualityGateStatus_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetProjectQualityGateStatus_Call) RunAndReturn(run func(ctx context.Context, projectKey string) (string, error)) *MockClientInterface_GetProjectQualityGateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQualityGate provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetQualityGate(ctx context.Context, name string) (*sonar.QualityGate, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQualityGate")
+	}
+
+	var r0 *sonar.QualityGate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.QualityGate, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.QualityGate); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.QualityGate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetQualityGate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQualityGate'
+type MockClientInterface_GetQualityGate_Call struct {
+	*mock.Call
+}
+
+// GetQualityGate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockClientInterface_Expecter) GetQualityGate(ctx interface{}, name interface{}) *MockClientInterface_GetQualityGate_Call {
+	return &MockClientInterface_GetQualityGate_Call{Call: _e.mock.On("GetQualityGate", ctx, name)}
+}
+
+func (_c *MockClientInterface_GetQualityGate_Call) Run(run func(ctx context.Context, name string)) *MockClientInterface_GetQualityGate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetQualityGate_Call) Return(qualityGate *sonar.QualityGate, err error) *MockClientInterface_GetQualityGate_Call {
+	_c.Call.Return(qualityGate, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetQualityGate_Call) RunAndReturn(run func(ctx context.Context, name string) (*sonar.QualityGate, error)) *MockClientInterface_GetQualityGate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQualityProfile provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetQualityProfile(ctx context.Context, name string) (*sonar.QualityProfile, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQualityProfile")
+	}
+
+	var r0 *sonar.QualityProfile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.QualityProfile, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.QualityProfile); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.QualityProfile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetQualityProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQualityProfile'
+type MockClientInterface_GetQualityProfile_Call struct {
+	*mock.Call
+}
+
+// GetQualityProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockClientInterface_Expecter) GetQualityProfile(ctx interface{}, name interface{}) *MockClientInterface_GetQualityProfile_Call {
+	return &MockClientInterface_GetQualityProfile_Call{Call: _e.mock.On("GetQualityProfile", ctx, name)}
+}
+
+func (_c *MockClientInterface_GetQualityProfile_Call) Run(run func(ctx context.Context, name string)) *MockClientInterface_GetQualityProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetQualityProfile_Call) Return(qualityProfile *sonar.QualityProfile, err error) *MockClientInterface_GetQualityProfile_Call {
+	_c.Call.Return(qualityProfile, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetQualityProfile_Call) RunAndReturn(run func(ctx context.Context, name string) (*sonar.QualityProfile, error)) *MockClientInterface_GetQualityProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQualityProfileActiveRules provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetQualityProfileActiveRules(ctx context.Context, profileKey string) ([]sonar.Rule, error) {
+	ret := _mock.Called(ctx, profileKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQualityProfileActiveRules")
+	}
+
+	var r0 []sonar.Rule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]sonar.Rule, error)); ok {
+		return returnFunc(ctx, profileKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []sonar.Rule); ok {
+		r0 = returnFunc(ctx, profileKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sonar.Rule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, profileKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetQualityProfileActiveRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQualityProfileActiveRules'
+type MockClientInterface_GetQualityProfileActiveRules_Call struct {
+	*mock.Call
+}
+
+// GetQualityProfileActiveRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - profileKey string
+func (_e *MockClientInterface_Expecter) GetQualityProfileActiveRules(ctx interface{}, profileKey interface{}) *MockClientInterface_GetQualityProfileActiveRules_Call {
+	return &MockClientInterface_GetQualityProfileActiveRules_Call{Call: _e.mock.On("GetQualityProfileActiveRules", ctx, profileKey)}
+}
+
+func (_c *MockClientInterface_GetQualityProfileActiveRules_Call) Run(run func(ctx context.Context, profileKey string)) *MockClientInterface_GetQualityProfileActiveRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetQualityProfileActiveRules_Call) Return(rules []sonar.Rule, err error) *MockClientInterface_GetQualityProfileActiveRules_Call {
+	_c.Call.Return(rules, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetQualityProfileActiveRules_Call) RunAndReturn(run func(ctx context.Context, profileKey string) ([]sonar.Rule, error)) *MockClientInterface_GetQualityProfileActiveRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSettings provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetSettings(ctx context.Context, component string, settingsKeys []string) ([]sonar.Setting, error) {
+	ret := _mock.Called(ctx, component, settingsKeys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSettings")
+	}
+
+	var r0 []sonar.Setting
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) ([]sonar.Setting, error)); ok {
+		return returnFunc(ctx, component, settingsKeys)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) []sonar.Setting); ok {
+		r0 = returnFunc(ctx, component, settingsKeys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sonar.Setting)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, component, settingsKeys)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSettings'
+type MockClientInterface_GetSettings_Call struct {
+	*mock.Call
+}
+
+// GetSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - component string
+//   - settingsKeys []string
+func (_e *MockClientInterface_Expecter) GetSettings(ctx interface{}, component interface{}, settingsKeys interface{}) *MockClientInterface_GetSettings_Call {
+	return &MockClientInterface_GetSettings_Call{Call: _e.mock.On("GetSettings", ctx, component, settingsKeys)}
+}
+
+func (_c *MockClientInterface_GetSettings_Call) Run(run func(ctx context.Context, component string, settingsKeys []string)) *MockClientInterface_GetSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetSettings_Call) Return(settings []sonar.Setting, err error) *MockClientInterface_GetSettings_Call {
+	_c.Call.Return(settings, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetSettings_Call) RunAndReturn(run func(ctx context.Context, component string, settingsKeys []string) ([]sonar.Setting, error)) *MockClientInterface_GetSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByLogin provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetUserByLogin(ctx context.Context, userLogin string) (*sonar.User, error) {
+	ret := _mock.Called(ctx, userLogin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByLogin")
+	}
+
+	var r0 *sonar.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.User, error)); ok {
+		return returnFunc(ctx, userLogin)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.User); ok {
+		r0 = returnFunc(ctx, userLogin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userLogin)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetUserByLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByLogin'
+type MockClientInterface_GetUserByLogin_Call struct {
+	*mock.Call
+}
+
+// GetUserByLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLogin string
+func (_e *MockClientInterface_Expecter) GetUserByLogin(ctx interface{}, userLogin interface{}) *MockClientInterface_GetUserByLogin_Call {
+	return &MockClientInterface_GetUserByLogin_Call{Call: _e.mock.On("GetUserByLogin", ctx, userLogin)}
+}
+
+func (_c *MockClientInterface_GetUserByLogin_Call) Run(run func(ctx context.Context, userLogin string)) *MockClientInterface_GetUserByLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetUserByLogin_Call) Return(user *sonar.User, err error) *MockClientInterface_GetUserByLogin_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetUserByLogin_Call) RunAndReturn(run func(ctx context.Context, userLogin string) (*sonar.User, error)) *MockClientInterface_GetUserByLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserGroups provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetUserGroups(ctx context.Context, userLogin string) ([]sonar.Group, error) {
+	ret := _mock.Called(ctx, userLogin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserGroups")
+	}
+
+	var r0 []sonar.Group
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]sonar.Group, error)); ok {
+		return returnFunc(ctx, userLogin)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []sonar.Group); ok {
+		r0 = returnFunc(ctx, userLogin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sonar.Group)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userLogin)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetUserGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserGroups'
+type MockClientInterface_GetUserGroups_Call struct {
+	*mock.Call
+}
+
+// GetUserGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLogin string
+func (_e *MockClientInterface_Expecter) GetUserGroups(ctx interface{}, userLogin interface{}) *MockClientInterface_GetUserGroups_Call {
+	return &MockClientInterface_GetUserGroups_Call{Call: _e.mock.On("GetUserGroups", ctx, userLogin)}
+}
+
+func (_c *MockClientInterface_GetUserGroups_Call) Run(run func(ctx context.Context, userLogin string)) *MockClientInterface_GetUserGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetUserGroups_Call) Return(groups []sonar.Group, err error) *MockClientInterface_GetUserGroups_Call {
+	_c.Call.Return(groups, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetUserGroups_Call) RunAndReturn(run func(ctx context.Context, userLogin string) ([]sonar.Group, error)) *MockClientInterface_GetUserGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserPermissions provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetUserPermissions(ctx context.Context, userLogin string) ([]string, error) {
+	ret := _mock.Called(ctx, userLogin)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUserPermissions")
@@ -2276,6 +3203,148 @@ func (_c *MockClientInterface_GetUserToken_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// GetWebhook provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetWebhook(ctx context.Context, key string, projectKey string) (*sonar.Webhook, error) {
+	ret := _mock.Called(ctx, key, projectKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhook")
+	}
+
+	var r0 *sonar.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*sonar.Webhook, error)); ok {
+		return returnFunc(ctx, key, projectKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *sonar.Webhook); ok {
+		r0 = returnFunc(ctx, key, projectKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, key, projectKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhook'
+type MockClientInterface_GetWebhook_Call struct {
+	*mock.Call
+}
+
+// GetWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - projectKey string
+func (_e *MockClientInterface_Expecter) GetWebhook(ctx interface{}, key interface{}, projectKey interface{}) *MockClientInterface_GetWebhook_Call {
+	return &MockClientInterface_GetWebhook_Call{Call: _e.mock.On("GetWebhook", ctx, key, projectKey)}
+}
+
+func (_c *MockClientInterface_GetWebhook_Call) Run(run func(ctx context.Context, key string, projectKey string)) *MockClientInterface_GetWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetWebhook_Call) Return(webhook *sonar.Webhook, err error) *MockClientInterface_GetWebhook_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetWebhook_Call) RunAndReturn(run func(ctx context.Context, key string, projectKey string) (*sonar.Webhook, error)) *MockClientInterface_GetWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhooks provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) GetWebhooks(ctx context.Context, projectKey string) ([]sonar.Webhook, error) {
+	ret := _mock.Called(ctx, projectKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooks")
+	}
+
+	var r0 []sonar.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]sonar.Webhook, error)); ok {
+		return returnFunc(ctx, projectKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []sonar.Webhook); ok {
+		r0 = returnFunc(ctx, projectKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sonar.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, projectKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_GetWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooks'
+type MockClientInterface_GetWebhooks_Call struct {
+	*mock.Call
+}
+
+// GetWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectKey string
+func (_e *MockClientInterface_Expecter) GetWebhooks(ctx interface{}, projectKey interface{}) *MockClientInterface_GetWebhooks_Call {
+	return &MockClientInterface_GetWebhooks_Call{Call: _e.mock.On("GetWebhooks", ctx, projectKey)}
+}
+
+func (_c *MockClientInterface_GetWebhooks_Call) Run(run func(ctx context.Context, projectKey string)) *MockClientInterface_GetWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_GetWebhooks_Call) Return(webhooks []sonar.Webhook, err error) *MockClientInterface_GetWebhooks_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockClientInterface_GetWebhooks_Call) RunAndReturn(run func(ctx context.Context, projectKey string) ([]sonar.Webhook, error)) *MockClientInterface_GetWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Health provides a mock function for the type MockClientInterface
 func (_mock *MockClientInterface) Health(ctx context.Context) (*sonar.SystemHealth, error) {
 	ret := _mock.Called(ctx)
@@ -2338,39 +3407,105 @@ func (_c *MockClientInterface_Health_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
-// InstallPlugins provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) InstallPlugins(plugins []string) error {
-	ret := _mock.Called(plugins)
+// InstallPlugin provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) InstallPlugin(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InstallPlugins")
+		panic("no return value specified for InstallPlugin")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func([]string) error); ok {
-		r0 = returnFunc(plugins)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockClientInterface_InstallPlugins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstallPlugins'
-type MockClientInterface_InstallPlugins_Call struct {
+// MockClientInterface_InstallPlugin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstallPlugin'
+type MockClientInterface_InstallPlugin_Call struct {
+	*mock.Call
+}
+
+// InstallPlugin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockClientInterface_Expecter) InstallPlugin(ctx interface{}, key interface{}) *MockClientInterface_InstallPlugin_Call {
+	return &MockClientInterface_InstallPlugin_Call{Call: _e.mock.On("InstallPlugin", ctx, key)}
+}
+
+func (_c *MockClientInterface_InstallPlugin_Call) Run(run func(ctx context.Context, key string)) *MockClientInterface_InstallPlugin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_InstallPlugin_Call) Return(err error) *MockClientInterface_InstallPlugin_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClientInterface_InstallPlugin_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockClientInterface_InstallPlugin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsAuthenticated provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) IsAuthenticated(ctx context.Context) (bool, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAuthenticated")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_IsAuthenticated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAuthenticated'
+type MockClientInterface_IsAuthenticated_Call struct {
 	*mock.Call
 }
 
-// InstallPlugins is a helper method to define mock.On call
-//   - plugins []string
-func (_e *MockClientInterface_Expecter) InstallPlugins(plugins interface{}) *MockClientInterface_InstallPlugins_Call {
-	return &MockClientInterface_InstallPlugins_Call{Call: _e.mock.On("InstallPlugins", plugins)}
+// IsAuthenticated is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockClientInterface_Expecter) IsAuthenticated(ctx interface{}) *MockClientInterface_IsAuthenticated_Call {
+	return &MockClientInterface_IsAuthenticated_Call{Call: _e.mock.On("IsAuthenticated", ctx)}
 }
 
-func (_c *MockClientInterface_InstallPlugins_Call) Run(run func(plugins []string)) *MockClientInterface_InstallPlugins_Call {
+func (_c *MockClientInterface_IsAuthenticated_Call) Run(run func(ctx context.Context)) *MockClientInterface_IsAuthenticated_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 []string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].([]string)
+			arg0 = args[0].(context.Context)
 		}
 		run(
 			arg0,
@@ -2379,12 +3514,56 @@ func (_c *MockClientInterface_InstallPlugins_Call) Run(run func(plugins []string
 	return _c
 }
 
-func (_c *MockClientInterface_InstallPlugins_Call) Return(err error) *MockClientInterface_InstallPlugins_Call {
+func (_c *MockClientInterface_IsAuthenticated_Call) Return(b bool, err error) *MockClientInterface_IsAuthenticated_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockClientInterface_IsAuthenticated_Call) RunAndReturn(run func(ctx context.Context) (bool, error)) *MockClientInterface_IsAuthenticated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reboot provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) Reboot() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reboot")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClientInterface_Reboot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reboot'
+type MockClientInterface_Reboot_Call struct {
+	*mock.Call
+}
+
+// Reboot is a helper method to define mock.On call
+func (_e *MockClientInterface_Expecter) Reboot() *MockClientInterface_Reboot_Call {
+	return &MockClientInterface_Reboot_Call{Call: _e.mock.On("Reboot")}
+}
+
+func (_c *MockClientInterface_Reboot_Call) Run(run func()) *MockClientInterface_Reboot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_Reboot_Call) Return(err error) *MockClientInterface_Reboot_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClientInterface_InstallPlugins_Call) RunAndReturn(run func(plugins []string) error) *MockClientInterface_InstallPlugins_Call {
+func (_c *MockClientInterface_Reboot_Call) RunAndReturn(run func() error) *MockClientInterface_Reboot_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2551,7 +3730,70 @@ func (_e *MockClientInterface_Expecter) RemovePermissionFromUser(ctx interface{}
 	return &MockClientInterface_RemovePermissionFromUser_Call{Call: _e.mock.On("RemovePermissionFromUser", ctx, userLogin, permission)}
 }
 
-func (_c *MockClientInterface_RemovePermissionFromUser_Call) Run(run func(ctx context.Context, userLogin string, permission string)) *MockClientInterface_RemovePermissionFromUser_Call {
+func (_c *MockClientInterface_RemovePermissionFromUser_Call) Run(run func(ctx context.Context, userLogin string, permission string)) *MockClientInterface_RemovePermissionFromUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_RemovePermissionFromUser_Call) Return(err error) *MockClientInterface_RemovePermissionFromUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClientInterface_RemovePermissionFromUser_Call) RunAndReturn(run func(ctx context.Context, userLogin string, permission string) error) *MockClientInterface_RemovePermissionFromUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveUserFromGroup provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) RemoveUserFromGroup(ctx context.Context, userLogin string, groupName string) error {
+	ret := _mock.Called(ctx, userLogin, groupName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveUserFromGroup")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userLogin, groupName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClientInterface_RemoveUserFromGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserFromGroup'
+type MockClientInterface_RemoveUserFromGroup_Call struct {
+	*mock.Call
+}
+
+// RemoveUserFromGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userLogin string
+//   - groupName string
+func (_e *MockClientInterface_Expecter) RemoveUserFromGroup(ctx interface{}, userLogin interface{}, groupName interface{}) *MockClientInterface_RemoveUserFromGroup_Call {
+	return &MockClientInterface_RemoveUserFromGroup_Call{Call: _e.mock.On("RemoveUserFromGroup", ctx, userLogin, groupName)}
+}
+
+func (_c *MockClientInterface_RemoveUserFromGroup_Call) Run(run func(ctx context.Context, userLogin string, groupName string)) *MockClientInterface_RemoveUserFromGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2574,47 +3816,47 @@ func (_c *MockClientInterface_RemovePermissionFromUser_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *MockClientInterface_RemovePermissionFromUser_Call) Return(err error) *MockClientInterface_RemovePermissionFromUser_Call {
+func (_c *MockClientInterface_RemoveUserFromGroup_Call) Return(err error) *MockClientInterface_RemoveUserFromGroup_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClientInterface_RemovePermissionFromUser_Call) RunAndReturn(run func(ctx context.Context, userLogin string, permission string) error) *MockClientInterface_RemovePermissionFromUser_Call {
+func (_c *MockClientInterface_RemoveUserFromGroup_Call) RunAndReturn(run func(ctx context.Context, userLogin string, groupName string) error) *MockClientInterface_RemoveUserFromGroup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveUserFromGroup provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) RemoveUserFromGroup(ctx context.Context, userLogin string, groupName string) error {
-	ret := _mock.Called(ctx, userLogin, groupName)
+// ResetSettings provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) ResetSettings(ctx context.Context, component string, settingsKeys []string) error {
+	ret := _mock.Called(ctx, component, settingsKeys)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveUserFromGroup")
+		panic("no return value specified for ResetSettings")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, userLogin, groupName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, component, settingsKeys)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockClientInterface_RemoveUserFromGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserFromGroup'
-type MockClientInterface_RemoveUserFromGroup_Call struct {
+// MockClientInterface_ResetSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetSettings'
+type MockClientInterface_ResetSettings_Call struct {
 	*mock.Call
 }
 
-// RemoveUserFromGroup is a helper method to define mock.On call
+// ResetSettings is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userLogin string
-//   - groupName string
-func (_e *MockClientInterface_Expecter) RemoveUserFromGroup(ctx interface{}, userLogin interface{}, groupName interface{}) *MockClientInterface_RemoveUserFromGroup_Call {
-	return &MockClientInterface_RemoveUserFromGroup_Call{Call: _e.mock.On("RemoveUserFromGroup", ctx, userLogin, groupName)}
+//   - component string
+//   - settingsKeys []string
+func (_e *MockClientInterface_Expecter) ResetSettings(ctx interface{}, component interface{}, settingsKeys interface{}) *MockClientInterface_ResetSettings_Call {
+	return &MockClientInterface_ResetSettings_Call{Call: _e.mock.On("ResetSettings", ctx, component, settingsKeys)}
 }
 
-func (_c *MockClientInterface_RemoveUserFromGroup_Call) Run(run func(ctx context.Context, userLogin string, groupName string)) *MockClientInterface_RemoveUserFromGroup_Call {
+func (_c *MockClientInterface_ResetSettings_Call) Run(run func(ctx context.Context, component string, settingsKeys []string)) *MockClientInterface_ResetSettings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2624,9 +3866,9 @@ func (_c *MockClientInterface_RemoveUserFromGroup_Call) Run(run func(ctx context
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 []string
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].([]string)
 		}
 		run(
 			arg0,
@@ -2637,54 +3879,54 @@ func (_c *MockClientInterface_RemoveUserFromGroup_Call) Run(run func(ctx context
 	return _c
 }
 
-func (_c *MockClientInterface_RemoveUserFromGroup_Call) Return(err error) *MockClientInterface_RemoveUserFromGroup_Call {
+func (_c *MockClientInterface_ResetSettings_Call) Return(err error) *MockClientInterface_ResetSettings_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClientInterface_RemoveUserFromGroup_Call) RunAndReturn(run func(ctx context.Context, userLogin string, groupName string) error) *MockClientInterface_RemoveUserFromGroup_Call {
+func (_c *MockClientInterface_ResetSettings_Call) RunAndReturn(run func(ctx context.Context, component string, settingsKeys []string) error) *MockClientInterface_ResetSettings_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResetSettings provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) ResetSettings(ctx context.Context, settingsKeys []string) error {
-	ret := _mock.Called(ctx, settingsKeys)
+// RevokeToken provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) RevokeToken(ctx context.Context, name string) error {
+	ret := _mock.Called(ctx, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ResetSettings")
+		panic("no return value specified for RevokeToken")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) error); ok {
-		r0 = returnFunc(ctx, settingsKeys)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, name)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockClientInterface_ResetSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetSettings'
-type MockClientInterface_ResetSettings_Call struct {
+// MockClientInterface_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type MockClientInterface_RevokeToken_Call struct {
 	*mock.Call
 }
 
-// ResetSettings is a helper method to define mock.On call
+// RevokeToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - settingsKeys []string
-func (_e *MockClientInterface_Expecter) ResetSettings(ctx interface{}, settingsKeys interface{}) *MockClientInterface_ResetSettings_Call {
-	return &MockClientInterface_ResetSettings_Call{Call: _e.mock.On("ResetSettings", ctx, settingsKeys)}
+//   - name string
+func (_e *MockClientInterface_Expecter) RevokeToken(ctx interface{}, name interface{}) *MockClientInterface_RevokeToken_Call {
+	return &MockClientInterface_RevokeToken_Call{Call: _e.mock.On("RevokeToken", ctx, name)}
 }
 
-func (_c *MockClientInterface_ResetSettings_Call) Run(run func(ctx context.Context, settingsKeys []string)) *MockClientInterface_ResetSettings_Call {
+func (_c *MockClientInterface_RevokeToken_Call) Run(run func(ctx context.Context, name string)) *MockClientInterface_RevokeToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []string
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].([]string)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -2694,12 +3936,12 @@ func (_c *MockClientInterface_ResetSettings_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockClientInterface_ResetSettings_Call) Return(err error) *MockClientInterface_ResetSettings_Call {
+func (_c *MockClientInterface_RevokeToken_Call) Return(err error) *MockClientInterface_RevokeToken_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClientInterface_ResetSettings_Call) RunAndReturn(run func(ctx context.Context, settingsKeys []string) error) *MockClientInterface_ResetSettings_Call {
+func (_c *MockClientInterface_RevokeToken_Call) RunAndReturn(run func(ctx context.Context, name string) error) *MockClientInterface_RevokeToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2824,6 +4066,52 @@ func (_c *MockClientInterface_SetAsDefaultQualityProfile_Call) RunAndReturn(run
 	return _c
 }
 
+// SetCredentials provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) SetCredentials(user string, password string) {
+	_mock.Called(user, password)
+	return
+}
+
+// MockClientInterface_SetCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCredentials'
+type MockClientInterface_SetCredentials_Call struct {
+	*mock.Call
+}
+
+// SetCredentials is a helper method to define mock.On call
+//   - user string
+//   - password string
+func (_e *MockClientInterface_Expecter) SetCredentials(user interface{}, password interface{}) *MockClientInterface_SetCredentials_Call {
+	return &MockClientInterface_SetCredentials_Call{Call: _e.mock.On("SetCredentials", user, password)}
+}
+
+func (_c *MockClientInterface_SetCredentials_Call) Run(run func(user string, password string)) *MockClientInterface_SetCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_SetCredentials_Call) Return() *MockClientInterface_SetCredentials_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockClientInterface_SetCredentials_Call) RunAndReturn(run func(user string, password string)) *MockClientInterface_SetCredentials_Call {
+	_c.Run(run)
+	return _c
+}
+
 // SetDefaultPermissionTemplate provides a mock function for the type MockClientInterface
 func (_mock *MockClientInterface) SetDefaultPermissionTemplate(ctx context.Context, name string) error {
 	ret := _mock.Called(ctx, name)
@@ -2881,6 +4169,63 @@ func (_c *MockClientInterface_SetDefaultPermissionTemplate_Call) RunAndReturn(ru
 	return _c
 }
 
+// SetLicense provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) SetLicense(ctx context.Context, license string) error {
+	ret := _mock.Called(ctx, license)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLicense")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, license)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClientInterface_SetLicense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLicense'
+type MockClientInterface_SetLicense_Call struct {
+	*mock.Call
+}
+
+// SetLicense is a helper method to define mock.On call
+//   - ctx context.Context
+//   - license string
+func (_e *MockClientInterface_Expecter) SetLicense(ctx interface{}, license interface{}) *MockClientInterface_SetLicense_Call {
+	return &MockClientInterface_SetLicense_Call{Call: _e.mock.On("SetLicense", ctx, license)}
+}
+
+func (_c *MockClientInterface_SetLicense_Call) Run(run func(ctx context.Context, license string)) *MockClientInterface_SetLicense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_SetLicense_Call) Return(err error) *MockClientInterface_SetLicense_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClientInterface_SetLicense_Call) RunAndReturn(run func(ctx context.Context, license string) error) *MockClientInterface_SetLicense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetProjectsDefaultVisibility provides a mock function for the type MockClientInterface
 func (_mock *MockClientInterface) SetProjectsDefaultVisibility(visibility string) error {
 	ret := _mock.Called(visibility)
@@ -2898,22 +4243,181 @@ func (_mock *MockClientInterface) SetProjectsDefaultVisibility(visibility string
 	return r0
 }
 
-// MockClientInterface_SetProjectsDefaultVisibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProjectsDefaultVisibility'
-type MockClientInterface_SetProjectsDefaultVisibility_Call struct {
+// MockClientInterface_SetProjectsDefaultVisibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProjectsDefaultVisibility'
+type MockClientInterface_SetProjectsDefaultVisibility_Call struct {
+	*mock.Call
+}
+
+// SetProjectsDefaultVisibility is a helper method to define mock.On call
+//   - visibility string
+func (_e *MockClientInterface_Expecter) SetProjectsDefaultVisibility(visibility interface{}) *MockClientInterface_SetProjectsDefaultVisibility_Call {
+	return &MockClientInterface_SetProjectsDefaultVisibility_Call{Call: _e.mock.On("SetProjectsDefaultVisibility", visibility)}
+}
+
+func (_c *MockClientInterface_SetProjectsDefaultVisibility_Call) Run(run func(visibility string)) *MockClientInterface_SetProjectsDefaultVisibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_SetProjectsDefaultVisibility_Call) Return(err error) *MockClientInterface_SetProjectsDefaultVisibility_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClientInterface_SetProjectsDefaultVisibility_Call) RunAndReturn(run func(visibility string) error) *MockClientInterface_SetProjectsDefaultVisibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSetting provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) SetSetting(ctx context.Context, setting url.Values) error {
+	ret := _mock.Called(ctx, setting)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSetting")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, url.Values) error); ok {
+		r0 = returnFunc(ctx, setting)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClientInterface_SetSetting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSetting'
+type MockClientInterface_SetSetting_Call struct {
+	*mock.Call
+}
+
+// SetSetting is a helper method to define mock.On call
+//   - ctx context.Context
+//   - setting url.Values
+func (_e *MockClientInterface_Expecter) SetSetting(ctx interface{}, setting interface{}) *MockClientInterface_SetSetting_Call {
+	return &MockClientInterface_SetSetting_Call{Call: _e.mock.On("SetSetting", ctx, setting)}
+}
+
+func (_c *MockClientInterface_SetSetting_Call) Run(run func(ctx context.Context, setting url.Values)) *MockClientInterface_SetSetting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 url.Values
+		if args[1] != nil {
+			arg1 = args[1].(url.Values)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_SetSetting_Call) Return(err error) *MockClientInterface_SetSetting_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClientInterface_SetSetting_Call) RunAndReturn(run func(ctx context.Context, setting url.Values) error) *MockClientInterface_SetSetting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetToken provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) SetToken(token string) {
+	_mock.Called(token)
+	return
+}
+
+// MockClientInterface_SetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetToken'
+type MockClientInterface_SetToken_Call struct {
+	*mock.Call
+}
+
+// SetToken is a helper method to define mock.On call
+//   - token string
+func (_e *MockClientInterface_Expecter) SetToken(token interface{}) *MockClientInterface_SetToken_Call {
+	return &MockClientInterface_SetToken_Call{Call: _e.mock.On("SetToken", token)}
+}
+
+func (_c *MockClientInterface_SetToken_Call) Run(run func(token string)) *MockClientInterface_SetToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_SetToken_Call) Return() *MockClientInterface_SetToken_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockClientInterface_SetToken_Call) RunAndReturn(run func(token string)) *MockClientInterface_SetToken_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SystemStatus provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) SystemStatus(ctx context.Context) (*sonar.SystemStatusResponse, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SystemStatus")
+	}
+
+	var r0 *sonar.SystemStatusResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*sonar.SystemStatusResponse, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *sonar.SystemStatusResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.SystemStatusResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_SystemStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SystemStatus'
+type MockClientInterface_SystemStatus_Call struct {
 	*mock.Call
 }
 
-// SetProjectsDefaultVisibility is a helper method to define mock.On call
-//   - visibility string
-func (_e *MockClientInterface_Expecter) SetProjectsDefaultVisibility(visibility interface{}) *MockClientInterface_SetProjectsDefaultVisibility_Call {
-	return &MockClientInterface_SetProjectsDefaultVisibility_Call{Call: _e.mock.On("SetProjectsDefaultVisibility", visibility)}
+// SystemStatus is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockClientInterface_Expecter) SystemStatus(ctx interface{}) *MockClientInterface_SystemStatus_Call {
+	return &MockClientInterface_SystemStatus_Call{Call: _e.mock.On("SystemStatus", ctx)}
 }
 
-func (_c *MockClientInterface_SetProjectsDefaultVisibility_Call) Run(run func(visibility string)) *MockClientInterface_SetProjectsDefaultVisibility_Call {
+func (_c *MockClientInterface_SystemStatus_Call) Run(run func(ctx context.Context)) *MockClientInterface_SystemStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
 		}
 		run(
 			arg0,
@@ -2922,54 +4426,54 @@ func (_c *MockClientInterface_SetProjectsDefaultVisibility_Call) Run(run func(vi
 	return _c
 }
 
-func (_c *MockClientInterface_SetProjectsDefaultVisibility_Call) Return(err error) *MockClientInterface_SetProjectsDefaultVisibility_Call {
-	_c.Call.Return(err)
+func (_c *MockClientInterface_SystemStatus_Call) Return(systemStatusResponse *sonar.SystemStatusResponse, err error) *MockClientInterface_SystemStatus_Call {
+	_c.Call.Return(systemStatusResponse, err)
 	return _c
 }
 
-func (_c *MockClientInterface_SetProjectsDefaultVisibility_Call) RunAndReturn(run func(visibility string) error) *MockClientInterface_SetProjectsDefaultVisibility_Call {
+func (_c *MockClientInterface_SystemStatus_Call) RunAndReturn(run func(ctx context.Context) (*sonar.SystemStatusResponse, error)) *MockClientInterface_SystemStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetSetting provides a mock function for the type MockClientInterface
-func (_mock *MockClientInterface) SetSetting(ctx context.Context, setting url.Values) error {
-	ret := _mock.Called(ctx, setting)
+// UninstallPlugin provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) UninstallPlugin(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetSetting")
+		panic("no return value specified for UninstallPlugin")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, url.Values) error); ok {
-		r0 = returnFunc(ctx, setting)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockClientInterface_SetSetting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSetting'
-type MockClientInterface_SetSetting_Call struct {
+// MockClientInterface_UninstallPlugin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UninstallPlugin'
+type MockClientInterface_UninstallPlugin_Call struct {
 	*mock.Call
 }
 
-// SetSetting is a helper method to define mock.On call
+// UninstallPlugin is a helper method to define mock.On call
 //   - ctx context.Context
-//   - setting url.Values
-func (_e *MockClientInterface_Expecter) SetSetting(ctx interface{}, setting interface{}) *MockClientInterface_SetSetting_Call {
-	return &MockClientInterface_SetSetting_Call{Call: _e.mock.On("SetSetting", ctx, setting)}
+//   - key string
+func (_e *MockClientInterface_Expecter) UninstallPlugin(ctx interface{}, key interface{}) *MockClientInterface_UninstallPlugin_Call {
+	return &MockClientInterface_UninstallPlugin_Call{Call: _e.mock.On("UninstallPlugin", ctx, key)}
 }
 
-func (_c *MockClientInterface_SetSetting_Call) Run(run func(ctx context.Context, setting url.Values)) *MockClientInterface_SetSetting_Call {
+func (_c *MockClientInterface_UninstallPlugin_Call) Run(run func(ctx context.Context, key string)) *MockClientInterface_UninstallPlugin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 url.Values
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(url.Values)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -2979,12 +4483,12 @@ func (_c *MockClientInterface_SetSetting_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockClientInterface_SetSetting_Call) Return(err error) *MockClientInterface_SetSetting_Call {
+func (_c *MockClientInterface_UninstallPlugin_Call) Return(err error) *MockClientInterface_UninstallPlugin_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClientInterface_SetSetting_Call) RunAndReturn(run func(ctx context.Context, setting url.Values) error) *MockClientInterface_SetSetting_Call {
+func (_c *MockClientInterface_UninstallPlugin_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockClientInterface_UninstallPlugin_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3109,6 +4613,63 @@ func (_c *MockClientInterface_UpdatePermissionTemplate_Call) RunAndReturn(run fu
 	return _c
 }
 
+// UpdatePlugin provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) UpdatePlugin(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePlugin")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClientInterface_UpdatePlugin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePlugin'
+type MockClientInterface_UpdatePlugin_Call struct {
+	*mock.Call
+}
+
+// UpdatePlugin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockClientInterface_Expecter) UpdatePlugin(ctx interface{}, key interface{}) *MockClientInterface_UpdatePlugin_Call {
+	return &MockClientInterface_UpdatePlugin_Call{Call: _e.mock.On("UpdatePlugin", ctx, key)}
+}
+
+func (_c *MockClientInterface_UpdatePlugin_Call) Run(run func(ctx context.Context, key string)) *MockClientInterface_UpdatePlugin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_UpdatePlugin_Call) Return(err error) *MockClientInterface_UpdatePlugin_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClientInterface_UpdatePlugin_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockClientInterface_UpdatePlugin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateProject provides a mock function for the type MockClientInterface
 func (_mock *MockClientInterface) UpdateProject(ctx context.Context, project *sonar.Project) error {
 	ret := _mock.Called(ctx, project)
@@ -3279,3 +4840,261 @@ func (_c *MockClientInterface_UpdateUser_Call) RunAndReturn(run func(ctx context
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateWebhook provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) UpdateWebhook(ctx context.Context, webhook *sonar.Webhook) error {
+	ret := _mock.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sonar.Webhook) error); ok {
+		r0 = returnFunc(ctx, webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClientInterface_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type MockClientInterface_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *sonar.Webhook
+func (_e *MockClientInterface_Expecter) UpdateWebhook(ctx interface{}, webhook interface{}) *MockClientInterface_UpdateWebhook_Call {
+	return &MockClientInterface_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", ctx, webhook)}
+}
+
+func (_c *MockClientInterface_UpdateWebhook_Call) Run(run func(ctx context.Context, webhook *sonar.Webhook)) *MockClientInterface_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sonar.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(*sonar.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_UpdateWebhook_Call) Return(err error) *MockClientInterface_UpdateWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClientInterface_UpdateWebhook_Call) RunAndReturn(run func(ctx context.Context, webhook *sonar.Webhook) error) *MockClientInterface_UpdateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateCredentials provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) ValidateCredentials(ctx context.Context, user string, password string) (bool, error) {
+	ret := _mock.Called(ctx, user, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateCredentials")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, user, password)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, user, password)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, user, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_ValidateCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateCredentials'
+type MockClientInterface_ValidateCredentials_Call struct {
+	*mock.Call
+}
+
+// ValidateCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user string
+//   - password string
+func (_e *MockClientInterface_Expecter) ValidateCredentials(ctx interface{}, user interface{}, password interface{}) *MockClientInterface_ValidateCredentials_Call {
+	return &MockClientInterface_ValidateCredentials_Call{Call: _e.mock.On("ValidateCredentials", ctx, user, password)}
+}
+
+func (_c *MockClientInterface_ValidateCredentials_Call) Run(run func(ctx context.Context, user string, password string)) *MockClientInterface_ValidateCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_ValidateCredentials_Call) Return(b bool, err error) *MockClientInterface_ValidateCredentials_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockClientInterface_ValidateCredentials_Call) RunAndReturn(run func(ctx context.Context, user string, password string) (bool, error)) *MockClientInterface_ValidateCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateToken provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) ValidateToken(ctx context.Context, token string) (bool, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateToken")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientInterface_ValidateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateToken'
+type MockClientInterface_ValidateToken_Call struct {
+	*mock.Call
+}
+
+// ValidateToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockClientInterface_Expecter) ValidateToken(ctx interface{}, token interface{}) *MockClientInterface_ValidateToken_Call {
+	return &MockClientInterface_ValidateToken_Call{Call: _e.mock.On("ValidateToken", ctx, token)}
+}
+
+func (_c *MockClientInterface_ValidateToken_Call) Run(run func(ctx context.Context, token string)) *MockClientInterface_ValidateToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_ValidateToken_Call) Return(b bool, err error) *MockClientInterface_ValidateToken_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockClientInterface_ValidateToken_Call) RunAndReturn(run func(ctx context.Context, token string) (bool, error)) *MockClientInterface_ValidateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitForStatusIsUp provides a mock function for the type MockClientInterface
+func (_mock *MockClientInterface) WaitForStatusIsUp(ctx context.Context, retryCount int, timeout time.Duration) error {
+	ret := _mock.Called(ctx, retryCount, timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForStatusIsUp")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Duration) error); ok {
+		r0 = returnFunc(ctx, retryCount, timeout)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClientInterface_WaitForStatusIsUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForStatusIsUp'
+type MockClientInterface_WaitForStatusIsUp_Call struct {
+	*mock.Call
+}
+
+// WaitForStatusIsUp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retryCount int
+//   - timeout time.Duration
+func (_e *MockClientInterface_Expecter) WaitForStatusIsUp(ctx interface{}, retryCount interface{}, timeout interface{}) *MockClientInterface_WaitForStatusIsUp_Call {
+	return &MockClientInterface_WaitForStatusIsUp_Call{Call: _e.mock.On("WaitForStatusIsUp", ctx, retryCount, timeout)}
+}
+
+func (_c *MockClientInterface_WaitForStatusIsUp_Call) Run(run func(ctx context.Context, retryCount int, timeout time.Duration)) *MockClientInterface_WaitForStatusIsUp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientInterface_WaitForStatusIsUp_Call) Return(err error) *MockClientInterface_WaitForStatusIsUp_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClientInterface_WaitForStatusIsUp_Call) RunAndReturn(run func(ctx context.Context, retryCount int, timeout time.Duration) error) *MockClientInterface_WaitForStatusIsUp_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,158 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockLicenseClient creates a new instance of MockLicenseClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLicenseClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLicenseClient {
+	mock := &MockLicenseClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLicenseClient is an autogenerated mock type for the LicenseClient type
+type MockLicenseClient struct {
+	mock.Mock
+}
+
+type MockLicenseClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLicenseClient) EXPECT() *MockLicenseClient_Expecter {
+	return &MockLicenseClient_Expecter{mock: &_m.Mock}
+}
+
+// GetLicense provides a mock function for the type MockLicenseClient
+func (_mock *MockLicenseClient) GetLicense(ctx context.Context) (*sonar.License, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLicense")
+	}
+
+	var r0 *sonar.License
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*sonar.License, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *sonar.License); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.License)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLicenseClient_GetLicense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLicense'
+type MockLicenseClient_GetLicense_Call struct {
+	*mock.Call
+}
+
+// GetLicense is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockLicenseClient_Expecter) GetLicense(ctx interface{}) *MockLicenseClient_GetLicense_Call {
+	return &MockLicenseClient_GetLicense_Call{Call: _e.mock.On("GetLicense", ctx)}
+}
+
+func (_c *MockLicenseClient_GetLicense_Call) Run(run func(ctx context.Context)) *MockLicenseClient_GetLicense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLicenseClient_GetLicense_Call) Return(license *sonar.License, err error) *MockLicenseClient_GetLicense_Call {
+	_c.Call.Return(license, err)
+	return _c
+}
+
+func (_c *MockLicenseClient_GetLicense_Call) RunAndReturn(run func(ctx context.Context) (*sonar.License, error)) *MockLicenseClient_GetLicense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLicense provides a mock function for the type MockLicenseClient
+func (_mock *MockLicenseClient) SetLicense(ctx context.Context, license string) error {
+	ret := _mock.Called(ctx, license)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLicense")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, license)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLicenseClient_SetLicense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLicense'
+type MockLicenseClient_SetLicense_Call struct {
+	*mock.Call
+}
+
+// SetLicense is a helper method to define mock.On call
+//   - ctx context.Context
+//   - license string
+func (_e *MockLicenseClient_Expecter) SetLicense(ctx interface{}, license interface{}) *MockLicenseClient_SetLicense_Call {
+	return &MockLicenseClient_SetLicense_Call{Call: _e.mock.On("SetLicense", ctx, license)}
+}
+
+func (_c *MockLicenseClient_SetLicense_Call) Run(run func(ctx context.Context, license string)) *MockLicenseClient_SetLicense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLicenseClient_SetLicense_Call) Return(err error) *MockLicenseClient_SetLicense_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLicenseClient_SetLicense_Call) RunAndReturn(run func(ctx context.Context, license string) error) *MockLicenseClient_SetLicense_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,178 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMeasureClient creates a new instance of MockMeasureClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMeasureClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMeasureClient {
+	mock := &MockMeasureClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMeasureClient is an autogenerated mock type for the MeasureClient type
+type MockMeasureClient struct {
+	mock.Mock
+}
+
+type MockMeasureClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMeasureClient) EXPECT() *MockMeasureClient_Expecter {
+	return &MockMeasureClient_Expecter{mock: &_m.Mock}
+}
+
+// GetProjectMeasures provides a mock function for the type MockMeasureClient
+func (_mock *MockMeasureClient) GetProjectMeasures(ctx context.Context, projectKey string, metricKeys []string) (map[string]float64, error) {
+	ret := _mock.Called(ctx, projectKey, metricKeys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectMeasures")
+	}
+
+	var r0 map[string]float64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) (map[string]float64, error)); ok {
+		return returnFunc(ctx, projectKey, metricKeys)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) map[string]float64); ok {
+		r0 = returnFunc(ctx, projectKey, metricKeys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]float64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, projectKey, metricKeys)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMeasureClient_GetProjectMeasures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectMeasures'
+type MockMeasureClient_GetProjectMeasures_Call struct {
+	*mock.Call
+}
+
+// GetProjectMeasures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectKey string
+//   - metricKeys []string
+func (_e *MockMeasureClient_Expecter) GetProjectMeasures(ctx interface{}, projectKey interface{}, metricKeys interface{}) *MockMeasureClient_GetProjectMeasures_Call {
+	return &MockMeasureClient_GetProjectMeasures_Call{Call: _e.mock.On("GetProjectMeasures", ctx, projectKey, metricKeys)}
+}
+
+func (_c *MockMeasureClient_GetProjectMeasures_Call) Run(run func(ctx context.Context, projectKey string, metricKeys []string)) *MockMeasureClient_GetProjectMeasures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMeasureClient_GetProjectMeasures_Call) Return(stringToFloat64 map[string]float64, err error) *MockMeasureClient_GetProjectMeasures_Call {
+	_c.Call.Return(stringToFloat64, err)
+	return _c
+}
+
+func (_c *MockMeasureClient_GetProjectMeasures_Call) RunAndReturn(run func(ctx context.Context, projectKey string, metricKeys []string) (map[string]float64, error)) *MockMeasureClient_GetProjectMeasures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectQualityGateStatus provides a mock function for the type MockMeasureClient
+func (_mock *MockMeasureClient) GetProjectQualityGateStatus(ctx context.Context, projectKey string) (string, error) {
+	ret := _mock.Called(ctx, projectKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectQualityGateStatus")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, projectKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, projectKey)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, projectKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMeasureClient_GetProjectQualityGateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectQualityGateStatus'
+type MockMeasureClient_GetProjectQualityGateStatus_Call struct {
+	*mock.Call
+}
+
+// GetProjectQualityGateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectKey string
+func (_e *MockMeasureClient_Expecter) GetProjectQualityGateStatus(ctx interface{}, projectKey interface{}) *MockMeasureClient_GetProjectQualityGateStatus_Call {
+	return &MockMeasureClient_GetProjectQualityGateStatus_Call{Call: _e.mock.On("GetProjectQualityGateStatus", ctx, projectKey)}
+}
+
+func (_c *MockMeasureClient_GetProjectQualityGateStatus_Call) Run(run func(ctx context.Context, projectKey string)) *MockMeasureClient_GetProjectQualityGateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMeasureClient_GetProjectQualityGateStatus_Call) Return(s string, err error) *MockMeasureClient_GetProjectQualityGateStatus_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockMeasureClient_GetProjectQualityGateStatus_Call) RunAndReturn(run func(ctx context.Context, projectKey string) (string, error)) *MockMeasureClient_GetProjectQualityGateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOrganizationClient creates a new instance of MockOrganizationClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrganizationClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrganizationClient {
+	mock := &MockOrganizationClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOrganizationClient is an autogenerated mock type for the OrganizationClient type
+type MockOrganizationClient struct {
+	mock.Mock
+}
+
+type MockOrganizationClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrganizationClient) EXPECT() *MockOrganizationClient_Expecter {
+	return &MockOrganizationClient_Expecter{mock: &_m.Mock}
+}
+
+// GetOrganization provides a mock function for the type MockOrganizationClient
+func (_mock *MockOrganizationClient) GetOrganization(ctx context.Context, key string) (*sonar.Organization, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganization")
+	}
+
+	var r0 *sonar.Organization
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*sonar.Organization, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *sonar.Organization); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.Organization)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrganizationClient_GetOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganization'
+type MockOrganizationClient_GetOrganization_Call struct {
+	*mock.Call
+}
+
+// GetOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockOrganizationClient_Expecter) GetOrganization(ctx interface{}, key interface{}) *MockOrganizationClient_GetOrganization_Call {
+	return &MockOrganizationClient_GetOrganization_Call{Call: _e.mock.On("GetOrganization", ctx, key)}
+}
+
+func (_c *MockOrganizationClient_GetOrganization_Call) Run(run func(ctx context.Context, key string)) *MockOrganizationClient_GetOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrganizationClient_GetOrganization_Call) Return(organization *sonar.Organization, err error) *MockOrganizationClient_GetOrganization_Call {
+	_c.Call.Return(organization, err)
+	return _c
+}
+
+func (_c *MockOrganizationClient_GetOrganization_Call) RunAndReturn(run func(ctx context.Context, key string) (*sonar.Organization, error)) *MockOrganizationClient_GetOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,458 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockPluginClient creates a new instance of MockPluginClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPluginClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPluginClient {
+	mock := &MockPluginClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPluginClient is an autogenerated mock type for the PluginClient type
+type MockPluginClient struct {
+	mock.Mock
+}
+
+type MockPluginClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPluginClient) EXPECT() *MockPluginClient_Expecter {
+	return &MockPluginClient_Expecter{mock: &_m.Mock}
+}
+
+// GetAvailablePlugins provides a mock function for the type MockPluginClient
+func (_mock *MockPluginClient) GetAvailablePlugins(ctx context.Context) ([]sonar.AvailablePlugin, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailablePlugins")
+	}
+
+	var r0 []sonar.AvailablePlugin
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]sonar.AvailablePlugin, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []sonar.AvailablePlugin); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sonar.AvailablePlugin)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPluginClient_GetAvailablePlugins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailablePlugins'
+type MockPluginClient_GetAvailablePlugins_Call struct {
+	*mock.Call
+}
+
+// GetAvailablePlugins is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPluginClient_Expecter) GetAvailablePlugins(ctx interface{}) *MockPluginClient_GetAvailablePlugins_Call {
+	return &MockPluginClient_GetAvailablePlugins_Call{Call: _e.mock.On("GetAvailablePlugins", ctx)}
+}
+
+func (_c *MockPluginClient_GetAvailablePlugins_Call) Run(run func(ctx context.Context)) *MockPluginClient_GetAvailablePlugins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPluginClient_GetAvailablePlugins_Call) Return(availablePlugins []sonar.AvailablePlugin, err error) *MockPluginClient_GetAvailablePlugins_Call {
+	_c.Call.Return(availablePlugins, err)
+	return _c
+}
+
+func (_c *MockPluginClient_GetAvailablePlugins_Call) RunAndReturn(run func(ctx context.Context) ([]sonar.AvailablePlugin, error)) *MockPluginClient_GetAvailablePlugins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingPlugins provides a mock function for the type MockPluginClient
+func (_mock *MockPluginClient) GetPendingPlugins(ctx context.Context) (*sonar.PendingPlugins, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingPlugins")
+	}
+
+	var r0 *sonar.PendingPlugins
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*sonar.PendingPlugins, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *sonar.PendingPlugins); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.PendingPlugins)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPluginClient_GetPendingPlugins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingPlugins'
+type MockPluginClient_GetPendingPlugins_Call struct {
+	*mock.Call
+}
+
+// GetPendingPlugins is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPluginClient_Expecter) GetPendingPlugins(ctx interface{}) *MockPluginClient_GetPendingPlugins_Call {
+	return &MockPluginClient_GetPendingPlugins_Call{Call: _e.mock.On("GetPendingPlugins", ctx)}
+}
+
+func (_c *MockPluginClient_GetPendingPlugins_Call) Run(run func(ctx context.Context)) *MockPluginClient_GetPendingPlugins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPluginClient_GetPendingPlugins_Call) Return(pendingPlugins *sonar.PendingPlugins, err error) *MockPluginClient_GetPendingPlugins_Call {
+	_c.Call.Return(pendingPlugins, err)
+	return _c
+}
+
+func (_c *MockPluginClient_GetPendingPlugins_Call) RunAndReturn(run func(ctx context.Context) (*sonar.PendingPlugins, error)) *MockPluginClient_GetPendingPlugins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPluginUpdates provides a mock function for the type MockPluginClient
+func (_mock *MockPluginClient) GetPluginUpdates(ctx context.Context) ([]sonar.UpdatablePlugin, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPluginUpdates")
+	}
+
+	var r0 []sonar.UpdatablePlugin
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]sonar.UpdatablePlugin, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []sonar.UpdatablePlugin); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sonar.UpdatablePlugin)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPluginClient_GetPluginUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPluginUpdates'
+type MockPluginClient_GetPluginUpdates_Call struct {
+	*mock.Call
+}
+
+// GetPluginUpdates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPluginClient_Expecter) GetPluginUpdates(ctx interface{}) *MockPluginClient_GetPluginUpdates_Call {
+	return &MockPluginClient_GetPluginUpdates_Call{Call: _e.mock.On("GetPluginUpdates", ctx)}
+}
+
+func (_c *MockPluginClient_GetPluginUpdates_Call) Run(run func(ctx context.Context)) *MockPluginClient_GetPluginUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPluginClient_GetPluginUpdates_Call) Return(updatablePlugins []sonar.UpdatablePlugin, err error) *MockPluginClient_GetPluginUpdates_Call {
+	_c.Call.Return(updatablePlugins, err)
+	return _c
+}
+
+func (_c *MockPluginClient_GetPluginUpdates_Call) RunAndReturn(run func(ctx context.Context) ([]sonar.UpdatablePlugin, error)) *MockPluginClient_GetPluginUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPlugins provides a mock function for the type MockPluginClient
+func (_mock *MockPluginClient) GetPlugins(ctx context.Context) ([]sonar.Plugin, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlugins")
+	}
+
+	var r0 []sonar.Plugin
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]sonar.Plugin, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []sonar.Plugin); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sonar.Plugin)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPluginClient_GetPlugins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlugins'
+type MockPluginClient_GetPlugins_Call struct {
+	*mock.Call
+}
+
+// GetPlugins is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPluginClient_Expecter) GetPlugins(ctx interface{}) *MockPluginClient_GetPlugins_Call {
+	return &MockPluginClient_GetPlugins_Call{Call: _e.mock.On("GetPlugins", ctx)}
+}
+
+func (_c *MockPluginClient_GetPlugins_Call) Run(run func(ctx context.Context)) *MockPluginClient_GetPlugins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPluginClient_GetPlugins_Call) Return(plugins []sonar.Plugin, err error) *MockPluginClient_GetPlugins_Call {
+	_c.Call.Return(plugins, err)
+	return _c
+}
+
+func (_c *MockPluginClient_GetPlugins_Call) RunAndReturn(run func(ctx context.Context) ([]sonar.Plugin, error)) *MockPluginClient_GetPlugins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstallPlugin provides a mock function for the type MockPluginClient
+func (_mock *MockPluginClient) InstallPlugin(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstallPlugin")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPluginClient_InstallPlugin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstallPlugin'
+type MockPluginClient_InstallPlugin_Call struct {
+	*mock.Call
+}
+
+// InstallPlugin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockPluginClient_Expecter) InstallPlugin(ctx interface{}, key interface{}) *MockPluginClient_InstallPlugin_Call {
+	return &MockPluginClient_InstallPlugin_Call{Call: _e.mock.On("InstallPlugin", ctx, key)}
+}
+
+func (_c *MockPluginClient_InstallPlugin_Call) Run(run func(ctx context.Context, key string)) *MockPluginClient_InstallPlugin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPluginClient_InstallPlugin_Call) Return(err error) *MockPluginClient_InstallPlugin_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPluginClient_InstallPlugin_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockPluginClient_InstallPlugin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UninstallPlugin provides a mock function for the type MockPluginClient
+func (_mock *MockPluginClient) UninstallPlugin(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UninstallPlugin")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPluginClient_UninstallPlugin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UninstallPlugin'
+type MockPluginClient_UninstallPlugin_Call struct {
+	*mock.Call
+}
+
+// UninstallPlugin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockPluginClient_Expecter) UninstallPlugin(ctx interface{}, key interface{}) *MockPluginClient_UninstallPlugin_Call {
+	return &MockPluginClient_UninstallPlugin_Call{Call: _e.mock.On("UninstallPlugin", ctx, key)}
+}
+
+func (_c *MockPluginClient_UninstallPlugin_Call) Run(run func(ctx context.Context, key string)) *MockPluginClient_UninstallPlugin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPluginClient_UninstallPlugin_Call) Return(err error) *MockPluginClient_UninstallPlugin_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPluginClient_UninstallPlugin_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockPluginClient_UninstallPlugin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePlugin provides a mock function for the type MockPluginClient
+func (_mock *MockPluginClient) UpdatePlugin(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePlugin")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPluginClient_UpdatePlugin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePlugin'
+type MockPluginClient_UpdatePlugin_Call struct {
+	*mock.Call
+}
+
+// UpdatePlugin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockPluginClient_Expecter) UpdatePlugin(ctx interface{}, key interface{}) *MockPluginClient_UpdatePlugin_Call {
+	return &MockPluginClient_UpdatePlugin_Call{Call: _e.mock.On("UpdatePlugin", ctx, key)}
+}
+
+func (_c *MockPluginClient_UpdatePlugin_Call) Run(run func(ctx context.Context, key string)) *MockPluginClient_UpdatePlugin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPluginClient_UpdatePlugin_Call) Return(err error) *MockPluginClient_UpdatePlugin_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPluginClient_UpdatePlugin_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockPluginClient_UpdatePlugin_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"net/url"
 
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,17 +39,91 @@ func (_m *MockSettings) EXPECT() *MockSettings_Expecter {
 	return &MockSettings_Expecter{mock: &_m.Mock}
 }
 
+// GetSettings provides a mock function for the type MockSettings
+func (_mock *MockSettings) GetSettings(ctx context.Context, component string, settingsKeys []string) ([]sonar.Setting, error) {
+	ret := _mock.Called(ctx, component, settingsKeys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSettings")
+	}
+
+	var r0 []sonar.Setting
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) ([]sonar.Setting, error)); ok {
+		return returnFunc(ctx, component, settingsKeys)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) []sonar.Setting); ok {
+		r0 = returnFunc(ctx, component, settingsKeys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sonar.Setting)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, component, settingsKeys)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSettings_GetSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSettings'
+type MockSettings_GetSettings_Call struct {
+	*mock.Call
+}
+
+// GetSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - component string
+//   - settingsKeys []string
+func (_e *MockSettings_Expecter) GetSettings(ctx interface{}, component interface{}, settingsKeys interface{}) *MockSettings_GetSettings_Call {
+	return &MockSettings_GetSettings_Call{Call: _e.mock.On("GetSettings", ctx, component, settingsKeys)}
+}
+
+func (_c *MockSettings_GetSettings_Call) Run(run func(ctx context.Context, component string, settingsKeys []string)) *MockSettings_GetSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSettings_GetSettings_Call) Return(settings []sonar.Setting, err error) *MockSettings_GetSettings_Call {
+	_c.Call.Return(settings, err)
+	return _c
+}
+
+func (_c *MockSettings_GetSettings_Call) RunAndReturn(run func(ctx context.Context, component string, settingsKeys []string) ([]sonar.Setting, error)) *MockSettings_GetSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResetSettings provides a mock function for the type MockSettings
-func (_mock *MockSettings) ResetSettings(ctx context.Context, settingsKeys []string) error {
-	ret := _mock.Called(ctx, settingsKeys)
+func (_mock *MockSettings) ResetSettings(ctx context.Context, component string, settingsKeys []string) error {
+	ret := _mock.Called(ctx, component, settingsKeys)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ResetSettings")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) error); ok {
-		r0 = returnFunc(ctx, settingsKeys)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, component, settingsKeys)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -62,24 +137,30 @@ type MockSettings_ResetSettings_Call struct {
 
 // ResetSettings is a helper method to define mock.On call
 //   - ctx context.Context
+//   - component string
 //   - settingsKeys []string
-func (_e *MockSettings_Expecter) ResetSettings(ctx interface{}, settingsKeys interface{}) *MockSettings_ResetSettings_Call {
-	return &MockSettings_ResetSettings_Call{Call: _e.mock.On("ResetSettings", ctx, settingsKeys)}
+func (_e *MockSettings_Expecter) ResetSettings(ctx interface{}, component interface{}, settingsKeys interface{}) *MockSettings_ResetSettings_Call {
+	return &MockSettings_ResetSettings_Call{Call: _e.mock.On("ResetSettings", ctx, component, settingsKeys)}
 }
 
-func (_c *MockSettings_ResetSettings_Call) Run(run func(ctx context.Context, settingsKeys []string)) *MockSettings_ResetSettings_Call {
+func (_c *MockSettings_ResetSettings_Call) Run(run func(ctx context.Context, component string, settingsKeys []string)) *MockSettings_ResetSettings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []string
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].([]string)
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -90,7 +171,7 @@ func (_c *MockSettings_ResetSettings_Call) Return(err error) *MockSettings_Reset
 	return _c
 }
 
-func (_c *MockSettings_ResetSettings_Call) RunAndReturn(run func(ctx context.Context, settingsKeys []string) error) *MockSettings_ResetSettings_Call {
+func (_c *MockSettings_ResetSettings_Call) RunAndReturn(run func(ctx context.Context, component string, settingsKeys []string) error) *MockSettings_ResetSettings_Call {
 	_c.Call.Return(run)
 	return _c
 }
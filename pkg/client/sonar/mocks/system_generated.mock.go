@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
 	mock "github.com/stretchr/testify/mock"
@@ -38,6 +39,66 @@ func (_m *MockSystem) EXPECT() *MockSystem_Expecter {
 	return &MockSystem_Expecter{mock: &_m.Mock}
 }
 
+// Edition provides a mock function for the type MockSystem
+func (_mock *MockSystem) Edition(ctx context.Context) (string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Edition")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSystem_Edition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Edition'
+type MockSystem_Edition_Call struct {
+	*mock.Call
+}
+
+// Edition is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSystem_Expecter) Edition(ctx interface{}) *MockSystem_Edition_Call {
+	return &MockSystem_Edition_Call{Call: _e.mock.On("Edition", ctx)}
+}
+
+func (_c *MockSystem_Edition_Call) Run(run func(ctx context.Context)) *MockSystem_Edition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSystem_Edition_Call) Return(s string, err error) *MockSystem_Edition_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockSystem_Edition_Call) RunAndReturn(run func(ctx context.Context) (string, error)) *MockSystem_Edition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Health provides a mock function for the type MockSystem
 func (_mock *MockSystem) Health(ctx context.Context) (*sonar.SystemHealth, error) {
 	ret := _mock.Called(ctx)
@@ -99,3 +160,172 @@ func (_c *MockSystem_Health_Call) RunAndReturn(run func(ctx context.Context) (*s
 	_c.Call.Return(run)
 	return _c
 }
+
+// Reboot provides a mock function for the type MockSystem
+func (_mock *MockSystem) Reboot() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reboot")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSystem_Reboot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reboot'
+type MockSystem_Reboot_Call struct {
+	*mock.Call
+}
+
+// Reboot is a helper method to define mock.On call
+func (_e *MockSystem_Expecter) Reboot() *MockSystem_Reboot_Call {
+	return &MockSystem_Reboot_Call{Call: _e.mock.On("Reboot")}
+}
+
+func (_c *MockSystem_Reboot_Call) Run(run func()) *MockSystem_Reboot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSystem_Reboot_Call) Return(err error) *MockSystem_Reboot_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSystem_Reboot_Call) RunAndReturn(run func() error) *MockSystem_Reboot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SystemStatus provides a mock function for the type MockSystem
+func (_mock *MockSystem) SystemStatus(ctx context.Context) (*sonar.SystemStatusResponse, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SystemStatus")
+	}
+
+	var r0 *sonar.SystemStatusResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*sonar.SystemStatusResponse, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *sonar.SystemStatusResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.SystemStatusResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSystem_SystemStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SystemStatus'
+type MockSystem_SystemStatus_Call struct {
+	*mock.Call
+}
+
+// SystemStatus is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSystem_Expecter) SystemStatus(ctx interface{}) *MockSystem_SystemStatus_Call {
+	return &MockSystem_SystemStatus_Call{Call: _e.mock.On("SystemStatus", ctx)}
+}
+
+func (_c *MockSystem_SystemStatus_Call) Run(run func(ctx context.Context)) *MockSystem_SystemStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSystem_SystemStatus_Call) Return(systemStatusResponse *sonar.SystemStatusResponse, err error) *MockSystem_SystemStatus_Call {
+	_c.Call.Return(systemStatusResponse, err)
+	return _c
+}
+
+func (_c *MockSystem_SystemStatus_Call) RunAndReturn(run func(ctx context.Context) (*sonar.SystemStatusResponse, error)) *MockSystem_SystemStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitForStatusIsUp provides a mock function for the type MockSystem
+func (_mock *MockSystem) WaitForStatusIsUp(ctx context.Context, retryCount int, timeout time.Duration) error {
+	ret := _mock.Called(ctx, retryCount, timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForStatusIsUp")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Duration) error); ok {
+		r0 = returnFunc(ctx, retryCount, timeout)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSystem_WaitForStatusIsUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForStatusIsUp'
+type MockSystem_WaitForStatusIsUp_Call struct {
+	*mock.Call
+}
+
+// WaitForStatusIsUp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retryCount int
+//   - timeout time.Duration
+func (_e *MockSystem_Expecter) WaitForStatusIsUp(ctx interface{}, retryCount interface{}, timeout interface{}) *MockSystem_WaitForStatusIsUp_Call {
+	return &MockSystem_WaitForStatusIsUp_Call{Call: _e.mock.On("WaitForStatusIsUp", ctx, retryCount, timeout)}
+}
+
+func (_c *MockSystem_WaitForStatusIsUp_Call) Run(run func(ctx context.Context, retryCount int, timeout time.Duration)) *MockSystem_WaitForStatusIsUp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSystem_WaitForStatusIsUp_Call) Return(err error) *MockSystem_WaitForStatusIsUp_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSystem_WaitForStatusIsUp_Call) RunAndReturn(run func(ctx context.Context, retryCount int, timeout time.Duration) error) *MockSystem_WaitForStatusIsUp_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,369 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWebhookClient creates a new instance of MockWebhookClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookClient {
+	mock := &MockWebhookClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookClient is an autogenerated mock type for the WebhookClient type
+type MockWebhookClient struct {
+	mock.Mock
+}
+
+type MockWebhookClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookClient) EXPECT() *MockWebhookClient_Expecter {
+	return &MockWebhookClient_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function for the type MockWebhookClient
+func (_mock *MockWebhookClient) CreateWebhook(ctx context.Context, projectKey string, webhook *sonar.Webhook) (*sonar.Webhook, error) {
+	ret := _mock.Called(ctx, projectKey, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 *sonar.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *sonar.Webhook) (*sonar.Webhook, error)); ok {
+		return returnFunc(ctx, projectKey, webhook)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *sonar.Webhook) *sonar.Webhook); ok {
+		r0 = returnFunc(ctx, projectKey, webhook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *sonar.Webhook) error); ok {
+		r1 = returnFunc(ctx, projectKey, webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookClient_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type MockWebhookClient_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectKey string
+//   - webhook *sonar.Webhook
+func (_e *MockWebhookClient_Expecter) CreateWebhook(ctx interface{}, projectKey interface{}, webhook interface{}) *MockWebhookClient_CreateWebhook_Call {
+	return &MockWebhookClient_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, projectKey, webhook)}
+}
+
+func (_c *MockWebhookClient_CreateWebhook_Call) Run(run func(ctx context.Context, projectKey string, webhook *sonar.Webhook)) *MockWebhookClient_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *sonar.Webhook
+		if args[2] != nil {
+			arg2 = args[2].(*sonar.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookClient_CreateWebhook_Call) Return(webhook *sonar.Webhook, err error) *MockWebhookClient_CreateWebhook_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *MockWebhookClient_CreateWebhook_Call) RunAndReturn(run func(ctx context.Context, projectKey string, webhook *sonar.Webhook) (*sonar.Webhook, error)) *MockWebhookClient_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function for the type MockWebhookClient
+func (_mock *MockWebhookClient) DeleteWebhook(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookClient_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockWebhookClient_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockWebhookClient_Expecter) DeleteWebhook(ctx interface{}, key interface{}) *MockWebhookClient_DeleteWebhook_Call {
+	return &MockWebhookClient_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, key)}
+}
+
+func (_c *MockWebhookClient_DeleteWebhook_Call) Run(run func(ctx context.Context, key string)) *MockWebhookClient_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookClient_DeleteWebhook_Call) Return(err error) *MockWebhookClient_DeleteWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookClient_DeleteWebhook_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockWebhookClient_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhook provides a mock function for the type MockWebhookClient
+func (_mock *MockWebhookClient) GetWebhook(ctx context.Context, key string, projectKey string) (*sonar.Webhook, error) {
+	ret := _mock.Called(ctx, key, projectKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhook")
+	}
+
+	var r0 *sonar.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*sonar.Webhook, error)); ok {
+		return returnFunc(ctx, key, projectKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *sonar.Webhook); ok {
+		r0 = returnFunc(ctx, key, projectKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sonar.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, key, projectKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookClient_GetWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhook'
+type MockWebhookClient_GetWebhook_Call struct {
+	*mock.Call
+}
+
+// GetWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - projectKey string
+func (_e *MockWebhookClient_Expecter) GetWebhook(ctx interface{}, key interface{}, projectKey interface{}) *MockWebhookClient_GetWebhook_Call {
+	return &MockWebhookClient_GetWebhook_Call{Call: _e.mock.On("GetWebhook", ctx, key, projectKey)}
+}
+
+func (_c *MockWebhookClient_GetWebhook_Call) Run(run func(ctx context.Context, key string, projectKey string)) *MockWebhookClient_GetWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookClient_GetWebhook_Call) Return(webhook *sonar.Webhook, err error) *MockWebhookClient_GetWebhook_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *MockWebhookClient_GetWebhook_Call) RunAndReturn(run func(ctx context.Context, key string, projectKey string) (*sonar.Webhook, error)) *MockWebhookClient_GetWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhooks provides a mock function for the type MockWebhookClient
+func (_mock *MockWebhookClient) GetWebhooks(ctx context.Context, projectKey string) ([]sonar.Webhook, error) {
+	ret := _mock.Called(ctx, projectKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooks")
+	}
+
+	var r0 []sonar.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]sonar.Webhook, error)); ok {
+		return returnFunc(ctx, projectKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []sonar.Webhook); ok {
+		r0 = returnFunc(ctx, projectKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sonar.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, projectKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookClient_GetWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooks'
+type MockWebhookClient_GetWebhooks_Call struct {
+	*mock.Call
+}
+
+// GetWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectKey string
+func (_e *MockWebhookClient_Expecter) GetWebhooks(ctx interface{}, projectKey interface{}) *MockWebhookClient_GetWebhooks_Call {
+	return &MockWebhookClient_GetWebhooks_Call{Call: _e.mock.On("GetWebhooks", ctx, projectKey)}
+}
+
+func (_c *MockWebhookClient_GetWebhooks_Call) Run(run func(ctx context.Context, projectKey string)) *MockWebhookClient_GetWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookClient_GetWebhooks_Call) Return(webhooks []sonar.Webhook, err error) *MockWebhookClient_GetWebhooks_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockWebhookClient_GetWebhooks_Call) RunAndReturn(run func(ctx context.Context, projectKey string) ([]sonar.Webhook, error)) *MockWebhookClient_GetWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhook provides a mock function for the type MockWebhookClient
+func (_mock *MockWebhookClient) UpdateWebhook(ctx context.Context, webhook *sonar.Webhook) error {
+	ret := _mock.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sonar.Webhook) error); ok {
+		r0 = returnFunc(ctx, webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookClient_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type MockWebhookClient_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *sonar.Webhook
+func (_e *MockWebhookClient_Expecter) UpdateWebhook(ctx interface{}, webhook interface{}) *MockWebhookClient_UpdateWebhook_Call {
+	return &MockWebhookClient_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", ctx, webhook)}
+}
+
+func (_c *MockWebhookClient_UpdateWebhook_Call) Run(run func(ctx context.Context, webhook *sonar.Webhook)) *MockWebhookClient_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sonar.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(*sonar.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookClient_UpdateWebhook_Call) Return(err error) *MockWebhookClient_UpdateWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookClient_UpdateWebhook_Call) RunAndReturn(run func(ctx context.Context, webhook *sonar.Webhook) error) *MockWebhookClient_UpdateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,40 @@
+package sonar
+
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ClientOption configures the sonar client.
+type ClientOption func(c *resty.Client)
+
+// WithTLSConfig sets the TLS configuration of the client transport.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *resty.Client) {
+		c.SetTLSClientConfig(config)
+	}
+}
+
+// WithProxy sets the HTTP proxy of the client transport.
+// Proxy credentials can be passed as the user info of the URL.
+func WithProxy(proxyURL string) ClientOption {
+	return func(c *resty.Client) {
+		c.SetProxy(proxyURL)
+	}
+}
+
+// WithHeaders sets the headers that are sent with every request.
+func WithHeaders(headers map[string]string) ClientOption {
+	return func(c *resty.Client) {
+		c.SetHeaders(headers)
+	}
+}
+
+// WithTimeout sets the timeout of a single request.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *resty.Client) {
+		c.SetTimeout(timeout)
+	}
+}
@@ -0,0 +1,49 @@
+package sonar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const organizationField = "organization"
+
+// Organization is the organization of SonarCloud.
+type Organization struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+type organizationSearchResponse struct {
+	Organizations []Organization `json:"organizations"`
+}
+
+// SetOrganization sets the organization that is sent with the requests of the organization-scoped API,
+// e.g. projects, quality gates, quality profiles, groups, permissions and webhooks.
+// SonarCloud requires the organization for these API calls, SonarQube ignores it.
+func (sc *Client) SetOrganization(organization string) {
+	sc.org = organization
+}
+
+// GetOrganization returns the organization with the given key.
+// https://sonarcloud.io/web_api/api/organizations/search
+func (sc *Client) GetOrganization(ctx context.Context, key string) (*Organization, error) {
+	var orgResponse organizationSearchResponse
+
+	rsp, err := sc.startRequest(ctx).
+		SetResult(&orgResponse).
+		SetQueryParam("organizations", key).
+		Get("/organizations/search")
+
+	if err = sc.checkError(rsp, err); err != nil {
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	for _, o := range orgResponse.Organizations {
+		if o.Key == key {
+			return &o, nil
+		}
+	}
+
+	return nil, NewHTTPError(http.StatusNotFound, fmt.Sprintf("organization %s not found", key))
+}
@@ -0,0 +1,274 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetOrganization(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		serverBody     string
+		want           *Organization
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "organization found",
+			serverResponse: http.StatusOK,
+			serverBody:     `{"organizations":[{"key":"my-org","name":"My Organization"}]}`,
+			want:           &Organization{Key: "my-org", Name: "My Organization"},
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "organization not found",
+			serverResponse: http.StatusOK,
+			serverBody:     `{"organizations":[]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.True(t, IsErrNotFound(err))
+			},
+		},
+		{
+			name:           "server error",
+			serverResponse: http.StatusInternalServerError,
+			serverBody:     `{"errors":[{"msg":"internal error"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get organization")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/organizations/search", r.URL.Path)
+				assert.Equal(t, "my-org", r.URL.Query().Get("organizations"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err := w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(server.Close)
+
+			c := NewClient(server.URL, "token", "")
+			got, err := c.GetOrganization(context.Background(), "my-org")
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_SetOrganization(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		call    func(ctx context.Context, c *Client) error
+		wantOrg string
+	}{
+		{
+			name: "create project",
+			call: func(ctx context.Context, c *Client) error {
+				return c.CreateProject(ctx, &Project{Key: "project", Name: "project"})
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "get project",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetProject(ctx, "project")
+				return err
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "update project",
+			call: func(ctx context.Context, c *Client) error {
+				return c.UpdateProject(ctx, &Project{Key: "project", Visibility: "private"})
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "delete project",
+			call: func(ctx context.Context, c *Client) error {
+				return c.DeleteProject(ctx, "project")
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "create quality gate",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.CreateQualityGate(ctx, "gate")
+				return err
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "delete quality gate",
+			call: func(ctx context.Context, c *Client) error {
+				return c.DeleteQualityGate(ctx, "gate")
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "create quality gate condition",
+			call: func(ctx context.Context, c *Client) error {
+				return c.CreateQualityGateCondition(ctx, "gate", QualityGateCondition{Metric: "coverage", OP: "LT", Error: "80"})
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "get quality profile",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetQualityProfile(ctx, "profile")
+				return err
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "set default quality profile",
+			call: func(ctx context.Context, c *Client) error {
+				return c.SetAsDefaultQualityProfile(ctx, "profile", "go")
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "get quality profile active rules",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetQualityProfileActiveRules(ctx, "profile-key")
+				return err
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "create group",
+			call: func(ctx context.Context, c *Client) error {
+				return c.CreateGroup(ctx, &Group{Name: "group"})
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "add user to group",
+			call: func(ctx context.Context, c *Client) error {
+				return c.AddUserToGroup(ctx, "user", "group")
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "create permission template",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.CreatePermissionTemplate(ctx, &PermissionTemplateData{Name: "template"})
+				return err
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "get permission template groups",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetPermissionTemplateGroups(ctx, "template-id")
+				return err
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "add permission to user",
+			call: func(ctx context.Context, c *Client) error {
+				return c.AddPermissionToUser(ctx, "user", "admin")
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "get group permissions",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetGroupPermissions(ctx, "group")
+				return err
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "health is not organization-scoped",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Health(ctx)
+				return err
+			},
+		},
+		{
+			name: "system status is not organization-scoped",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.SystemStatus(ctx)
+				return err
+			},
+		},
+		{
+			name: "get webhooks",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetWebhooks(ctx, "")
+				return err
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "create webhook",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.CreateWebhook(ctx, "", &Webhook{Name: "ci", URL: "https://ci.example.com"})
+				return err
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "update webhook",
+			call: func(ctx context.Context, c *Client) error {
+				return c.UpdateWebhook(ctx, &Webhook{Key: "AU-key", Name: "ci", URL: "https://ci.example.com"})
+			},
+			wantOrg: "my-org",
+		},
+		{
+			name: "delete webhook",
+			call: func(ctx context.Context, c *Client) error {
+				return c.DeleteWebhook(ctx, "AU-key")
+			},
+			wantOrg: "my-org",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+
+				assert.Equal(t, tt.wantOrg, r.URL.Query().Get("organization"), r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+				_, err := w.Write([]byte(`{}`))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(server.Close)
+
+			c := NewClient(server.URL, "token", "")
+			c.SetOrganization("my-org")
+
+			// only the sent requests are checked, the empty responses may be reported as errors.
+			_ = tt.call(context.Background(), c)
+
+			assert.Positive(t, requests.Load())
+		})
+	}
+}
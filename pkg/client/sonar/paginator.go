@@ -0,0 +1,104 @@
+package sonar
+
+import (
+	"context"
+	"strconv"
+)
+
+const (
+	// maxPageSize is the maximum page size of most sonar search endpoints.
+	maxPageSize = 500
+	// permissionsPageSize is the maximum page size of the permissions endpoints.
+	permissionsPageSize = 100
+)
+
+// pager defines the pagination params of a search endpoint.
+type pager struct {
+	pageParam string
+	sizeParam string
+	pageSize  int
+}
+
+var (
+	// v1Pager is used by the v1 Web API that returns the pagination in the paging field.
+	v1Pager = pager{pageParam: "p", sizeParam: "ps", pageSize: maxPageSize}
+	// v2Pager is used by the v2 Web API that returns the pagination in the page field.
+	v2Pager = pager{pageParam: "pageIndex", sizeParam: "pageSize", pageSize: maxPageSize}
+)
+
+// withPageSize returns the pager with the given page size.
+func (p pager) withPageSize(pageSize int) pager {
+	p.pageSize = pageSize
+
+	return p
+}
+
+// paging is the pagination of a search response.
+type paging struct {
+	PageIndex int `json:"pageIndex"`
+	PageSize  int `json:"pageSize"`
+	Total     int `json:"total"`
+}
+
+// pageInfo is embedded into the search responses to get the pagination.
+// The v1 Web API returns it in the paging field, the v2 Web API in the page field.
+// Some v1 endpoints, e.g. /api/rules/search of older sonar versions, return only the total field.
+type pageInfo struct {
+	Paging *paging `json:"paging,omitempty"`
+	Page   *paging `json:"page,omitempty"`
+	Total  *int    `json:"total,omitempty"`
+}
+
+// total returns the total number of items if the response contains the pagination.
+func (p pageInfo) total() (int, bool) {
+	switch {
+	case p.Paging != nil:
+		return p.Paging.Total, true
+	case p.Page != nil:
+		return p.Page.Total, true
+	case p.Total != nil:
+		return *p.Total, true
+	default:
+		return 0, false
+	}
+}
+
+// searchAll requests the pages of a search endpoint one by one and returns the items of all pages.
+// The fetch function requests a single page with the given pagination params.
+// The pages are requested until the total number of items is received,
+// or until a page is not full if the response has no pagination.
+func searchAll[T any](
+	ctx context.Context,
+	p pager,
+	fetch func(ctx context.Context, params map[string]string) ([]T, pageInfo, error),
+) ([]T, error) {
+	var all []T
+
+	for index := 1; ; index++ {
+		items, page, err := fetch(ctx, map[string]string{
+			p.pageParam: strconv.Itoa(index),
+			p.sizeParam: strconv.Itoa(p.pageSize),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, items...)
+
+		if len(items) == 0 {
+			return all, nil
+		}
+
+		if total, ok := page.total(); ok {
+			if len(all) >= total {
+				return all, nil
+			}
+
+			continue
+		}
+
+		if len(items) < p.pageSize {
+			return all, nil
+		}
+	}
+}
@@ -0,0 +1,373 @@
+package sonar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPagedServer returns a server that serves the items page by page like sonar search endpoints.
+// The page is written to the field of the response, the pagination is written to the paging field.
+func newPagedServer(
+	t *testing.T,
+	path string,
+	p pager,
+	field, pagingField string,
+	items []map[string]any,
+) (server *httptest.Server, requests *atomic.Int32) {
+	t.Helper()
+
+	requests = new(atomic.Int32)
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api"+path {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		requests.Add(1)
+
+		index, err := strconv.Atoi(r.URL.Query().Get(p.pageParam))
+		assert.NoError(t, err)
+
+		size, err := strconv.Atoi(r.URL.Query().Get(p.sizeParam))
+		assert.NoError(t, err)
+		assert.Equal(t, p.pageSize, size)
+
+		from := min((index-1)*size, len(items))
+		to := min(from+size, len(items))
+
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			field:       items[from:to],
+			pagingField: paging{PageIndex: index, PageSize: size, Total: len(items)},
+		}))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
+
+func TestSearchAll(t *testing.T) {
+	t.Parallel()
+
+	p := pager{pageParam: "p", sizeParam: "ps", pageSize: 10}
+
+	newFetch := func(total int, withPaging bool) func(
+		ctx context.Context,
+		params map[string]string,
+	) ([]int, pageInfo, error) {
+		return func(_ context.Context, params map[string]string) ([]int, pageInfo, error) {
+			index, _ := strconv.Atoi(params["p"])
+			size, _ := strconv.Atoi(params["ps"])
+
+			var items []int
+			for i := (index - 1) * size; i < min(index*size, total); i++ {
+				items = append(items, i)
+			}
+
+			if !withPaging {
+				return items, pageInfo{}, nil
+			}
+
+			return items, pageInfo{Paging: &paging{PageIndex: index, PageSize: size, Total: total}}, nil
+		}
+	}
+
+	tests := []struct {
+		name    string
+		fetch   func(ctx context.Context, params map[string]string) ([]int, pageInfo, error)
+		wantLen int
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:    "multiple pages with paging",
+			fetch:   newFetch(95, true),
+			wantLen: 95,
+			wantErr: require.NoError,
+		},
+		{
+			name:    "exactly full pages with paging",
+			fetch:   newFetch(30, true),
+			wantLen: 30,
+			wantErr: require.NoError,
+		},
+		{
+			name:    "multiple pages without paging",
+			fetch:   newFetch(42, false),
+			wantLen: 42,
+			wantErr: require.NoError,
+		},
+		{
+			name:    "exactly full pages without paging",
+			fetch:   newFetch(20, false),
+			wantLen: 20,
+			wantErr: require.NoError,
+		},
+		{
+			name:    "no items",
+			fetch:   newFetch(0, true),
+			wantLen: 0,
+			wantErr: require.NoError,
+		},
+		{
+			name: "total is greater than the number of items",
+			fetch: func(_ context.Context, params map[string]string) ([]int, pageInfo, error) {
+				if params["p"] == "1" {
+					return []int{1, 2, 3}, pageInfo{Paging: &paging{Total: 100}}, nil
+				}
+
+				return nil, pageInfo{Paging: &paging{Total: 100}}, nil
+			},
+			wantLen: 3,
+			wantErr: require.NoError,
+		},
+		{
+			name: "error on the second page",
+			fetch: func(ctx context.Context, params map[string]string) ([]int, pageInfo, error) {
+				if params["p"] == "2" {
+					return nil, pageInfo{}, errors.New("page fatal")
+				}
+
+				return newFetch(95, true)(ctx, params)
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "page fatal")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := searchAll(context.Background(), p, tt.fetch)
+
+			tt.wantErr(t, err)
+			assert.Len(t, got, tt.wantLen)
+		})
+	}
+}
+
+func TestClient_Pagination(t *testing.T) {
+	t.Parallel()
+
+	newItems := func(n int, item func(i int) map[string]any) []map[string]any {
+		items := make([]map[string]any, 0, n)
+		for i := 0; i < n; i++ {
+			items = append(items, item(i))
+		}
+
+		return items
+	}
+
+	tests := []struct {
+		name         string
+		path         string
+		pager        pager
+		field        string
+		pagingField  string
+		items        []map[string]any
+		capabilities *Capabilities
+		call         func(t *testing.T, c *Client) int
+		wantLen      int
+		wantRequests int
+	}{
+		{
+			name:        "quality profile active rules",
+			path:        "/rules/search",
+			pager:       v1Pager,
+			field:       "rules",
+			pagingField: "paging",
+			items: newItems(1234, func(i int) map[string]any {
+				return map[string]any{"key": fmt.Sprintf("go:S%d", i), "severity": "MAJOR"}
+			}),
+			call: func(t *testing.T, c *Client) int {
+				rules, err := c.GetQualityProfileActiveRules(context.Background(), "profile")
+				require.NoError(t, err)
+				assert.Equal(t, "go:S1233", rules[len(rules)-1].Key)
+
+				return len(rules)
+			},
+			wantLen:      1234,
+			wantRequests: 3,
+		},
+		{
+			name:        "users",
+			path:        "/users/search",
+			pager:       v1Pager,
+			field:       "users",
+			pagingField: "paging",
+			items: newItems(1001, func(i int) map[string]any {
+				return map[string]any{"login": fmt.Sprintf("user-%d", i)}
+			}),
+			call: func(t *testing.T, c *Client) int {
+				users, err := c.SearchUsers(context.Background(), "user")
+				require.NoError(t, err)
+
+				return len(users)
+			},
+			wantLen:      1001,
+			wantRequests: 3,
+		},
+		{
+			name:         "users v2",
+			path:         usersV2Path,
+			pager:        v2Pager,
+			field:        "users",
+			pagingField:  "page",
+			capabilities: &Capabilities{Version: Version{Major: 10, Minor: 5}},
+			items: newItems(750, func(i int) map[string]any {
+				return map[string]any{"id": strconv.Itoa(i), "login": fmt.Sprintf("user-%d", i)}
+			}),
+			call: func(t *testing.T, c *Client) int {
+				users, err := c.SearchUsers(context.Background(), "user")
+				require.NoError(t, err)
+
+				return len(users)
+			},
+			wantLen:      750,
+			wantRequests: 2,
+		},
+		{
+			name:        "groups",
+			path:        "/user_groups/search",
+			pager:       v1Pager,
+			field:       "groups",
+			pagingField: "paging",
+			items: newItems(2500, func(i int) map[string]any {
+				return map[string]any{"name": fmt.Sprintf("group-%d", i)}
+			}),
+			call: func(t *testing.T, c *Client) int {
+				group, err := c.GetGroup(context.Background(), "group-2499")
+				require.NoError(t, err)
+				assert.Equal(t, "group-2499", group.Name)
+
+				groups, err := c.SearchGroups(context.Background(), "group")
+				require.NoError(t, err)
+
+				return len(groups)
+			},
+			wantLen:      2500,
+			wantRequests: 10,
+		},
+		{
+			name:         "groups v2",
+			path:         groupsV2Path,
+			pager:        v2Pager,
+			field:        "groups",
+			pagingField:  "page",
+			capabilities: &Capabilities{Version: Version{Major: 10, Minor: 5}},
+			items: newItems(501, func(i int) map[string]any {
+				return map[string]any{"id": strconv.Itoa(i), "name": fmt.Sprintf("group-%d", i)}
+			}),
+			call: func(t *testing.T, c *Client) int {
+				groups, err := c.SearchGroups(context.Background(), "group")
+				require.NoError(t, err)
+
+				return len(groups)
+			},
+			wantLen:      501,
+			wantRequests: 2,
+		},
+		{
+			name:        "user groups",
+			path:        "/users/groups",
+			pager:       v1Pager,
+			field:       "groups",
+			pagingField: "paging",
+			items: newItems(600, func(i int) map[string]any {
+				return map[string]any{"name": fmt.Sprintf("group-%d", i)}
+			}),
+			call: func(t *testing.T, c *Client) int {
+				groups, err := c.GetUserGroups(context.Background(), "user")
+				require.NoError(t, err)
+
+				return len(groups)
+			},
+			wantLen:      600,
+			wantRequests: 2,
+		},
+		{
+			name:        "permission template groups",
+			path:        "/permissions/template_groups",
+			pager:       v1Pager.withPageSize(permissionsPageSize),
+			field:       "groups",
+			pagingField: "paging",
+			items: newItems(250, func(i int) map[string]any {
+				return map[string]any{"name": fmt.Sprintf("group-%d", i), "permissions": []string{"user"}}
+			}),
+			call: func(t *testing.T, c *Client) int {
+				groups, err := c.GetPermissionTemplateGroups(context.Background(), "template")
+				require.NoError(t, err)
+				assert.Equal(t, []string{"user"}, groups["group-249"])
+
+				return len(groups)
+			},
+			wantLen:      250,
+			wantRequests: 3,
+		},
+		{
+			name:        "user permissions",
+			path:        "/permissions/users",
+			pager:       v1Pager.withPageSize(permissionsPageSize),
+			field:       "users",
+			pagingField: "paging",
+			items: newItems(150, func(i int) map[string]any {
+				return map[string]any{"login": fmt.Sprintf("user-%d", i), "permissions": []string{"admin"}}
+			}),
+			call: func(t *testing.T, c *Client) int {
+				permissions, err := c.GetUserPermissions(context.Background(), "user-149")
+				require.NoError(t, err)
+
+				return len(permissions)
+			},
+			wantLen:      1,
+			wantRequests: 2,
+		},
+		{
+			name:        "group permissions",
+			path:        "/permissions/groups",
+			pager:       v1Pager.withPageSize(permissionsPageSize),
+			field:       "groups",
+			pagingField: "paging",
+			items: newItems(101, func(i int) map[string]any {
+				return map[string]any{"name": fmt.Sprintf("group-%d", i), "permissions": []string{"scan"}}
+			}),
+			call: func(t *testing.T, c *Client) int {
+				permissions, err := c.GetGroupPermissions(context.Background(), "group-100")
+				require.NoError(t, err)
+
+				return len(permissions)
+			},
+			wantLen:      1,
+			wantRequests: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, requests := newPagedServer(t, tt.path, tt.pager, tt.field, tt.pagingField, tt.items)
+
+			c := NewClient(server.URL, "admin", "admin")
+			c.capabilities = tt.capabilities
+
+			assert.Equal(t, tt.wantLen, tt.call(t, c))
+			assert.Equal(t, tt.wantRequests, int(requests.Load()))
+		})
+	}
+}
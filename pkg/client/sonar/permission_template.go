@@ -28,6 +28,7 @@ type PermissionTemplateGroup struct {
 
 type getPermissionGroupsResponse struct {
 	Groups []PermissionTemplateGroup `json:"groups"`
+	pageInfo
 }
 
 type createPermissionTemplateResponse struct {
@@ -41,18 +42,24 @@ type searchPermissionTemplatesResponse struct {
 	} `json:"defaultTemplates"`
 }
 
+type userPermissions struct {
+	Login       string   `json:"login"`
+	Permissions []string `json:"permissions"`
+}
+
 type getUserPermissionResponse struct {
-	Users []struct {
-		Login       string   `json:"login"`
-		Permissions []string `json:"permissions"`
-	} `json:"users"`
+	Users []userPermissions `json:"users"`
+	pageInfo
+}
+
+type groupPermissions struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
 }
 
 type getGroupPermissionResponse struct {
-	Groups []struct {
-		Name        string   `json:"name"`
-		Permissions []string `json:"permissions"`
-	} `json:"groups"`
+	Groups []groupPermissions `json:"groups"`
+	pageInfo
 }
 
 func (sc *Client) CreatePermissionTemplate(
@@ -60,7 +67,7 @@ func (sc *Client) CreatePermissionTemplate(
 	tpl *PermissionTemplateData,
 ) (*PermissionTemplate, error) {
 	var result createPermissionTemplateResponse
-	rsp, err := sc.startRequest(ctx).SetResult(&result).SetFormData(map[string]string{
+	rsp, err := sc.startOrgRequest(ctx).SetResult(&result).SetFormData(map[string]string{
 		"name":              tpl.Name,
 		"description":       tpl.Description,
 		"projectKeyPattern": tpl.ProjectKeyPattern,
@@ -74,7 +81,7 @@ func (sc *Client) CreatePermissionTemplate(
 }
 
 func (sc *Client) UpdatePermissionTemplate(ctx context.Context, tpl *PermissionTemplate) error {
-	rsp, err := sc.startRequest(ctx).SetFormData(map[string]string{
+	rsp, err := sc.startOrgRequest(ctx).SetFormData(map[string]string{
 		"id":                tpl.ID,
 		"name":              tpl.Name,
 		"description":       tpl.Description,
@@ -89,7 +96,7 @@ func (sc *Client) UpdatePermissionTemplate(ctx context.Context, tpl *PermissionT
 }
 
 func (sc *Client) DeletePermissionTemplate(ctx context.Context, id string) error {
-	rsp, err := sc.startRequest(ctx).SetFormData(map[string]string{
+	rsp, err := sc.startOrgRequest(ctx).SetFormData(map[string]string{
 		templateIdName: id,
 	}).Post("/permissions/delete_template")
 
@@ -106,7 +113,7 @@ func (sc *Client) searchPermissionTemplates(
 ) (*searchPermissionTemplatesResponse, error) {
 	var result searchPermissionTemplatesResponse
 
-	rsp, err := sc.startRequest(ctx).SetQueryParam("q", name).SetResult(&result).
+	rsp, err := sc.startOrgRequest(ctx).SetQueryParam("q", name).SetResult(&result).
 		Get("/permissions/search_templates")
 	if err = sc.checkError(rsp, err); err != nil {
 		return nil, fmt.Errorf("failed to search for permission templates: %w", err)
@@ -138,7 +145,7 @@ func (sc *Client) GetPermissionTemplate(ctx context.Context, name string) (*Perm
 }
 
 func (sc *Client) AddGroupToPermissionTemplate(ctx context.Context, templateID, groupName, permission string) error {
-	rsp, err := sc.startRequest(ctx).SetFormData(map[string]string{
+	rsp, err := sc.startOrgRequest(ctx).SetFormData(map[string]string{
 		templateIdName: templateID,
 		"groupName":    groupName,
 		"permission":   permission,
@@ -154,21 +161,27 @@ func (sc *Client) AddGroupToPermissionTemplate(ctx context.Context, templateID,
 // GetPermissionTemplateGroups returns map where key is group name and value is list of permissions.
 // Warning: this is a sonar internal endpoint, which may be changed in future versions.
 func (sc *Client) GetPermissionTemplateGroups(ctx context.Context, templateID string) (map[string][]string, error) {
-	var response getPermissionGroupsResponse
-	rsp, err := sc.startRequest(ctx).
-		SetResult(&response).
-		SetQueryParams(map[string]string{
-			"templateId": templateID,
-			"ps":         "100",
-		}).
-		Get("/permissions/template_groups")
+	groups, err := searchAll(ctx, v1Pager.withPageSize(permissionsPageSize),
+		func(ctx context.Context, params map[string]string) ([]PermissionTemplateGroup, pageInfo, error) {
+			var response getPermissionGroupsResponse
+			rsp, err := sc.startOrgRequest(ctx).
+				SetResult(&response).
+				SetQueryParam("templateId", templateID).
+				SetQueryParams(params).
+				Get("/permissions/template_groups")
+
+			if err = sc.checkError(rsp, err); err != nil {
+				return nil, pageInfo{}, err
+			}
 
-	if err = sc.checkError(rsp, err); err != nil {
+			return response.Groups, response.pageInfo, nil
+		})
+	if err != nil {
 		return nil, fmt.Errorf("failed to get permission template groups: %w", err)
 	}
 
-	result := make(map[string][]string, len(response.Groups))
-	for _, g := range response.Groups {
+	result := make(map[string][]string, len(groups))
+	for _, g := range groups {
 		result[g.GroupName] = g.Permissions
 	}
 
@@ -179,7 +192,7 @@ func (sc *Client) RemoveGroupFromPermissionTemplate(
 	ctx context.Context,
 	templateID, groupName, permission string,
 ) error {
-	rsp, err := sc.startRequest(ctx).
+	rsp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			templateIdName: templateID,
 			"groupName":    groupName,
@@ -195,7 +208,7 @@ func (sc *Client) RemoveGroupFromPermissionTemplate(
 }
 
 func (sc *Client) SetDefaultPermissionTemplate(ctx context.Context, name string) error {
-	rsp, err := sc.startRequest(ctx).
+	rsp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			"templateName": name,
 		}).
@@ -212,20 +225,26 @@ func (sc *Client) SetDefaultPermissionTemplate(ctx context.Context, name string)
 // Warning: this is a sonar internal endpoint, which may be changed in future versions.
 // nolint:dupl // this has a lot of common code with GetGroupPermissions, but it's not worth to extract it
 func (sc *Client) GetUserPermissions(ctx context.Context, userLogin string) ([]string, error) {
-	response := getUserPermissionResponse{}
-	rsp, err := sc.startRequest(ctx).
-		SetResult(&response).
-		SetQueryParams(map[string]string{
-			"q":  userLogin,
-			"ps": "100",
-		}).
-		Get("/permissions/users")
+	users, err := searchAll(ctx, v1Pager.withPageSize(permissionsPageSize),
+		func(ctx context.Context, params map[string]string) ([]userPermissions, pageInfo, error) {
+			response := getUserPermissionResponse{}
+			rsp, err := sc.startOrgRequest(ctx).
+				SetResult(&response).
+				SetQueryParam("q", userLogin).
+				SetQueryParams(params).
+				Get("/permissions/users")
+
+			if err = sc.checkError(rsp, err); err != nil {
+				return nil, pageInfo{}, err
+			}
 
-	if err = sc.checkError(rsp, err); err != nil {
+			return response.Users, response.pageInfo, nil
+		})
+	if err != nil {
 		return nil, fmt.Errorf("failed to get user %s permission: %w", userLogin, err)
 	}
 
-	for _, u := range response.Users {
+	for _, u := range users {
 		if u.Login == userLogin {
 			return u.Permissions, nil
 		}
@@ -236,7 +255,7 @@ func (sc *Client) GetUserPermissions(ctx context.Context, userLogin string) ([]s
 
 // AddPermissionToUser adds permission to user.
 func (sc *Client) AddPermissionToUser(ctx context.Context, userLogin, permission string) error {
-	rsp, err := sc.startRequest(ctx).
+	rsp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			"login":      userLogin,
 			"permission": permission,
@@ -252,7 +271,7 @@ func (sc *Client) AddPermissionToUser(ctx context.Context, userLogin, permission
 
 // RemovePermissionFromUser removes permission from user.
 func (sc *Client) RemovePermissionFromUser(ctx context.Context, userLogin, permission string) error {
-	rsp, err := sc.startRequest(ctx).
+	rsp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			"login":      userLogin,
 			"permission": permission,
@@ -270,20 +289,26 @@ func (sc *Client) RemovePermissionFromUser(ctx context.Context, userLogin, permi
 // Warning: this is a sonar internal endpoint, which may be changed in future versions.
 // nolint:dupl // this has a lot of common code with GetUserPermissions, but it's not worth to extract it
 func (sc *Client) GetGroupPermissions(ctx context.Context, groupName string) ([]string, error) {
-	response := getGroupPermissionResponse{}
-	rsp, err := sc.startRequest(ctx).
-		SetResult(&response).
-		SetQueryParams(map[string]string{
-			"q":  groupName,
-			"ps": "100",
-		}).
-		Get("/permissions/groups")
+	groups, err := searchAll(ctx, v1Pager.withPageSize(permissionsPageSize),
+		func(ctx context.Context, params map[string]string) ([]groupPermissions, pageInfo, error) {
+			response := getGroupPermissionResponse{}
+			rsp, err := sc.startOrgRequest(ctx).
+				SetResult(&response).
+				SetQueryParam("q", groupName).
+				SetQueryParams(params).
+				Get("/permissions/groups")
+
+			if err = sc.checkError(rsp, err); err != nil {
+				return nil, pageInfo{}, err
+			}
 
-	if err = sc.checkError(rsp, err); err != nil {
+			return response.Groups, response.pageInfo, nil
+		})
+	if err != nil {
 		return nil, fmt.Errorf("failed to get group %s permission: %w", groupName, err)
 	}
 
-	for _, g := range response.Groups {
+	for _, g := range groups {
 		if g.Name == groupName {
 			return g.Permissions, nil
 		}
@@ -294,7 +319,7 @@ func (sc *Client) GetGroupPermissions(ctx context.Context, groupName string) ([]
 
 // AddPermissionToGroup adds permission to group.
 func (sc *Client) AddPermissionToGroup(ctx context.Context, groupName, permission string) error {
-	rsp, err := sc.startRequest(ctx).
+	rsp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			"groupName":  groupName,
 			"permission": permission,
@@ -310,7 +335,7 @@ func (sc *Client) AddPermissionToGroup(ctx context.Context, groupName, permissio
 
 // RemovePermissionFromGroup removes permission from group.
 func (sc *Client) RemovePermissionFromGroup(ctx context.Context, groupName, permission string) error {
-	rsp, err := sc.startRequest(ctx).
+	rsp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			"groupName":  groupName,
 			"permission": permission,
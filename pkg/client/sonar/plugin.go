@@ -0,0 +1,151 @@
+package sonar
+
+import (
+	"context"
+	"fmt"
+)
+
+// PluginRelease represents a release of the plugin available in the SonarQube Marketplace.
+type PluginRelease struct {
+	Version string `json:"version"`
+	Date    string `json:"date,omitempty"`
+}
+
+// AvailablePlugin represents a plugin that can be installed from the SonarQube Marketplace.
+type AvailablePlugin struct {
+	Key     string        `json:"key"`
+	Name    string        `json:"name"`
+	Release PluginRelease `json:"release"`
+}
+
+// PluginUpdate represents a plugin release that can be used to update the installed plugin.
+type PluginUpdate struct {
+	Release PluginRelease `json:"release"`
+	Status  string        `json:"status"`
+}
+
+// UpdatablePlugin represents an installed plugin that has updates in the SonarQube Marketplace.
+type UpdatablePlugin struct {
+	Key     string         `json:"key"`
+	Name    string         `json:"name"`
+	Updates []PluginUpdate `json:"updates"`
+}
+
+// PendingPlugins represents plugins that will be installed, updated or removed after the next restart.
+type PendingPlugins struct {
+	Installing []Plugin `json:"installing"`
+	Updating   []Plugin `json:"updating"`
+	Removing   []Plugin `json:"removing"`
+}
+
+// GetPlugins returns the installed plugins.
+func (sc *Client) GetPlugins(ctx context.Context) ([]Plugin, error) {
+	var pluginsResp InstalledPluginsResponse
+
+	resp, err := sc.startRequest(ctx).
+		SetResult(&pluginsResp).
+		Get("/plugins/installed")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to get installed plugins: %w", err)
+	}
+
+	return pluginsResp.Plugins, nil
+}
+
+// GetAvailablePlugins returns the plugins that can be installed from the SonarQube Marketplace.
+func (sc *Client) GetAvailablePlugins(ctx context.Context) ([]AvailablePlugin, error) {
+	pluginsResp := struct {
+		Plugins []AvailablePlugin `json:"plugins"`
+	}{}
+
+	resp, err := sc.startRequest(ctx).
+		SetResult(&pluginsResp).
+		Get("/plugins/available")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to get available plugins: %w", err)
+	}
+
+	return pluginsResp.Plugins, nil
+}
+
+// GetPluginUpdates returns the installed plugins that have updates in the SonarQube Marketplace.
+func (sc *Client) GetPluginUpdates(ctx context.Context) ([]UpdatablePlugin, error) {
+	pluginsResp := struct {
+		Plugins []UpdatablePlugin `json:"plugins"`
+	}{}
+
+	resp, err := sc.startRequest(ctx).
+		SetResult(&pluginsResp).
+		Get("/plugins/updates")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to get plugin updates: %w", err)
+	}
+
+	return pluginsResp.Plugins, nil
+}
+
+// GetPendingPlugins returns the plugins that will be installed, updated or removed after the next restart.
+func (sc *Client) GetPendingPlugins(ctx context.Context) (*PendingPlugins, error) {
+	pending := &PendingPlugins{}
+
+	resp, err := sc.startRequest(ctx).
+		SetResult(pending).
+		Get("/plugins/pending")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to get pending plugins: %w", err)
+	}
+
+	return pending, nil
+}
+
+// InstallPlugin installs the latest compatible release of the plugin from the SonarQube Marketplace.
+// The plugin is available only after sonar is restarted.
+func (sc *Client) InstallPlugin(ctx context.Context, key string) error {
+	resp, err := sc.startRequest(ctx).
+		SetFormData(map[string]string{
+			"key": key,
+		}).
+		Post("/plugins/install")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return fmt.Errorf("failed to install plugin %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// UpdatePlugin updates the plugin to the latest compatible release from the SonarQube Marketplace.
+// The new version is available only after sonar is restarted.
+func (sc *Client) UpdatePlugin(ctx context.Context, key string) error {
+	resp, err := sc.startRequest(ctx).
+		SetFormData(map[string]string{
+			"key": key,
+		}).
+		Post("/plugins/update")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return fmt.Errorf("failed to update plugin %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// UninstallPlugin uninstalls the plugin.
+// The plugin is removed only after sonar is restarted.
+func (sc *Client) UninstallPlugin(ctx context.Context, key string) error {
+	resp, err := sc.startRequest(ctx).
+		SetFormData(map[string]string{
+			"key": key,
+		}).
+		Post("/plugins/uninstall")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return fmt.Errorf("failed to uninstall plugin %s: %w", key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,340 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetPlugins(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		serverBody     string
+		want           []Plugin
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusOK,
+			serverBody: `{"plugins":[{"key":"java","name":"Java Code Quality and Security",` +
+				`"version":"7.16 (build 30901)"}]}`,
+			want: []Plugin{
+				{Key: "java", Name: "Java Code Quality and Security", Version: "7.16 (build 30901)"},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:           "server error",
+			serverResponse: http.StatusInternalServerError,
+			serverBody:     `{"errors":[{"msg":"Internal server error"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get installed plugins")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/api/plugins/installed", r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err := w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "user", "password")
+
+			got, err := client.GetPlugins(context.Background())
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetAvailablePlugins(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		serverBody     string
+		want           []AvailablePlugin
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusOK,
+			serverBody:     `{"plugins":[{"key":"go","name":"Go","release":{"version":"1.15.0.4655","date":"2023-10-10"}}]}`,
+			want: []AvailablePlugin{
+				{Key: "go", Name: "Go", Release: PluginRelease{Version: "1.15.0.4655", Date: "2023-10-10"}},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:           "server error",
+			serverResponse: http.StatusInternalServerError,
+			serverBody:     `{"errors":[{"msg":"Internal server error"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get available plugins")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/api/plugins/available", r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err := w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "user", "password")
+
+			got, err := client.GetAvailablePlugins(context.Background())
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetPluginUpdates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		serverBody     string
+		want           []UpdatablePlugin
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusOK,
+			serverBody: `{"plugins":[{"key":"go","name":"Go","updates":[` +
+				`{"release":{"version":"1.15.0.4655"},"status":"COMPATIBLE"}]}]}`,
+			want: []UpdatablePlugin{
+				{
+					Key:  "go",
+					Name: "Go",
+					Updates: []PluginUpdate{
+						{Release: PluginRelease{Version: "1.15.0.4655"}, Status: "COMPATIBLE"},
+					},
+				},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:           "server error",
+			serverResponse: http.StatusInternalServerError,
+			serverBody:     `{"errors":[{"msg":"Internal server error"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get plugin updates")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/api/plugins/updates", r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err := w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "user", "password")
+
+			got, err := client.GetPluginUpdates(context.Background())
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_GetPendingPlugins(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		serverBody     string
+		want           *PendingPlugins
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusOK,
+			serverBody:     `{"installing":[{"key":"go"}],"updating":[],"removing":[{"key":"php"}]}`,
+			want: &PendingPlugins{
+				Installing: []Plugin{{Key: "go"}},
+				Updating:   []Plugin{},
+				Removing:   []Plugin{{Key: "php"}},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:           "server error",
+			serverResponse: http.StatusInternalServerError,
+			serverBody:     `{"errors":[{"msg":"Internal server error"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get pending plugins")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/api/plugins/pending", r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err := w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "user", "password")
+
+			got, err := client.GetPendingPlugins(context.Background())
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_ChangePlugin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		path           string
+		change         func(client *Client) error
+		serverResponse int
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name: "install plugin",
+			path: "/api/plugins/install",
+			change: func(client *Client) error {
+				return client.InstallPlugin(context.Background(), "go")
+			},
+			serverResponse: http.StatusNoContent,
+			wantErr:        require.NoError,
+		},
+		{
+			name: "failed to install plugin",
+			path: "/api/plugins/install",
+			change: func(client *Client) error {
+				return client.InstallPlugin(context.Background(), "go")
+			},
+			serverResponse: http.StatusBadRequest,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to install plugin go")
+			},
+		},
+		{
+			name: "update plugin",
+			path: "/api/plugins/update",
+			change: func(client *Client) error {
+				return client.UpdatePlugin(context.Background(), "go")
+			},
+			serverResponse: http.StatusNoContent,
+			wantErr:        require.NoError,
+		},
+		{
+			name: "failed to update plugin",
+			path: "/api/plugins/update",
+			change: func(client *Client) error {
+				return client.UpdatePlugin(context.Background(), "go")
+			},
+			serverResponse: http.StatusBadRequest,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to update plugin go")
+			},
+		},
+		{
+			name: "uninstall plugin",
+			path: "/api/plugins/uninstall",
+			change: func(client *Client) error {
+				return client.UninstallPlugin(context.Background(), "go")
+			},
+			serverResponse: http.StatusNoContent,
+			wantErr:        require.NoError,
+		},
+		{
+			name: "failed to uninstall plugin",
+			path: "/api/plugins/uninstall",
+			change: func(client *Client) error {
+				return client.UninstallPlugin(context.Background(), "go")
+			},
+			serverResponse: http.StatusBadRequest,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to uninstall plugin go")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, tt.path, r.URL.Path)
+
+				err := r.ParseForm()
+				require.NoError(t, err)
+
+				assert.Equal(t, "go", r.FormValue("key"))
+
+				w.WriteHeader(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "user", "password")
+
+			tt.wantErr(t, tt.change(client))
+		})
+	}
+}
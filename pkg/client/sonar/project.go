@@ -16,11 +16,7 @@ type Project struct {
 
 type projectSearchResponse struct {
 	Projects []Project `json:"components"`
-	Paging   struct {
-		PageIndex int `json:"pageIndex"`
-		PageSize  int `json:"pageSize"`
-		Total     int `json:"total"`
-	} `json:"paging"`
+	pageInfo
 }
 
 // CreateProject creates a new project in SonarQube.
@@ -36,7 +32,7 @@ func (sc *Client) CreateProject(ctx context.Context, project *Project) error {
 		formData["mainBranch"] = project.MainBranch
 	}
 
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetFormData(formData).
 		Post("/projects/create")
 
@@ -50,7 +46,7 @@ func (sc *Client) CreateProject(ctx context.Context, project *Project) error {
 // GetProject returns the project with the given key.
 func (sc *Client) GetProject(ctx context.Context, projectKey string) (*Project, error) {
 	var projectResponse projectSearchResponse
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetResult(&projectResponse).
 		SetQueryParams(map[string]string{
 			"projects": projectKey,
@@ -75,7 +71,7 @@ func (sc *Client) GetProject(ctx context.Context, projectKey string) (*Project,
 func (sc *Client) UpdateProject(ctx context.Context, project *Project) error {
 	// Update visibility if needed
 	if project.Visibility != "" {
-		resp, err := sc.startRequest(ctx).
+		resp, err := sc.startOrgRequest(ctx).
 			SetFormData(map[string]string{
 				"project":    project.Key,
 				"visibility": project.Visibility,
@@ -92,7 +88,7 @@ func (sc *Client) UpdateProject(ctx context.Context, project *Project) error {
 
 // DeleteProject deletes the project with the given key.
 func (sc *Client) DeleteProject(ctx context.Context, projectKey string) error {
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			"project": projectKey,
 		}).
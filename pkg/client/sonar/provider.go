@@ -4,64 +4,449 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/epam/edp-sonar-operator/api/common"
 	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/sourceref"
+)
+
+const (
+	// DefaultAdminUser is the admin username of a fresh sonar instance.
+	DefaultAdminUser = "admin"
+	// DefaultAdminPassword is the admin password of a fresh sonar instance.
+	DefaultAdminPassword = "admin"
+
+	// TokenSecretKey is the key of the operator API token in the token Secret.
+	TokenSecretKey = "token"
+	// TokenNameSecretKey is the key of the operator API token name in the token Secret.
+	TokenNameSecretKey = "tokenName"
+	// TokenExpirationDateSecretKey is the key of the operator API token expiration date in the token Secret.
+	TokenExpirationDateSecretKey = "expirationDate"
 )
 
 // ApiClientProvider is a struct for providing sonar api client.
 type ApiClientProvider struct {
 	k8sClient client.Client
+	// operatorNamespace is the namespace of the Secrets and ConfigMaps referenced by ClusterSonar.
+	operatorNamespace string
+	// cache keeps the clients that are shared by the controllers of the resources referencing sonar.
+	cache *clientCache
+	// limiters keep the rate limiters that are shared by all clients of sonar.
+	limiters *rateLimiters
 }
 
 // NewApiClientProvider returns a new instance of ApiClientProvider.
-func NewApiClientProvider(k8sClient client.Client) *ApiClientProvider {
-	return &ApiClientProvider{k8sClient: k8sClient}
+func NewApiClientProvider(k8sClient client.Client, operatorNamespace string) *ApiClientProvider {
+	return &ApiClientProvider{
+		k8sClient:         k8sClient,
+		operatorNamespace: operatorNamespace,
+		cache:             newClientCache(),
+		limiters:          newRateLimiters(),
+	}
 }
 
-// GetSonarApiClientFromSonar returns sonar api client from sonar CR.
+// GetSonarApiClientFromSonar returns a new sonar api client from sonar CR.
+// The client is not cached, because the sonar controller changes its credentials,
+// but it shares the rate limiter with the cached client of sonar.
+// The capabilities of the sonar server are detected when the client is created.
+// If the detection fails, the client uses the v1 Web API. SonarCloud supports only the v1 Web API.
 func (p *ApiClientProvider) GetSonarApiClientFromSonar(ctx context.Context, sonar *sonarApi.Sonar) (*Client, error) {
+	return p.buildClient(ctx, p.k8sClient, sonar)
+}
+
+// buildClient creates the client reading the Secrets and ConfigMaps of sonar with the given k8s client.
+func (p *ApiClientProvider) buildClient(
+	ctx context.Context,
+	k8sClient client.Client,
+	sonar *sonarApi.Sonar,
+) (*Client, error) {
+	c, err := p.newClient(ctx, k8sClient, sonar)
+	if err != nil {
+		return nil, err
+	}
+
+	c.SetOrganization(sonar.Spec.Organization)
+
+	if sonar.Spec.IsSonarCloud() {
+		c.cloud = true
+
+		return c, nil
+	}
+
+	if err = c.DetectCapabilities(ctx); err != nil {
+		ctrl.LoggerFrom(ctx).Info("Unable to detect sonar capabilities, v1 Web API is used", "error", err.Error())
+	}
+
+	return c, nil
+}
+
+func (p *ApiClientProvider) newClient(
+	ctx context.Context,
+	k8sClient client.Client,
+	sonar *sonarApi.Sonar,
+) (*Client, error) {
+	opts, err := p.getClientOptions(ctx, k8sClient, sonar)
+	if err != nil {
+		return nil, err
+	}
+
+	if sonar.Spec.TokenAuth != nil {
+		token, tokenErr := GetOperatorToken(ctx, k8sClient, sonar)
+		if tokenErr != nil {
+			return nil, tokenErr
+		}
+
+		// until the token is generated, the credentials from the sonar secret are used.
+		if token != "" {
+			c := NewClient(sonar.Spec.Url, "", "", opts...)
+			c.SetToken(token)
+
+			return c, nil
+		}
+	}
+
+	user, password, err := GetSonarCredentials(ctx, k8sClient, sonar)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(sonar.Spec.Url, user, password, opts...), nil
+}
+
+// getClientOptions returns the connection options of the sonar client from sonar CR.
+func (p *ApiClientProvider) getClientOptions(
+	ctx context.Context,
+	k8sClient client.Client,
+	sonar *sonarApi.Sonar,
+) ([]ClientOption, error) {
+	var opts []ClientOption
+
+	tlsConfig, err := GetTLSConfig(ctx, k8sClient, sonar)
+	if err != nil {
+		return nil, err
+	}
+
+	if tlsConfig != nil {
+		opts = append(opts, WithTLSConfig(tlsConfig))
+	}
+
+	if sonar.Spec.Proxy != nil {
+		proxyURL, proxyErr := getProxyURL(ctx, k8sClient, sonar)
+		if proxyErr != nil {
+			return nil, proxyErr
+		}
+
+		opts = append(opts, WithProxy(proxyURL))
+	}
+
+	if len(sonar.Spec.Headers) > 0 {
+		headers := make(map[string]string, len(sonar.Spec.Headers))
+
+		for _, h := range sonar.Spec.Headers {
+			value := h.Value
+
+			if h.ValueRef != nil {
+				if value, err = sourceref.GetValueFromSourceRef(ctx, h.ValueRef, sonar.Namespace, k8sClient); err != nil {
+					return nil, fmt.Errorf("failed to get value of header %s: %w", h.Name, err)
+				}
+			}
+
+			headers[h.Name] = value
+		}
+
+		opts = append(opts, WithHeaders(headers))
+	}
+
+	if sonar.Spec.Timeout != nil {
+		opts = append(opts, WithTimeout(sonar.Spec.Timeout.Duration))
+	}
+
+	policy := getRequestPolicy(sonar.Spec.RequestPolicy)
+	opts = append(opts, withRequestPolicy(policy, p.limiters.get(InstanceName(sonar), policy)))
+
+	// tracing and metrics wrap the transport, so they are set after the transport options.
+	opts = append(opts, WithTracing(), WithMetrics(InstanceName(sonar)))
+
+	return opts, nil
+}
+
+// getRequestPolicy returns the request policy from sonar CR, the default values are used for the unset fields.
+func getRequestPolicy(spec *sonarApi.SonarRequestPolicy) RequestPolicy {
+	policy := DefaultRequestPolicy()
+
+	if spec == nil {
+		return policy
+	}
+
+	policy.RateLimit = spec.RateLimit
+	policy.Burst = spec.Burst
+
+	if spec.MaxRetries != nil {
+		policy.MaxRetries = *spec.MaxRetries
+	}
+
+	if spec.RetryWaitTime != nil {
+		policy.RetryWaitTime = spec.RetryWaitTime.Duration
+	}
+
+	if spec.MaxRetryWaitTime != nil {
+		policy.MaxRetryWaitTime = spec.MaxRetryWaitTime.Duration
+	}
+
+	return policy
+}
+
+func getProxyURL(ctx context.Context, k8sClient client.Client, sonar *sonarApi.Sonar) (string, error) {
+	proxyURL, err := url.Parse(sonar.Spec.Proxy.Url)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse proxy url: %w", err)
+	}
+
+	if proxyURL.Scheme == "" || proxyURL.Host == "" {
+		return "", fmt.Errorf("proxy url %s must contain scheme and host", sonar.Spec.Proxy.Url)
+	}
+
+	if sonar.Spec.Proxy.CredentialsSecret != "" {
+		user, password, credErr := GetCredentialsFromSecret(
+			ctx,
+			k8sClient,
+			sonar.Namespace,
+			sonar.Spec.Proxy.CredentialsSecret,
+		)
+		if credErr != nil {
+			return "", fmt.Errorf("failed to get proxy credentials: %w", credErr)
+		}
+
+		proxyURL.User = url.UserPassword(user, password)
+	}
+
+	return proxyURL.String(), nil
+}
+
+// GetSonarCredentials returns sonar user and password from the secret of the sonar CR.
+func GetSonarCredentials(
+	ctx context.Context,
+	k8sClient client.Client,
+	sonar *sonarApi.Sonar,
+) (user, password string, err error) {
+	user, password, err = GetCredentialsFromSecret(ctx, k8sClient, sonar.Namespace, sonar.Spec.Secret)
+	if err == nil {
+		return user, password, nil
+	}
+
+	// the secret of a fresh sonar is created by the operator after the admin password is changed,
+	// so the initial admin credentials are used until then.
+	if sonar.Spec.AdminCredentials == nil || !k8sErrors.IsNotFound(err) {
+		return "", "", err
+	}
+
+	return GetInitialAdminCredentials(ctx, k8sClient, sonar)
+}
+
+// GetCredentialsFromSecret returns sonar user and password from the secret.
+func GetCredentialsFromSecret(
+	ctx context.Context,
+	k8sClient client.Client,
+	namespace, name string,
+) (user, password string, err error) {
 	secret := corev1.Secret{}
-	if err := p.k8sClient.Get(ctx, types.NamespacedName{
-		Name:      sonar.Spec.Secret,
-		Namespace: sonar.Namespace,
+	if err = k8sClient.Get(ctx, types.NamespacedName{
+		Name:      name,
+		Namespace: namespace,
 	}, &secret); err != nil {
-		return nil, fmt.Errorf("failed to get sonar secret: %w", err)
+		return "", "", fmt.Errorf("failed to get sonar secret: %w", err)
 	}
 
 	if secret.Data["user"] == nil {
-		return nil, fmt.Errorf("sonar secret doesn't contain user")
+		return "", "", fmt.Errorf("sonar secret doesn't contain user")
 	}
 
-	password := ""
 	if secret.Data["password"] != nil {
 		password = string(secret.Data["password"])
 	}
 
-	return NewClient(sonar.Spec.Url, string(secret.Data["user"]), password), nil
+	return string(secret.Data["user"]), password, nil
+}
+
+// GetInitialAdminCredentials returns the initial admin credentials of sonar.
+// If the initial secret is not set, the default admin credentials are returned.
+func GetInitialAdminCredentials(
+	ctx context.Context,
+	k8sClient client.Client,
+	sonar *sonarApi.Sonar,
+) (user, password string, err error) {
+	if sonar.Spec.AdminCredentials == nil || sonar.Spec.AdminCredentials.InitialSecret == "" {
+		return DefaultAdminUser, DefaultAdminPassword, nil
+	}
+
+	user, password, err = GetCredentialsFromSecret(
+		ctx,
+		k8sClient,
+		sonar.Namespace,
+		sonar.Spec.AdminCredentials.InitialSecret,
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get initial admin credentials: %w", err)
+	}
+
+	return user, password, nil
+}
+
+// GetTokenSecretName returns the name of the Secret where the operator stores the API token.
+func GetTokenSecretName(sonar *sonarApi.Sonar) string {
+	if sonar.Spec.TokenAuth != nil && sonar.Spec.TokenAuth.Secret != "" {
+		return sonar.Spec.TokenAuth.Secret
+	}
+
+	return fmt.Sprintf("%s-operator-token", sonar.Name)
+}
+
+// GetOperatorToken returns the API token generated by the operator.
+// If the token is not generated yet, an empty string is returned.
+func GetOperatorToken(ctx context.Context, k8sClient client.Client, sonar *sonarApi.Sonar) (string, error) {
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{
+		Name:      GetTokenSecretName(sonar),
+		Namespace: sonar.Namespace,
+	}, secret); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("failed to get sonar token secret: %w", err)
+	}
+
+	return string(secret.Data[TokenSecretKey]), nil
 }
 
 // GetSonarApiClientFromSonarRef returns sonar api client from sonar ref.
+// The client is cached and shared by all resources referencing sonar until sonar or its Secrets change.
 func (p *ApiClientProvider) GetSonarApiClientFromSonarRef(
 	ctx context.Context,
 	namespace string,
 	sonarRef common.HasSonarRef,
 ) (*Client, error) {
-	sonar := &sonarApi.Sonar{}
-	if err := p.k8sClient.Get(ctx, types.NamespacedName{
-		Name:      sonarRef.GetSonarRef().Name,
-		Namespace: namespace,
-	}, sonar); err != nil {
-		return nil, fmt.Errorf("failed to get sonar: %w", err)
+	sonar, err := p.GetSonarFromSonarRef(ctx, namespace, sonarRef)
+	if err != nil {
+		return nil, err
 	}
 
 	if !sonar.Status.Connected {
 		return nil, errors.New("sonar is not connected")
 	}
 
-	return p.GetSonarApiClientFromSonar(ctx, sonar)
+	return p.getCachedClient(ctx, sonar)
+}
+
+// GetSonarFromSonarRef returns sonar CR from sonar ref.
+// Sonar is read from the namespace of the ref or the given namespace, ClusterSonar is cluster-scoped
+// and returned as the Sonar view. The given namespace must be allowed by sonar.
+func (p *ApiClientProvider) GetSonarFromSonarRef(
+	ctx context.Context,
+	namespace string,
+	sonarRef common.HasSonarRef,
+) (*sonarApi.Sonar, error) {
+	ref := sonarRef.GetSonarRef()
+
+	var sonar *sonarApi.Sonar
+
+	switch ref.Kind {
+	case common.ClusterSonarKind:
+		clusterSonar := &sonarApi.ClusterSonar{}
+		if err := p.k8sClient.Get(ctx, types.NamespacedName{Name: ref.Name}, clusterSonar); err != nil {
+			return nil, sonarGetError("cluster sonar", err)
+		}
+
+		sonar = clusterSonar.ToSonar(p.operatorNamespace)
+	case "", common.SonarKind:
+		sonarNamespace := namespace
+		if ref.Namespace != "" {
+			sonarNamespace = ref.Namespace
+		}
+
+		sonar = &sonarApi.Sonar{}
+		if err := p.k8sClient.Get(ctx, types.NamespacedName{
+			Name:      ref.Name,
+			Namespace: sonarNamespace,
+		}, sonar); err != nil {
+			return nil, sonarGetError("sonar", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported sonar kind %s", ref.Kind)
+	}
+
+	if err := p.checkNamespaceAllowed(ctx, sonar, namespace); err != nil {
+		return nil, err
+	}
+
+	return sonar, nil
+}
+
+// sonarGetError wraps the error of getting the referenced sonar, ErrSonarNotFound is added if sonar is deleted.
+func sonarGetError(kind string, err error) error {
+	if k8sErrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get %s: %w: %w", kind, ErrSonarNotFound, err)
+	}
+
+	return fmt.Errorf("failed to get %s: %w", kind, err)
+}
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// checkNamespaceAllowed checks that the resources from the namespace can reference sonar.
+func (p *ApiClientProvider) checkNamespaceAllowed(ctx context.Context, sonar *sonarApi.Sonar, namespace string) error {
+	if !sonar.IsClusterSonarView() && sonar.Namespace == namespace {
+		return nil
+	}
+
+	allowed := sonar.Spec.AllowedNamespaces
+	if allowed == nil {
+		if sonar.IsClusterSonarView() {
+			return nil
+		}
+
+		return namespaceNotAllowedError(sonar, namespace)
+	}
+
+	if slices.Contains(allowed.Names, namespace) {
+		return nil
+	}
+
+	if allowed.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(allowed.Selector)
+		if err != nil {
+			return fmt.Errorf("failed to parse allowed namespaces selector: %w", err)
+		}
+
+		ns := &corev1.Namespace{}
+		if err = p.k8sClient.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+			return fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+		}
+
+		if selector.Matches(labels.Set(ns.Labels)) {
+			return nil
+		}
+	}
+
+	return namespaceNotAllowedError(sonar, namespace)
+}
+
+func namespaceNotAllowedError(sonar *sonarApi.Sonar, namespace string) error {
+	if sonar.IsClusterSonarView() {
+		return fmt.Errorf("%s %s doesn't allow references from namespace %s: %w",
+			common.ClusterSonarKind, sonar.Name, namespace, common.ErrNamespaceNotAllowed)
+	}
+
+	return fmt.Errorf("%s %s/%s doesn't allow references from namespace %s: %w",
+		common.SonarKind, sonar.Namespace, sonar.Name, namespace, common.ErrNamespaceNotAllowed)
 }
@@ -0,0 +1,404 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestApiClientProvider_GetSonarApiClientFromSonar(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	newSecret := func(name string, data map[string]string) *corev1.Secret {
+		s := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Data:       map[string][]byte{},
+		}
+
+		for k, v := range data {
+			s.Data[k] = []byte(v)
+		}
+
+		return s
+	}
+
+	adminSecret := newSecret("sonar-admin", map[string]string{"user": "admin", "password": "password"})
+
+	tests := []struct {
+		name       string
+		spec       func(serverURL string) sonarApi.SonarSpec
+		objects    []client.Object
+		handler    func(t *testing.T) http.HandlerFunc
+		wantErr    require.ErrorAssertionFunc
+		wantReqErr require.ErrorAssertionFunc
+		wantOrg    string
+	}{
+		{
+			name: "static and referenced headers",
+			spec: func(serverURL string) sonarApi.SonarSpec {
+				return sonarApi.SonarSpec{
+					Url:    serverURL,
+					Secret: "sonar-admin",
+					Headers: []sonarApi.SonarHeader{
+						{Name: "X-Static", Value: "static"},
+						{
+							Name: "X-Gateway-Key",
+							ValueRef: &common.SourceRef{
+								SecretKeyRef: &common.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "gateway"},
+									Key:                  "key",
+								},
+							},
+						},
+					},
+				}
+			},
+			objects: []client.Object{adminSecret, newSecret("gateway", map[string]string{"key": "gateway-key"})},
+			handler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "static", r.Header.Get("X-Static"))
+					assert.Equal(t, "gateway-key", r.Header.Get("X-Gateway-Key"))
+
+					w.WriteHeader(http.StatusNoContent)
+				}
+			},
+			wantErr:    require.NoError,
+			wantReqErr: require.NoError,
+		},
+		{
+			name: "proxy with credentials",
+			spec: func(serverURL string) sonarApi.SonarSpec {
+				return sonarApi.SonarSpec{
+					Url:    "http://sonar.example.com",
+					Secret: "sonar-admin",
+					Proxy: &sonarApi.SonarProxy{
+						Url:               serverURL,
+						CredentialsSecret: "proxy",
+					},
+				}
+			},
+			objects: []client.Object{
+				adminSecret,
+				newSecret("proxy", map[string]string{"user": "proxy", "password": "secret"}),
+			},
+			handler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "sonar.example.com", r.URL.Host)
+					assert.Equal(t, "Basic cHJveHk6c2VjcmV0", r.Header.Get("Proxy-Authorization"))
+
+					w.WriteHeader(http.StatusNoContent)
+				}
+			},
+			wantErr:    require.NoError,
+			wantReqErr: require.NoError,
+		},
+		{
+			name: "request timeout",
+			spec: func(serverURL string) sonarApi.SonarSpec {
+				return sonarApi.SonarSpec{
+					Url:           serverURL,
+					Secret:        "sonar-admin",
+					Timeout:       &metav1.Duration{Duration: 10 * time.Millisecond},
+					RequestPolicy: &sonarApi.SonarRequestPolicy{MaxRetries: ptr.To(0)},
+				}
+			},
+			objects: []client.Object{adminSecret},
+			handler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					time.Sleep(200 * time.Millisecond)
+					w.WriteHeader(http.StatusNoContent)
+				}
+			},
+			wantErr: require.NoError,
+			wantReqErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "Client.Timeout exceeded")
+			},
+		},
+		{
+			name: "sonar cloud organization",
+			spec: func(serverURL string) sonarApi.SonarSpec {
+				return sonarApi.SonarSpec{
+					Url:          serverURL,
+					Secret:       "sonar-admin",
+					Flavor:       sonarApi.FlavorSonarCloud,
+					Organization: "my-org",
+				}
+			},
+			objects: []client.Object{adminSecret},
+			handler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					// capabilities are not detected for SonarCloud.
+					assert.Equal(t, "/api/webhooks/delete", r.URL.Path)
+					assert.Equal(t, "my-org", r.URL.Query().Get("organization"))
+
+					w.WriteHeader(http.StatusNoContent)
+				}
+			},
+			wantErr:    require.NoError,
+			wantReqErr: require.NoError,
+			wantOrg:    "my-org",
+		},
+		{
+			name: "invalid proxy url",
+			spec: func(serverURL string) sonarApi.SonarSpec {
+				return sonarApi.SonarSpec{
+					Url:    serverURL,
+					Secret: "sonar-admin",
+					Proxy:  &sonarApi.SonarProxy{Url: "proxy.example.com"},
+				}
+			},
+			objects: []client.Object{adminSecret},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "must contain scheme and host")
+			},
+		},
+		{
+			name: "header secret not found",
+			spec: func(serverURL string) sonarApi.SonarSpec {
+				return sonarApi.SonarSpec{
+					Url:    serverURL,
+					Secret: "sonar-admin",
+					Headers: []sonarApi.SonarHeader{
+						{
+							Name: "X-Gateway-Key",
+							ValueRef: &common.SourceRef{
+								SecretKeyRef: &common.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "gateway"},
+									Key:                  "key",
+								},
+							},
+						},
+					},
+				}
+			},
+			objects: []client.Object{adminSecret},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get value of header X-Gateway-Key")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var handler http.Handler = http.NotFoundHandler()
+			if tt.handler != nil {
+				handler = tt.handler(t)
+			}
+
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			sonar := &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "default"},
+				Spec:       tt.spec(server.URL),
+			}
+
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+
+			c, err := NewApiClientProvider(k8sClient, "default").GetSonarApiClientFromSonar(context.Background(), sonar)
+
+			tt.wantErr(t, err)
+
+			if tt.wantReqErr != nil {
+				assert.Equal(t, tt.wantOrg, c.org)
+				assert.Equal(t, sonar.Spec.IsSonarCloud(), c.IsSonarCloud())
+				tt.wantReqErr(t, c.DeleteWebhook(context.Background(), "AU-key"))
+			}
+		})
+	}
+}
+
+func TestApiClientProvider_GetSonarApiClientFromSonarRef(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, sonarApi.AddToScheme(scheme))
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	connected := sonarApi.SonarStatus{Connected: true}
+
+	objects := []client.Object{
+		&sonarApi.Sonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "default"},
+			Spec:       sonarApi.SonarSpec{Url: server.URL, Secret: "sonar-admin"},
+			Status:     connected,
+		},
+		&sonarApi.Sonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "disconnected", Namespace: "default"},
+			Spec:       sonarApi.SonarSpec{Url: server.URL, Secret: "sonar-admin"},
+		},
+		&sonarApi.Sonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "central", Namespace: "sonar"},
+			Spec: sonarApi.SonarSpec{
+				Url:    server.URL,
+				Secret: "sonar-admin",
+				AllowedNamespaces: &sonarApi.AllowedNamespaces{
+					Names: []string{"default"},
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"sonar.edp.epam.com/tenant": "true"},
+					},
+				},
+			},
+			Status: connected,
+		},
+		&sonarApi.Sonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "private", Namespace: "sonar"},
+			Spec:       sonarApi.SonarSpec{Url: server.URL, Secret: "sonar-admin"},
+			Status:     connected,
+		},
+		&sonarApi.ClusterSonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+			Spec:       sonarApi.SonarSpec{Url: server.URL, Secret: "shared-admin"},
+			Status:     connected,
+		},
+		&sonarApi.ClusterSonar{
+			ObjectMeta: metav1.ObjectMeta{Name: "restricted"},
+			Spec: sonarApi.SonarSpec{
+				Url:               server.URL,
+				Secret:            "shared-admin",
+				AllowedNamespaces: &sonarApi.AllowedNamespaces{Names: []string{"team-a"}},
+			},
+			Status: connected,
+		},
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"sonar.edp.epam.com/tenant": "true"}},
+		},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar-admin", Namespace: "sonar"},
+			Data:       map[string][]byte{"user": []byte("admin"), "password": []byte("password")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "sonar-admin", Namespace: "default"},
+			Data:       map[string][]byte{"user": []byte("admin"), "password": []byte("password")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared-admin", Namespace: "operators"},
+			Data:       map[string][]byte{"user": []byte("admin"), "password": []byte("password")},
+		},
+	}
+
+	namespaceNotAllowed := func(t require.TestingT, err error, i ...interface{}) {
+		require.Error(t, err)
+		require.ErrorIs(t, err, common.ErrNamespaceNotAllowed)
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		ref       common.SonarRef
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name:    "sonar from the namespace",
+			ref:     common.SonarRef{Name: "sonar"},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "sonar from another namespace allows the namespace by name",
+			ref:     common.SonarRef{Name: "central", Namespace: "sonar"},
+			wantErr: require.NoError,
+		},
+		{
+			name:      "sonar from another namespace allows the namespace by selector",
+			namespace: "team-a",
+			ref:       common.SonarRef{Name: "central", Namespace: "sonar"},
+			wantErr:   require.NoError,
+		},
+		{
+			name:      "namespace doesn't match the selector",
+			namespace: "team-b",
+			ref:       common.SonarRef{Name: "central", Namespace: "sonar"},
+			wantErr:   namespaceNotAllowed,
+		},
+		{
+			name:    "sonar from another namespace without allowed namespaces",
+			ref:     common.SonarRef{Name: "private", Namespace: "sonar"},
+			wantErr: namespaceNotAllowed,
+		},
+		{
+			name:      "cluster sonar allows the namespace",
+			namespace: "team-a",
+			ref:       common.SonarRef{Name: "restricted", Kind: common.ClusterSonarKind},
+			wantErr:   require.NoError,
+		},
+		{
+			name:    "cluster sonar doesn't allow the namespace",
+			ref:     common.SonarRef{Name: "restricted", Kind: common.ClusterSonarKind},
+			wantErr: namespaceNotAllowed,
+		},
+		{
+			name:    "cluster sonar with the secret in the operator namespace",
+			ref:     common.SonarRef{Name: "shared", Kind: common.ClusterSonarKind},
+			wantErr: require.NoError,
+		},
+		{
+			name: "cluster sonar not found",
+			ref:  common.SonarRef{Name: "sonar", Kind: common.ClusterSonarKind},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get cluster sonar")
+			},
+		},
+		{
+			name: "sonar is not connected",
+			ref:  common.SonarRef{Name: "disconnected", Kind: common.SonarKind},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "sonar is not connected")
+			},
+		},
+		{
+			name: "unsupported kind",
+			ref:  common.SonarRef{Name: "sonar", Kind: "Unknown"},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unsupported sonar kind")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+			project := &sonarApi.SonarProject{Spec: sonarApi.SonarProjectSpec{SonarRef: tt.ref}}
+
+			namespace := tt.namespace
+			if namespace == "" {
+				namespace = "default"
+			}
+
+			_, err := NewApiClientProvider(k8sClient, "operators").
+				GetSonarApiClientFromSonarRef(context.Background(), namespace, project)
+
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -56,7 +56,7 @@ type Actions struct {
 // Returns the created quality gate only with ID and name fields filled.
 func (sc *Client) CreateQualityGate(ctx context.Context, qualityGateName string) (*QualityGate, error) {
 	gate := &QualityGate{}
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{nameField: qualityGateName}).
 		SetResult(gate).
 		Post("/qualitygates/create")
@@ -71,7 +71,7 @@ func (sc *Client) CreateQualityGate(ctx context.Context, qualityGateName string)
 // GetQualityGate returns the quality gate with the given name.
 func (sc *Client) GetQualityGate(ctx context.Context, name string) (*QualityGate, error) {
 	gate := &QualityGate{}
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetQueryParam(nameField, name).
 		SetResult(gate).
 		Get("/qualitygates/show")
@@ -85,8 +85,13 @@ func (sc *Client) GetQualityGate(ctx context.Context, name string) (*QualityGate
 
 // DeleteQualityGate deletes the quality gate with the given name.
 func (sc *Client) DeleteQualityGate(ctx context.Context, name string) error {
-	resp, err := sc.startRequest(ctx).
-		SetFormData(map[string]string{nameField: name}).
+	params, err := sc.qualityGateRef(ctx, name, nameField, "id")
+	if err != nil {
+		return fmt.Errorf("failed to delete quality gate: %w", err)
+	}
+
+	resp, err := sc.startOrgRequest(ctx).
+		SetFormData(params).
 		Post("/qualitygates/destroy")
 
 	if err = sc.checkError(resp, err); err != nil {
@@ -98,8 +103,13 @@ func (sc *Client) DeleteQualityGate(ctx context.Context, name string) error {
 
 // SetAsDefaultQualityGate sets the quality gate with the given name as default.
 func (sc *Client) SetAsDefaultQualityGate(ctx context.Context, name string) error {
-	resp, err := sc.startRequest(ctx).
-		SetFormData(map[string]string{nameField: name}).
+	params, err := sc.qualityGateRef(ctx, name, nameField, "id")
+	if err != nil {
+		return fmt.Errorf("failed to set default quality gate: %w", err)
+	}
+
+	resp, err := sc.startOrgRequest(ctx).
+		SetFormData(params).
 		Post("/qualitygates/set_as_default")
 
 	if err = sc.checkError(resp, err); err != nil {
@@ -111,13 +121,17 @@ func (sc *Client) SetAsDefaultQualityGate(ctx context.Context, name string) erro
 
 // CreateQualityGateCondition creates a new quality gate condition.
 func (sc *Client) CreateQualityGateCondition(ctx context.Context, gate string, condition QualityGateCondition) error {
-	resp, err := sc.startRequest(ctx).
-		SetFormData(map[string]string{
-			"gateName": gate,
-			"error":    condition.Error,
-			"metric":   condition.Metric,
-			"op":       condition.OP,
-		}).
+	params, err := sc.qualityGateRef(ctx, gate, "gateName", "gateId")
+	if err != nil {
+		return fmt.Errorf("failed to create quality gate condition: %w", err)
+	}
+
+	params["error"] = condition.Error
+	params["metric"] = condition.Metric
+	params["op"] = condition.OP
+
+	resp, err := sc.startOrgRequest(ctx).
+		SetFormData(params).
 		Post("/qualitygates/create_condition")
 
 	if err = sc.checkError(resp, err); err != nil {
@@ -129,7 +143,7 @@ func (sc *Client) CreateQualityGateCondition(ctx context.Context, gate string, c
 
 // UpdateQualityGateCondition updates the quality gate condition.
 func (sc *Client) UpdateQualityGateCondition(ctx context.Context, condition QualityGateCondition) error {
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			"id":     condition.ID,
 			"metric": condition.Metric,
@@ -147,7 +161,7 @@ func (sc *Client) UpdateQualityGateCondition(ctx context.Context, condition Qual
 
 // DeleteQualityGateCondition deletes the quality gate condition with the given ID.
 func (sc *Client) DeleteQualityGateCondition(ctx context.Context, conditionId string) error {
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			"id": conditionId,
 		}).
@@ -159,3 +173,27 @@ func (sc *Client) DeleteQualityGateCondition(ctx context.Context, conditionId st
 
 	return nil
 }
+
+// qualityGateRef returns the form parameters that identify the quality gate.
+// Before SonarQube 8.4 the quality gate is identified by ID that is found by name.
+func (sc *Client) qualityGateRef(ctx context.Context, name, nameParam, idParam string) (map[string]string, error) {
+	if sc.capabilities.QualityGatesByName() {
+		return map[string]string{nameParam: name}, nil
+	}
+
+	// the ID is a number in the legacy API, so it can't be decoded to QualityGate.
+	var gate struct {
+		ID any `json:"id"`
+	}
+
+	resp, err := sc.startOrgRequest(ctx).
+		SetQueryParam(nameField, name).
+		SetResult(&gate).
+		Get("/qualitygates/show")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to get quality gate: %w", err)
+	}
+
+	return map[string]string{idParam: fmt.Sprint(gate.ID)}, nil
+}
@@ -0,0 +1,69 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_QualityGateRef(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		capabilities *Capabilities
+		wantForm     map[string]string
+	}{
+		{
+			name:         "quality gate is identified by name",
+			capabilities: &Capabilities{Version: Version{Major: 10, Minor: 0}},
+			wantForm:     map[string]string{"gateName": "gate", "metric": "coverage", "op": "LT", "error": "80"},
+		},
+		{
+			name:         "quality gate is identified by id before 8.4",
+			capabilities: &Capabilities{Version: Version{Major: 8, Minor: 3}},
+			wantForm:     map[string]string{"gateId": "7", "metric": "coverage", "op": "LT", "error": "80"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/qualitygates/show", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "gate", r.URL.Query().Get("name"))
+
+				w.Header().Set("Content-Type", "application/json")
+				_, err := w.Write([]byte(`{"id":7,"name":"gate"}`))
+				require.NoError(t, err)
+			})
+			mux.HandleFunc("POST /api/qualitygates/create_condition", func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, r.ParseForm())
+
+				form := map[string]string{}
+				for k := range r.PostForm {
+					form[k] = r.PostForm.Get(k)
+				}
+
+				assert.Equal(t, tt.wantForm, form)
+			})
+
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			c := NewClient(server.URL, "user", "password")
+			c.capabilities = tt.capabilities
+
+			require.NoError(t, c.CreateQualityGateCondition(context.Background(), "gate", QualityGateCondition{
+				Metric: "coverage",
+				OP:     "LT",
+				Error:  "80",
+			}))
+		})
+	}
+}
@@ -19,7 +19,7 @@ func (sc *Client) CreateQualityProfile(ctx context.Context, name, language strin
 		Profile QualityProfile `json:"profile"`
 	}{}
 
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			nameField:  name,
 			"language": language,
@@ -39,7 +39,7 @@ func (sc *Client) GetQualityProfile(ctx context.Context, name string) (*QualityP
 	profiles := struct {
 		Profiles []QualityProfile `json:"profiles"`
 	}{}
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetQueryParam("qualityProfile", name).
 		SetResult(&profiles).
 		Get("/qualityprofiles/search")
@@ -59,7 +59,7 @@ func (sc *Client) GetQualityProfile(ctx context.Context, name string) (*QualityP
 
 // DeleteQualityProfile deletes the quality profile with the given name and language.
 func (sc *Client) DeleteQualityProfile(ctx context.Context, name, language string) error {
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			nameField:  name,
 			"language": language,
@@ -75,7 +75,7 @@ func (sc *Client) DeleteQualityProfile(ctx context.Context, name, language strin
 
 // SetAsDefaultQualityProfile sets the quality profile with the given name and language as default.
 func (sc *Client) SetAsDefaultQualityProfile(ctx context.Context, name, language string) error {
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			"qualityProfile": name,
 			"language":       language,
@@ -91,7 +91,7 @@ func (sc *Client) SetAsDefaultQualityProfile(ctx context.Context, name, language
 
 // ActivateQualityProfileRule activates the rule in the quality profile.
 func (sc *Client) ActivateQualityProfileRule(ctx context.Context, profileKey string, rule Rule) error {
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			"key":      profileKey,
 			"rule":     rule.Rule,
@@ -109,7 +109,7 @@ func (sc *Client) ActivateQualityProfileRule(ctx context.Context, profileKey str
 
 // DeactivateQualityProfileRule deactivates the rule in the quality profile.
 func (sc *Client) DeactivateQualityProfileRule(ctx context.Context, profileKey, ruleKey string) error {
-	resp, err := sc.startRequest(ctx).
+	resp, err := sc.startOrgRequest(ctx).
 		SetFormData(map[string]string{
 			"key":  profileKey,
 			"rule": ruleKey,
@@ -0,0 +1,187 @@
+package sonar
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/time/rate"
+)
+
+// RequestPolicy defines the rate limit and the retries of the requests to sonar.
+type RequestPolicy struct {
+	// RateLimit is the maximum average number of requests per second, zero disables the rate limit.
+	RateLimit int
+	// Burst is the maximum number of requests that are sent at once when the rate limit is set.
+	Burst int
+	// MaxRetries is the maximum number of retries of a failed request, zero disables the retries.
+	MaxRetries int
+	// RetryWaitTime is the initial wait time of the exponential backoff.
+	RetryWaitTime time.Duration
+	// MaxRetryWaitTime is the maximum wait time between the retries.
+	// A request is not retried if sonar asks to retry it later with the Retry-After header.
+	MaxRetryWaitTime time.Duration
+}
+
+// DefaultRequestPolicy returns the request policy that is used if the policy is not configured.
+func DefaultRequestPolicy() RequestPolicy {
+	return RequestPolicy{
+		MaxRetries:       3,
+		RetryWaitTime:    time.Second,
+		MaxRetryWaitTime: 30 * time.Second,
+	}
+}
+
+// WithRequestPolicy sets the rate limit and the retries of the requests.
+// The rate limiter is shared by all requests of the client and is applied to every retry.
+func WithRequestPolicy(policy RequestPolicy) ClientOption {
+	var limiter *rate.Limiter
+	if policy.RateLimit > 0 {
+		limiter = rate.NewLimiter(rate.Limit(policy.RateLimit), max(policy.Burst, 1))
+	}
+
+	return withRequestPolicy(policy, limiter)
+}
+
+// withRequestPolicy sets the retries of the requests from the policy and the given rate limiter,
+// so the rate limiter can be shared by several clients. A nil limiter disables the rate limit.
+func withRequestPolicy(policy RequestPolicy, limiter *rate.Limiter) ClientOption {
+	return func(c *resty.Client) {
+		if limiter != nil {
+			c.OnBeforeRequest(func(_ *resty.Client, r *resty.Request) error {
+				if err := limiter.Wait(r.Context()); err != nil {
+					return fmt.Errorf("rate limit wait failed: %w", err)
+				}
+
+				return nil
+			})
+		}
+
+		if policy.MaxRetries > 0 {
+			c.SetRetryCount(policy.MaxRetries).
+				SetRetryWaitTime(policy.RetryWaitTime).
+				SetRetryMaxWaitTime(policy.MaxRetryWaitTime).
+				AddRetryCondition(shouldRetry).
+				SetRetryAfter(retryAfter(policy.MaxRetryWaitTime))
+		}
+	}
+}
+
+// rateLimiters keeps a rate limiter per sonar instance, so all clients of sonar share its rate limit,
+// including the clients that are created by the sonar controller on every reconciliation.
+type rateLimiters struct {
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func newRateLimiters() *rateLimiters {
+	return &rateLimiters{limiters: make(map[string]*rate.Limiter)}
+}
+
+// get returns the rate limiter of the sonar instance updated to the policy.
+// Nil is returned if the policy has no rate limit.
+func (l *rateLimiters) get(instance string, policy RequestPolicy) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if policy.RateLimit <= 0 {
+		delete(l.limiters, instance)
+
+		return nil
+	}
+
+	limit, burst := rate.Limit(policy.RateLimit), max(policy.Burst, 1)
+
+	limiter, ok := l.limiters[instance]
+	if !ok {
+		limiter = rate.NewLimiter(limit, burst)
+		l.limiters[instance] = limiter
+
+		return limiter
+	}
+
+	if limiter.Limit() != limit {
+		limiter.SetLimit(limit)
+	}
+
+	if limiter.Burst() != burst {
+		limiter.SetBurst(burst)
+	}
+
+	return limiter
+}
+
+// delete removes the rate limiter of the deleted sonar instance.
+func (l *rateLimiters) delete(instance string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.limiters, instance)
+}
+
+// shouldRetry checks if the failed request can be retried.
+// The requests that are rejected with 429 or 503 or fail to connect are not processed by sonar,
+// so they are retried for all methods. Other server and connection errors are retried only for safe methods,
+// because a non-idempotent request may have been applied before it failed.
+func shouldRetry(resp *resty.Response, err error) bool {
+	if err != nil {
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+
+		return resp != nil && isSafeMethod(resp.Request.Method)
+	}
+
+	if resp == nil {
+		return false
+	}
+
+	switch code := resp.StatusCode(); {
+	case code == http.StatusTooManyRequests, code == http.StatusServiceUnavailable:
+		return true
+	case code >= http.StatusInternalServerError:
+		return isSafeMethod(resp.Request.Method)
+	default:
+		return false
+	}
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// retryAfter returns the wait time from the Retry-After header.
+// Zero is returned if the header is not set, so the exponential backoff is used.
+// The request is not retried if sonar asks to wait longer than the maximum wait time.
+func retryAfter(maxWaitTime time.Duration) resty.RetryAfterFunc {
+	return func(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
+		header := resp.Header().Get("Retry-After")
+		if header == "" {
+			return 0, nil
+		}
+
+		var wait time.Duration
+
+		if seconds, err := strconv.Atoi(header); err == nil {
+			wait = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(header); err == nil {
+			wait = time.Until(date)
+		}
+
+		if wait <= 0 {
+			return 0, nil
+		}
+
+		if wait > maxWaitTime {
+			return 0, fmt.Errorf("sonar asks to retry after %s that exceeds the maximum wait time %s", wait, maxWaitTime)
+		}
+
+		return wait, nil
+	}
+}
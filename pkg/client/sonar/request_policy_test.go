@@ -0,0 +1,265 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestWithRequestPolicy_Retries(t *testing.T) {
+	t.Parallel()
+
+	policy := RequestPolicy{
+		MaxRetries:       2,
+		RetryWaitTime:    time.Millisecond,
+		MaxRetryWaitTime: 10 * time.Millisecond,
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		policy       RequestPolicy
+		failures     int
+		status       int
+		retryAfter   string
+		wantAttempts int32
+		wantErr      require.ErrorAssertionFunc
+	}{
+		{
+			name:         "too many requests is retried for POST",
+			method:       http.MethodPost,
+			policy:       policy,
+			failures:     2,
+			status:       http.StatusTooManyRequests,
+			wantAttempts: 3,
+			wantErr:      require.NoError,
+		},
+		{
+			name:         "service unavailable is retried for POST",
+			method:       http.MethodPost,
+			policy:       policy,
+			failures:     1,
+			status:       http.StatusServiceUnavailable,
+			wantAttempts: 2,
+			wantErr:      require.NoError,
+		},
+		{
+			name:         "internal server error is retried for GET",
+			method:       http.MethodGet,
+			policy:       policy,
+			failures:     1,
+			status:       http.StatusInternalServerError,
+			wantAttempts: 2,
+			wantErr:      require.NoError,
+		},
+		{
+			name:         "internal server error is not retried for POST",
+			method:       http.MethodPost,
+			policy:       policy,
+			failures:     1,
+			status:       http.StatusInternalServerError,
+			wantAttempts: 1,
+			wantErr:      require.Error,
+		},
+		{
+			name:         "client error is not retried",
+			method:       http.MethodGet,
+			policy:       policy,
+			failures:     1,
+			status:       http.StatusBadRequest,
+			wantAttempts: 1,
+			wantErr:      require.Error,
+		},
+		{
+			name:         "retries are exhausted",
+			method:       http.MethodGet,
+			policy:       policy,
+			failures:     5,
+			status:       http.StatusServiceUnavailable,
+			wantAttempts: 3,
+			wantErr:      require.Error,
+		},
+		{
+			name:         "retries are disabled",
+			method:       http.MethodGet,
+			policy:       RequestPolicy{},
+			failures:     1,
+			status:       http.StatusServiceUnavailable,
+			wantAttempts: 1,
+			wantErr:      require.Error,
+		},
+		{
+			name:         "retry after is respected",
+			method:       http.MethodPost,
+			policy:       RequestPolicy{MaxRetries: 1, RetryWaitTime: time.Millisecond, MaxRetryWaitTime: 2 * time.Second},
+			failures:     1,
+			status:       http.StatusTooManyRequests,
+			retryAfter:   "1",
+			wantAttempts: 2,
+			wantErr:      require.NoError,
+		},
+		{
+			name:         "retry after exceeds the maximum wait time",
+			method:       http.MethodPost,
+			policy:       policy,
+			failures:     1,
+			status:       http.StatusTooManyRequests,
+			retryAfter:   "60",
+			wantAttempts: 1,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "exceeds the maximum wait time")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.method, r.Method)
+
+				if int(attempts.Add(1)) <= tt.failures {
+					if tt.retryAfter != "" {
+						w.Header().Set("Retry-After", tt.retryAfter)
+					}
+
+					w.WriteHeader(tt.status)
+
+					return
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			t.Cleanup(server.Close)
+
+			c := NewClient(server.URL, "admin", "admin", WithRequestPolicy(tt.policy))
+
+			start := time.Now()
+			rsp, err := c.startRequest(context.Background()).Execute(tt.method, "/test")
+
+			tt.wantErr(t, c.checkError(rsp, err))
+			assert.Equal(t, tt.wantAttempts, attempts.Load())
+
+			if tt.retryAfter == "1" {
+				assert.GreaterOrEqual(t, time.Since(start), time.Second)
+			}
+		})
+	}
+}
+
+func TestWithRequestPolicy_ConnectionError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	serverURL := server.URL
+	server.Close()
+
+	var attempts atomic.Int32
+
+	c := NewClient(serverURL, "admin", "admin", WithRequestPolicy(RequestPolicy{
+		MaxRetries:       2,
+		RetryWaitTime:    time.Millisecond,
+		MaxRetryWaitTime: 10 * time.Millisecond,
+	}))
+	c.resty.AddRetryHook(func(_ *resty.Response, _ error) {
+		attempts.Add(1)
+	})
+
+	err := c.DeleteWebhook(context.Background(), "AU-key")
+
+	require.Error(t, err)
+	assert.Equal(t, int32(3), attempts.Load(), "request that failed to connect must be retried")
+}
+
+func TestWithRequestPolicy_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(server.URL, "admin", "admin", WithRequestPolicy(RequestPolicy{RateLimit: 20, Burst: 1}))
+
+	start := time.Now()
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, c.DeleteWebhook(context.Background(), "AU-key"))
+	}
+
+	assert.Equal(t, int32(5), requests.Load())
+	assert.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond, "requests must be limited to 20 per second")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.Error(t, c.DeleteWebhook(ctx, "AU-key"))
+}
+
+func TestGetRequestPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		spec *sonarApi.SonarRequestPolicy
+		want RequestPolicy
+	}{
+		{
+			name: "default policy",
+			want: DefaultRequestPolicy(),
+		},
+		{
+			name: "custom policy",
+			spec: &sonarApi.SonarRequestPolicy{
+				RateLimit:        10,
+				Burst:            5,
+				MaxRetries:       ptr.To(0),
+				RetryWaitTime:    &metav1.Duration{Duration: 2 * time.Second},
+				MaxRetryWaitTime: &metav1.Duration{Duration: time.Minute},
+			},
+			want: RequestPolicy{
+				RateLimit:        10,
+				Burst:            5,
+				MaxRetries:       0,
+				RetryWaitTime:    2 * time.Second,
+				MaxRetryWaitTime: time.Minute,
+			},
+		},
+		{
+			name: "rate limit only",
+			spec: &sonarApi.SonarRequestPolicy{RateLimit: 10},
+			want: RequestPolicy{
+				RateLimit:        10,
+				MaxRetries:       3,
+				RetryWaitTime:    time.Second,
+				MaxRetryWaitTime: 30 * time.Second,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, getRequestPolicy(tt.spec))
+		})
+	}
+}
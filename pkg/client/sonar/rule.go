@@ -13,24 +13,34 @@ type Rule struct {
 	Params   string `json:"-"`
 }
 
-// GetQualityProfileActiveRules returns the active rules of the quality profile with the given key.
+type ruleSearchResponse struct {
+	Rules []Rule `json:"rules"`
+	pageInfo
+}
+
+// GetQualityProfileActiveRules returns all active rules of the quality profile with the given key.
 func (sc *Client) GetQualityProfileActiveRules(ctx context.Context, profileKey string) ([]Rule, error) {
-	rulesResp := struct {
-		Rules []Rule `json:"rules"`
-	}{}
-
-	resp, err := sc.startRequest(ctx).
-		SetQueryParams(map[string]string{
-			"activation": "true",
-			"qprofile":   profileKey,
-			"ps":         "500",
-		}).
-		SetResult(&rulesResp).
-		Get("/rules/search")
-
-	if err = sc.checkError(resp, err); err != nil {
+	rules, err := searchAll(ctx, v1Pager, func(ctx context.Context, params map[string]string) ([]Rule, pageInfo, error) {
+		var rulesResp ruleSearchResponse
+
+		resp, err := sc.startOrgRequest(ctx).
+			SetQueryParams(map[string]string{
+				"activation": "true",
+				"qprofile":   profileKey,
+			}).
+			SetQueryParams(params).
+			SetResult(&rulesResp).
+			Get("/rules/search")
+
+		if err = sc.checkError(resp, err); err != nil {
+			return nil, pageInfo{}, err
+		}
+
+		return rulesResp.Rules, rulesResp.pageInfo, nil
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to get quality profile active rules: %w", err)
 	}
 
-	return rulesResp.Rules, nil
+	return rules, nil
 }
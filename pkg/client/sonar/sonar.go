@@ -24,8 +24,7 @@ const (
 	loginField       = "login"
 	jsonContentType  = "application/json"
 	contentTypeField = "Content-Type"
-	retryCount       = 60
-	timeOut          = 10
+	componentField   = "component"
 )
 
 // SystemHealthResponse provides status of SonarQube.
@@ -42,17 +41,26 @@ type SystemHealthResponse struct {
 var log = ctrl.Log.WithName("sonar_client")
 
 type Client struct {
-	resty *resty.Client
+	resty        *resty.Client
+	capabilities *Capabilities
+	org          string
+	cloud        bool
 }
 
-func NewClient(sonarURL string, user string, password string) *Client {
+func NewClient(sonarURL string, user string, password string, opts ...ClientOption) *Client {
 	u := strings.TrimSuffix(sonarURL, "/")
 	if !strings.HasSuffix(sonarURL, "api") {
 		u = fmt.Sprintf("%s/api", u)
 	}
 
+	r := resty.New().SetBaseURL(u).SetBasicAuth(user, password)
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
 	return &Client{
-		resty: resty.New().SetBaseURL(u).SetBasicAuth(user, password),
+		resty: r,
 	}
 }
 
@@ -69,6 +77,41 @@ func (sc *Client) startRequest(ctx context.Context) *resty.Request {
 		SetContext(ctx)
 }
 
+func (sc *Client) startJSONRequest(ctx context.Context) *resty.Request {
+	return sc.resty.R().
+		SetHeaders(map[string]string{
+			contentTypeField: jsonContentType,
+			"Accept":         jsonContentType,
+		}).
+		SetContext(ctx)
+}
+
+// startOrgRequest starts a request of the organization-scoped API.
+// The organization is sent only if it is set, SonarQube has a single default organization.
+func (sc *Client) startOrgRequest(ctx context.Context) *resty.Request {
+	req := sc.startRequest(ctx)
+
+	if sc.org != "" {
+		req.SetQueryParam(organizationField, sc.org)
+	}
+
+	return req
+}
+
+// organization returns the organization that is set with SetOrganization or the default organization of SonarQube.
+func (sc *Client) organization() string {
+	if sc.org != "" {
+		return sc.org
+	}
+
+	return "default-organization"
+}
+
+// IsSonarCloud checks if the client is connected to SonarCloud.
+func (sc *Client) IsSonarCloud() bool {
+	return sc.cloud
+}
+
 func (sc *Client) checkError(response *resty.Response, err error) error {
 	if err != nil {
 		return fmt.Errorf("response error: %w", err)
@@ -126,6 +169,11 @@ func (sc *Client) ChangePassword(ctx context.Context, user string, oldPassword s
 	// eb6741754b2b35172012bc5b30f5b0d53a61f7be#diff-be83bcff4cfc3fb4d04542ca6eea91cfe7738b7bd754d86eb366ce3e18b0aa34
 	sc.resty.SetCookies(resp.Cookies())
 
+	// the old password is not valid anymore, so the client switches to the new one
+	if sc.resty.UserInfo != nil && sc.resty.UserInfo.Username == user {
+		sc.resty.SetBasicAuth(user, newPassword)
+	}
+
 	return nil
 }
 
@@ -143,6 +191,8 @@ func (sc *Client) Reboot() error {
 	return nil
 }
 
+// SystemStatusResponse provides the server ID, version and status of SonarQube.
+// https://next.sonarqube.com/sonarqube/web_api/api/system/status
 type SystemStatusResponse struct {
 	ID      string `json:"id"`
 	Version string `json:"version"`
@@ -150,87 +200,62 @@ type SystemStatusResponse struct {
 }
 
 // WaitForStatusIsUp waits for Sonar to be up
-// It retries the request for the specified number of times with the specified timeout
-func (sc *Client) WaitForStatusIsUp(retryCount int, timeout time.Duration) error {
-	var systemStatusResponse SystemStatusResponse
-
-	sc.resty.SetRetryCount(retryCount).
-		SetRetryWaitTime(timeout).
-		AddRetryCondition(
-			func(response *resty.Response, err error) bool {
-				if response.IsError() || !response.IsSuccess() {
-					return response.IsError()
-				}
-
-				if err := json.Unmarshal([]byte(response.String()), &systemStatusResponse); err != nil {
-					return true
-				}
-
-				log.Info(fmt.Sprintf("Current Sonar status - %s", systemStatusResponse.Status))
-
-				return systemStatusResponse.Status != "UP"
-			},
-		)
-	defer sc.resty.SetRetryCount(0)
-
-	resp, err := sc.resty.R().
-		Get("/system/status")
-	if err != nil {
-		return fmt.Errorf("failed to send request for current Sonar status!: %w", err)
-	}
-
-	if resp.IsError() {
-		return fmt.Errorf("checking Sonar status failed. Response - %s", resp.Status())
-	}
-
-	return nil
-}
-
-func (sc Client) InstallPlugins(plugins []string) error {
-	installedPlugins, err := sc.GetInstalledPlugins()
-	if err != nil {
-		return fmt.Errorf("failed to get list of installed plugins: %w", err)
-	}
-
-	log.Info("List of installed plugins", "plugins", installedPlugins)
-
-	needReboot := false
-
-	for _, plugin := range plugins {
-		if helper.CheckPluginInstalled(installedPlugins, plugin) {
-			continue
+// It requests the status for the specified number of times with the specified timeout between the attempts.
+// The attempts are made without changing the retry settings of the client, so it is safe for concurrent use.
+// Waiting is stopped when the context is done.
+func (sc *Client) WaitForStatusIsUp(ctx context.Context, retryCount int, timeout time.Duration) error {
+	var lastErr error
+
+	for attempt := 0; attempt <= retryCount; attempt++ {
+		if attempt > 0 {
+			if err := waitFor(ctx, timeout); err != nil {
+				return fmt.Errorf("failed to wait for sonar status: %w", err)
+			}
 		}
 
-		needReboot = true
-
-		resp, errPost := sc.resty.R().
-			SetBody(fmt.Sprintf("key=%s", plugin)).
-			SetHeader(contentTypeField, "application/x-www-form-urlencoded").
-			Post("/plugins/install")
-		if errPost != nil {
-			return fmt.Errorf("failed to send plugin installation request for %s: %w", plugin, errPost)
+		resp, err := sc.resty.R().
+			SetContext(ctx).
+			Get("/system/status")
+		if err != nil {
+			lastErr = fmt.Errorf("failed to send request for current Sonar status!: %w", err)
+			continue
 		}
 
 		if resp.IsError() {
-			return fmt.Errorf("failed to install plugin %s, response: %s", plugin, resp.Status())
+			lastErr = fmt.Errorf("checking Sonar status failed. Response - %s", resp.Status())
+			continue
 		}
 
-		log.Info(fmt.Sprintf("Plugin %s has been installed", plugin))
-	}
+		var systemStatusResponse SystemStatusResponse
 
-	if needReboot {
-		if err = sc.Reboot(); err != nil {
-			return err
+		if err = json.Unmarshal(resp.Body(), &systemStatusResponse); err != nil {
+			lastErr = fmt.Errorf(cantUnmarshalMsg, resp.Body(), err)
+			continue
 		}
 
-		if err = sc.WaitForStatusIsUp(retryCount, timeOut*time.Second); err != nil {
-			return err
+		log.Info(fmt.Sprintf("Current Sonar status - %s", systemStatusResponse.Status))
+
+		if systemStatusResponse.Status == "UP" {
+			return nil
 		}
+
+		lastErr = fmt.Errorf("sonar is not up, current status - %s", systemStatusResponse.Status)
 	}
 
-	log.Info("Plugins have been installed")
+	return lastErr
+}
 
-	return nil
+// waitFor blocks for the given duration or until the context is done.
+func waitFor(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
 
 type InstalledPluginsResponse struct {
@@ -256,27 +281,6 @@ type Plugin struct {
 	UpdatedAt           int    `json:"updatedAt"`
 }
 
-func (sc Client) GetInstalledPlugins() ([]string, error) {
-	resp, err := sc.resty.R().Get("/plugins/installed")
-	if err = sc.checkError(resp, err); err != nil {
-		return nil, err
-	}
-
-	var installedPluginsResponse InstalledPluginsResponse
-
-	if err = json.Unmarshal(resp.Body(), &installedPluginsResponse); err != nil {
-		return nil, fmt.Errorf(cantUnmarshalMsg, resp.Body(), err)
-	}
-
-	installedPlugins := make([]string, 0, len(installedPluginsResponse.Plugins))
-
-	for index := range installedPluginsResponse.Plugins {
-		installedPlugins = append(installedPlugins, installedPluginsResponse.Plugins[index].Key)
-	}
-
-	return installedPlugins, nil
-}
-
 type QualityGatesCreateResponse struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
@@ -531,10 +535,11 @@ type WebhooksListResponse struct {
 }
 
 type Webhook struct {
-	Key    string `json:"key"`
-	Name   string `json:"name"`
-	URL    string `json:"url"`
-	Secret string `json:"secret,omitempty"`
+	Key       string `json:"key"`
+	Name      string `json:"name"`
+	URL       string `json:"url"`
+	Secret    string `json:"secret,omitempty"`
+	HasSecret bool   `json:"hasSecret,omitempty"`
 }
 
 func (sc Client) checkWebhookExist(webhookName string) (bool, error) {
@@ -620,12 +625,7 @@ type Setting struct {
 	Value       string              `json:"value,omitempty"`
 	Inherited   bool                `json:"inherited"`
 	Values      []string            `json:"values,omitempty"`
-	FieldValues []SettingFieldValue `json:"fieldValues,omitempty"`
-}
-
-type SettingFieldValue struct {
-	Boolean string `json:"boolean"`
-	Text    string `json:"text"`
+	FieldValues []map[string]string `json:"fieldValues,omitempty"`
 }
 
 func (sc Client) checkGeneralSetting(key string, valueToCheck string) (bool, error) {
@@ -681,7 +681,7 @@ func checkValue(value interface{}, valueToCheck string) bool {
 
 func (sc Client) SetProjectsDefaultVisibility(visibility string) error {
 	resp, err := sc.resty.R().
-		SetBody(fmt.Sprintf("organization=default-organization&projectVisibility=%v", visibility)).
+		SetBody(fmt.Sprintf("organization=%s&projectVisibility=%v", sc.organization(), visibility)).
 		SetHeader(contentTypeField, "application/x-www-form-urlencoded").
 		Post("/projects/update_default_visibility")
 	if err != nil {
@@ -696,6 +696,31 @@ func (sc Client) SetProjectsDefaultVisibility(visibility string) error {
 	return nil
 }
 
+// GetSettings returns the current values of the given settings.
+// Settings that are not set and have no default value are not returned.
+// If component is empty, global settings are returned, otherwise settings of the component (e.g. project key).
+func (sc *Client) GetSettings(ctx context.Context, component string, settingsKeys []string) ([]Setting, error) {
+	var settingsResp SettingsValuesResponse
+
+	keys := strings.Join(settingsKeys, ",")
+	params := map[string]string{"keys": keys}
+
+	if component != "" {
+		params[componentField] = component
+	}
+
+	rsp, err := sc.startRequest(ctx).
+		SetQueryParams(params).
+		SetResult(&settingsResp).
+		Get("/settings/values")
+
+	if err = sc.checkError(rsp, err); err != nil {
+		return nil, fmt.Errorf("failed to get settings %s: %w", keys, err)
+	}
+
+	return settingsResp.Settings, nil
+}
+
 func (sc *Client) SetSetting(ctx context.Context, setting url.Values) error {
 	rsp, err := sc.startRequest(ctx).
 		SetFormDataFromValues(setting).
@@ -707,12 +732,19 @@ func (sc *Client) SetSetting(ctx context.Context, setting url.Values) error {
 
 	return nil
 }
-func (sc *Client) ResetSettings(ctx context.Context, settingsKeys []string) error {
+
+// ResetSettings resets the given settings to their default values.
+// If component is empty, global settings are reset, otherwise settings of the component (e.g. project key).
+func (sc *Client) ResetSettings(ctx context.Context, component string, settingsKeys []string) error {
 	keys := strings.Join(settingsKeys, ",")
+	params := map[string]string{"keys": keys}
+
+	if component != "" {
+		params[componentField] = component
+	}
+
 	rsp, err := sc.startRequest(ctx).
-		SetFormData(map[string]string{
-			"keys": keys,
-		}).
+		SetFormData(params).
 		Post("/settings/reset")
 
 	if err = sc.checkError(rsp, err); err != nil {
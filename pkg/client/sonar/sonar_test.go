@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -102,115 +103,6 @@ func TestClient_Reboot(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestClient_GetInstalledPlugins_GetErr(t *testing.T) {
-	restClient := CreateMockResty()
-
-	client := Client{resty: restClient}
-	plugins, err := client.GetInstalledPlugins()
-	assert.Error(t, err)
-	assert.Nil(t, plugins)
-	assert.Contains(t, err.Error(), "no responder found")
-}
-
-func TestClient_GetInstalledPlugins_UnmarshalErr(t *testing.T) {
-	restClient := CreateMockResty()
-
-	httpmock.RegisterResponder(http.MethodGet, "https://domain/plugins/installed",
-		httpmock.NewStringResponder(http.StatusOK, ""))
-
-	client := Client{resty: restClient}
-	plugins, err := client.GetInstalledPlugins()
-	errJSON := &json.SyntaxError{}
-	assert.ErrorAs(t, err, &errJSON)
-	assert.Nil(t, plugins)
-}
-
-func TestClient_GetInstalledPlugins(t *testing.T) {
-	restClient := CreateMockResty()
-	expectedPlugin := []string{name}
-	plugin := Plugin{Key: name}
-	body := InstalledPluginsResponse{Plugins: []Plugin{plugin}}
-	raw, err := json.Marshal(body)
-	require.NoError(t, err)
-	httpmock.RegisterResponder(http.MethodGet, "https://domain/plugins/installed",
-		httpmock.NewBytesResponder(http.StatusOK, raw))
-
-	client := Client{resty: restClient}
-	plugins, err := client.GetInstalledPlugins()
-	assert.NoError(t, err)
-	assert.Equal(t, expectedPlugin, plugins)
-}
-
-func TestClient_InstallPlugins_GetInstalledPluginsErr(t *testing.T) {
-	restClient := CreateMockResty()
-	plugins := []string{name}
-
-	client := Client{resty: restClient}
-	err := client.InstallPlugins(plugins)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "no responder found")
-}
-
-func TestClient_InstallPlugins_PostErr(t *testing.T) {
-	restClient := CreateMockResty()
-	plugins := []string{"test"}
-	plugin := Plugin{Key: name}
-	body := InstalledPluginsResponse{Plugins: []Plugin{plugin}}
-	raw, err := json.Marshal(body)
-	require.NoError(t, err)
-	httpmock.RegisterResponder(http.MethodGet, "https://domain/plugins/installed",
-		httpmock.NewBytesResponder(http.StatusOK, raw))
-
-	client := Client{resty: restClient}
-	err = client.InstallPlugins(plugins)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Post")
-}
-
-func TestClient_InstallPlugins_RebootErr(t *testing.T) {
-	restClient := CreateMockResty()
-	plugins := []string{"test"}
-	plugin := Plugin{Key: name}
-	body := InstalledPluginsResponse{Plugins: []Plugin{plugin}}
-	raw, err := json.Marshal(body)
-	require.NoError(t, err)
-	httpmock.RegisterResponder(http.MethodGet, "https://domain/plugins/installed",
-		httpmock.NewBytesResponder(http.StatusOK, raw))
-	httpmock.RegisterResponder(http.MethodPost, "https://domain/plugins/install",
-		httpmock.NewStringResponder(http.StatusOK, ""))
-
-	client := Client{resty: restClient}
-	err = client.InstallPlugins(plugins)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Post")
-}
-
-func TestClient_InstallPlugins(t *testing.T) {
-	status := SystemStatusResponse{Status: "UP"}
-	rawStatus, err := json.Marshal(status)
-	require.NoError(t, err)
-
-	restClient := CreateMockResty()
-	plugins := []string{"test"}
-	plugin := Plugin{Key: name}
-	body := InstalledPluginsResponse{Plugins: []Plugin{plugin}}
-	raw, err := json.Marshal(body)
-	require.NoError(t, err)
-
-	httpmock.RegisterResponder(http.MethodGet, "https://domain/plugins/installed",
-		httpmock.NewBytesResponder(http.StatusOK, raw))
-	httpmock.RegisterResponder(http.MethodPost, "https://domain/plugins/install",
-		httpmock.NewStringResponder(http.StatusOK, ""))
-	httpmock.RegisterResponder(http.MethodPost, "https://domain/system/restart",
-		httpmock.NewStringResponder(http.StatusOK, ""))
-	httpmock.RegisterResponder(http.MethodGet, "https://domain/system/status",
-		httpmock.NewBytesResponder(http.StatusOK, rawStatus))
-
-	client := Client{resty: restClient}
-	err = client.InstallPlugins(plugins)
-	assert.NoError(t, err)
-}
-
 func TestClient_AddUserToGroup_PostErr(t *testing.T) {
 	restClient := CreateMockResty()
 	client := Client{resty: restClient}
@@ -780,7 +672,7 @@ func TestClient_ConfigureGeneralSettings(t *testing.T) {
 func TestClient_WaitForStatusIsUp(t *testing.T) {
 	sc := NewClient("", "", "")
 
-	err := sc.WaitForStatusIsUp(1, time.Nanosecond)
+	err := sc.WaitForStatusIsUp(context.Background(), 1, time.Nanosecond)
 	require.Error(t, err)
 
 	if sc.resty.RetryCount > 0 {
@@ -788,6 +680,24 @@ func TestClient_WaitForStatusIsUp(t *testing.T) {
 	}
 }
 
+func TestClient_WaitForStatusIsUp_ContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"STARTING"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	sc := NewClient(server.URL, "", "")
+
+	err := sc.WaitForStatusIsUp(ctx, 10, time.Hour)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
 func TestClient_ChangePassword(t *testing.T) {
 	t.Parallel()
 
@@ -820,3 +730,96 @@ func TestClient_ChangePassword(t *testing.T) {
 		t.Fatal("no error or wrong type")
 	}
 }
+
+func TestClient_GetSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		component      string
+		serverResponse int
+		serverBody     string
+		want           []Setting
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusOK,
+			serverBody: `{"settings":[{"key":"sonar.core.a","value":"sonar","inherited":false},` +
+				`{"key":"sonar.core.b","values":["value1","value2"]},` +
+				`{"key":"sonar.core.c","fieldValues":[{"field1":"value1"}]}]}`,
+			want: []Setting{
+				{Key: "sonar.core.a", Value: "sonar"},
+				{Key: "sonar.core.b", Values: []string{"value1", "value2"}},
+				{Key: "sonar.core.c", FieldValues: []map[string]string{{"field1": "value1"}}},
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:           "project settings",
+			component:      "my-project",
+			serverResponse: http.StatusOK,
+			serverBody:     `{"settings":[{"key":"sonar.core.a","value":"project","inherited":false}]}`,
+			want:           []Setting{{Key: "sonar.core.a", Value: "project"}},
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "server error",
+			serverResponse: http.StatusBadRequest,
+			serverBody:     `{"errors":[{"msg":"The following setting keys are not valid"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get settings sonar.core.a,sonar.core.b,sonar.core.c")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/api/settings/values", r.URL.Path)
+				assert.Equal(t, "sonar.core.a,sonar.core.b,sonar.core.c", r.URL.Query().Get("keys"))
+				assert.Equal(t, tt.component, r.URL.Query().Get("component"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err := w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(server.Close)
+
+			client := NewClient(server.URL, "user", "password")
+
+			got, err := client.GetSettings(
+				context.Background(),
+				tt.component,
+				[]string{"sonar.core.a", "sonar.core.b", "sonar.core.c"},
+			)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_ResetSettings(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/settings/reset", r.URL.Path)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "sonar.core.a,sonar.core.b", r.PostForm.Get("keys"))
+		assert.Equal(t, "my-project", r.PostForm.Get("component"))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, "user", "password")
+
+	require.NoError(t, client.ResetSettings(context.Background(), "my-project", []string{"sonar.core.a", "sonar.core.b"}))
+}
@@ -9,9 +9,31 @@ type SystemHealth struct {
 	// GREEN: SonarQube is fully operational
 	// YELLOW: SonarQube is usable, but it needs attention in order to be fully operational
 	// RED: SonarQube is not operational
-	Health string `json:"health"`
-	Causes []any  `json:"causes"`
-	Nodes  []any  `json:"nodes"`
+	Health string        `json:"health"`
+	Causes []HealthCause `json:"causes"`
+	// Nodes are returned only by the Data Center Edition.
+	Nodes []NodeHealth `json:"nodes"`
+}
+
+// HealthCause explains why the health is not GREEN.
+type HealthCause struct {
+	Message string `json:"message"`
+}
+
+// NodeHealth is the health of a node of the Data Center Edition cluster.
+type NodeHealth struct {
+	Name   string        `json:"name"`
+	Type   string        `json:"type"`
+	Host   string        `json:"host"`
+	Port   int32         `json:"port"`
+	Health string        `json:"health"`
+	Causes []HealthCause `json:"causes"`
+}
+
+// globalNavigation is the part of the global navigation response that describes the server.
+// https://next.sonarqube.com/sonarqube/web_api/api/navigation/global
+type globalNavigation struct {
+	Edition string `json:"edition"`
 }
 
 func (sc *Client) Health(ctx context.Context) (*SystemHealth, error) {
@@ -27,3 +49,34 @@ func (sc *Client) Health(ctx context.Context) (*SystemHealth, error) {
 
 	return health, nil
 }
+
+// SystemStatus returns the server ID, version and status.
+// https://next.sonarqube.com/sonarqube/web_api/api/system/status
+func (sc *Client) SystemStatus(ctx context.Context) (*SystemStatusResponse, error) {
+	status := &SystemStatusResponse{}
+
+	rsp, err := sc.startRequest(ctx).
+		SetResult(status).
+		Get("/system/status")
+
+	if err = sc.checkError(rsp, err); err != nil {
+		return nil, fmt.Errorf("failed to get system status: %w", err)
+	}
+
+	return status, nil
+}
+
+// Edition returns the edition of the server, e.g. community, developer, enterprise or datacenter.
+func (sc *Client) Edition(ctx context.Context) (string, error) {
+	nav := &globalNavigation{}
+
+	rsp, err := sc.startRequest(ctx).
+		SetResult(nav).
+		Get("/navigation/global")
+
+	if err = sc.checkError(rsp, err); err != nil {
+		return "", fmt.Errorf("failed to get edition: %w", err)
+	}
+
+	return nav.Edition, nil
+}
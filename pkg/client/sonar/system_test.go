@@ -0,0 +1,110 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Health(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/system/health", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"health":"YELLOW","causes":[{"message":"not enough search nodes"}],` +
+			`"nodes":[{"name":"search-1","type":"SEARCH","host":"10.0.0.2","port":9001,"health":"YELLOW",` +
+			`"causes":[{"message":"Elasticsearch status is YELLOW"}]}]}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	got, err := NewClient(server.URL, "user", "password").Health(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, &SystemHealth{
+		Health: "YELLOW",
+		Causes: []HealthCause{{Message: "not enough search nodes"}},
+		Nodes: []NodeHealth{{
+			Name:   "search-1",
+			Type:   "SEARCH",
+			Host:   "10.0.0.2",
+			Port:   9001,
+			Health: "YELLOW",
+			Causes: []HealthCause{{Message: "Elasticsearch status is YELLOW"}},
+		}},
+	}, got)
+}
+
+func TestClient_SystemStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		serverBody     string
+		want           *SystemStatusResponse
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusOK,
+			serverBody:     `{"id":"server-id","version":"10.5.1.90531","status":"UP"}`,
+			want:           &SystemStatusResponse{ID: "server-id", Version: "10.5.1.90531", Status: "UP"},
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "server error",
+			serverResponse: http.StatusInternalServerError,
+			serverBody:     `{"errors":[{"msg":"internal error"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get system status")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/system/status", r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err := w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			t.Cleanup(server.Close)
+
+			got, err := NewClient(server.URL, "user", "password").SystemStatus(context.Background())
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_Edition(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/navigation/global", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"edition":"developer","version":"10.5.1.90531","productionDatabase":true}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	got, err := NewClient(server.URL, "user", "password").Edition(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "developer", got)
+}
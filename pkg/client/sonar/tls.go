@@ -0,0 +1,67 @@
+package sonar
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/sourceref"
+)
+
+// GetTLSConfig returns the TLS configuration of the sonar connection.
+// If TLS is not configured, nil is returned and the default transport settings are used.
+func GetTLSConfig(ctx context.Context, k8sClient client.Client, sonar *sonarApi.Sonar) (*tls.Config, error) {
+	if sonar.Spec.TLS == nil {
+		return nil, nil
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// nolint:gosec // insecure mode is explicitly enabled by the user.
+		InsecureSkipVerify: sonar.Spec.TLS.InsecureSkipVerify,
+	}
+
+	if sonar.Spec.TLS.CA != nil {
+		ca, err := sourceref.GetValueFromSourceRef(ctx, sonar.Spec.TLS.CA, sonar.Namespace, k8sClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sonar CA bundle: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM([]byte(ca)) {
+			return nil, errors.New("sonar CA bundle doesn't contain valid PEM certificates")
+		}
+
+		config.RootCAs = pool
+	}
+
+	if sonar.Spec.TLS.ClientCertSecret != "" {
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(ctx, types.NamespacedName{
+			Name:      sonar.Spec.TLS.ClientCertSecret,
+			Namespace: sonar.Namespace,
+		}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get sonar client certificate secret: %w", err)
+		}
+
+		cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sonar client certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
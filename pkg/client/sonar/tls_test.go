@@ -0,0 +1,214 @@
+package sonar
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestGetTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	clientCertPEM, clientKeyPEM, clientCert := generateCertificate(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	server.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	serverCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	tests := []struct {
+		name       string
+		tls        *sonarApi.SonarTLS
+		objects    []client.Object
+		wantErr    require.ErrorAssertionFunc
+		wantReqErr require.ErrorAssertionFunc
+		wantCerts  int
+	}{
+		{
+			name:       "tls is not configured",
+			wantErr:    require.NoError,
+			wantReqErr: require.Error,
+		},
+		{
+			name: "CA from config map",
+			tls: &sonarApi.SonarTLS{
+				CA: &common.SourceRef{
+					ConfigMapKeyRef: &common.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "sonar-ca"},
+						Key:                  "ca.crt",
+					},
+				},
+			},
+			objects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "sonar-ca", Namespace: "default"},
+					Data:       map[string]string{"ca.crt": serverCA},
+				},
+			},
+			wantErr:    require.NoError,
+			wantReqErr: require.NoError,
+		},
+		{
+			name: "CA from secret with client certificate",
+			tls: &sonarApi.SonarTLS{
+				CA: &common.SourceRef{
+					SecretKeyRef: &common.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "sonar-ca"},
+						Key:                  "ca.crt",
+					},
+				},
+				ClientCertSecret: "sonar-client-cert",
+			},
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "sonar-ca", Namespace: "default"},
+					Data:       map[string][]byte{"ca.crt": []byte(serverCA)},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "sonar-client-cert", Namespace: "default"},
+					Data: map[string][]byte{
+						corev1.TLSCertKey:       clientCertPEM,
+						corev1.TLSPrivateKeyKey: clientKeyPEM,
+					},
+				},
+			},
+			wantErr:    require.NoError,
+			wantReqErr: require.NoError,
+			wantCerts:  1,
+		},
+		{
+			name:       "insecure skip verify",
+			tls:        &sonarApi.SonarTLS{InsecureSkipVerify: true},
+			wantErr:    require.NoError,
+			wantReqErr: require.NoError,
+		},
+		{
+			name: "invalid CA bundle",
+			tls: &sonarApi.SonarTLS{
+				CA: &common.SourceRef{
+					ConfigMapKeyRef: &common.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "sonar-ca"},
+						Key:                  "ca.crt",
+					},
+				},
+			},
+			objects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "sonar-ca", Namespace: "default"},
+					Data:       map[string]string{"ca.crt": "invalid"},
+				},
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "doesn't contain valid PEM certificates")
+			},
+		},
+		{
+			name:    "client certificate secret not found",
+			tls:     &sonarApi.SonarTLS{ClientCertSecret: "sonar-client-cert"},
+			objects: []client.Object{},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get sonar client certificate secret")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sonar := &sonarApi.Sonar{
+				ObjectMeta: metav1.ObjectMeta{Name: "sonar", Namespace: "default"},
+				Spec:       sonarApi.SonarSpec{Url: server.URL, TLS: tt.tls},
+			}
+
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+
+			config, err := GetTLSConfig(context.Background(), k8sClient, sonar)
+
+			tt.wantErr(t, err)
+
+			if tt.wantReqErr == nil {
+				return
+			}
+
+			var opts []ClientOption
+			if config != nil {
+				require.Len(t, config.Certificates, tt.wantCerts)
+
+				opts = append(opts, WithTLSConfig(config))
+			}
+
+			c := NewClient(server.URL, "admin", "password", opts...)
+
+			tt.wantReqErr(t, c.DeleteWebhook(context.Background(), "AU-key"))
+		})
+	}
+}
+
+func generateCertificate(t *testing.T) (certPEM, keyPEM []byte, cert *x509.Certificate) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "sonar-operator"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err = x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		cert
+}
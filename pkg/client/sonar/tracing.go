@@ -0,0 +1,98 @@
+package sonar
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/epam/edp-sonar-operator/pkg/tracing"
+)
+
+const redactedValue = "REDACTED"
+
+// sensitiveQueryParams are the query parameters with the values that must not be exported in the spans,
+// e.g. the setting values that may be secured.
+var sensitiveQueryParams = map[string]bool{
+	"value":       true,
+	"values":      true,
+	"fieldvalues": true,
+	"license":     true,
+}
+
+// WithTracing records a client span for every request to sonar.
+// The spans are the children of the span in the request context, e.g. the span of the chain handler.
+// The sensitive query parameters and the user info of the URL are redacted.
+func WithTracing() ClientOption {
+	return func(c *resty.Client) {
+		base := c.GetClient().Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+
+		c.SetTransport(&tracingTransport{base: base})
+	}
+}
+
+// tracingTransport records the spans of the requests sent with the base transport.
+type tracingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := tracing.Start(req.Context(), req.Method+" "+endpointFamily(req.URL.Path),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.URLFull(redactURL(req.URL)),
+			semconv.ServerAddress(req.URL.Hostname()),
+		),
+	)
+	defer span.End()
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return resp, err
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+
+	return resp, nil
+}
+
+// redactURL returns the URL without the user info and with the redacted values of the sensitive query parameters.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+
+	query := redacted.Query()
+	for name := range query {
+		if isSensitiveQueryParam(name) {
+			query.Set(name, redactedValue)
+		}
+	}
+
+	redacted.RawQuery = query.Encode()
+
+	return redacted.String()
+}
+
+func isSensitiveQueryParam(name string) bool {
+	name = strings.ToLower(name)
+
+	return sensitiveQueryParams[name] ||
+		strings.Contains(name, "password") ||
+		strings.Contains(name, "token") ||
+		strings.Contains(name, "secret")
+}
@@ -6,7 +6,16 @@ import (
 	"net/http"
 )
 
+const (
+	// usersV2Path is the v2 API of users that replaces /api/users in SonarQube 10.4.
+	usersV2Path = "/v2/users-management/users"
+	// mergePatchContentType is used by the v2 API to update resources.
+	mergePatchContentType = "application/merge-patch+json"
+)
+
 type User struct {
+	// ID is returned only by the v2 API.
+	ID       string `json:"id,omitempty"`
 	Login    string `json:"login"`
 	Name     string `json:"name"`
 	Password string `json:"password"`
@@ -17,10 +26,13 @@ type UserToken struct {
 	Login string `json:"login"`
 	Name  string `json:"name,omitempty"`
 	Token string `json:"token,omitempty"`
+	// ExpirationDate is set only for tokens with expiration.
+	ExpirationDate string `json:"expirationDate,omitempty"`
 }
 
 type userSearchResponse struct {
 	Users []User `json:"users"`
+	pageInfo
 }
 
 type createUserResponse struct {
@@ -39,19 +51,28 @@ type userTokenSearchResponse struct {
 //   - If the search query is greater than 15 characters, then the query becomes case-sensitive
 //     and will match any login, name, or email that exactly matches the search query.
 func (sc *Client) SearchUsers(ctx context.Context, userQuery string) ([]User, error) {
-	var userResponse userSearchResponse
-	rsp, err := sc.startRequest(ctx).SetResult(&userResponse).
-		SetQueryParams(map[string]string{
-			"q":  userQuery,
-			"ps": "500",
-		}).
-		Get("/users/search")
+	if sc.capabilities.UsersV2() {
+		return sc.searchUsersV2(ctx, userQuery)
+	}
 
-	if err = sc.checkError(rsp, err); err != nil {
+	users, err := searchAll(ctx, v1Pager, func(ctx context.Context, params map[string]string) ([]User, pageInfo, error) {
+		var userResponse userSearchResponse
+		rsp, err := sc.startRequest(ctx).SetResult(&userResponse).
+			SetQueryParam("q", userQuery).
+			SetQueryParams(params).
+			Get("/users/search")
+
+		if err = sc.checkError(rsp, err); err != nil {
+			return nil, pageInfo{}, err
+		}
+
+		return userResponse.Users, userResponse.pageInfo, nil
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to search for users: %w", err)
 	}
 
-	return userResponse.Users, nil
+	return users, nil
 }
 
 func (sc Client) GetUserByLogin(ctx context.Context, userLogin string) (*User, error) {
@@ -70,6 +91,10 @@ func (sc Client) GetUserByLogin(ctx context.Context, userLogin string) (*User, e
 }
 
 func (sc *Client) CreateUser(ctx context.Context, user *User) error {
+	if sc.capabilities.UsersV2() {
+		return sc.createUserV2(ctx, user)
+	}
+
 	var createUserRsp createUserResponse
 
 	rsp, err := sc.startRequest(ctx).
@@ -89,12 +114,21 @@ func (sc *Client) CreateUser(ctx context.Context, user *User) error {
 
 // UpdateUser updates the user with the given login.
 func (sc *Client) UpdateUser(ctx context.Context, user *User) error {
+	if sc.capabilities.UsersV2() {
+		return sc.updateUserV2(ctx, user)
+	}
+
+	formData := map[string]string{
+		"login": user.Login,
+		"name":  user.Name,
+	}
+
+	if user.Email != "" {
+		formData["email"] = user.Email
+	}
+
 	rsp, err := sc.startRequest(ctx).
-		SetFormData(map[string]string{
-			"login": user.Login,
-			"name":  user.Name,
-			"email": user.Email,
-		}).
+		SetFormData(formData).
 		Post("/users/update")
 	if err = sc.checkError(rsp, err); err != nil {
 		return fmt.Errorf("failed to update user: %w", err)
@@ -132,23 +166,36 @@ func (sc Client) GetUserToken(ctx context.Context, userLogin, tokenName string)
 
 // GetUserGroups returns all groups that the user is a member of.
 func (sc *Client) GetUserGroups(ctx context.Context, userLogin string) ([]Group, error) {
-	groups := &groupSearchResponse{}
-	rsp, err := sc.startRequest(ctx).
-		SetResult(groups).
-		SetQueryParams(map[string]string{
-			"login": userLogin,
-			"ps":    "500",
-		}).
-		Get("/users/groups")
+	if sc.capabilities.GroupsV2() {
+		return sc.getUserGroupsV2(ctx, userLogin)
+	}
 
-	if err = sc.checkError(rsp, err); err != nil {
+	groups, err := searchAll(ctx, v1Pager, func(ctx context.Context, params map[string]string) ([]Group, pageInfo, error) {
+		groupResponse := &groupSearchResponse{}
+		rsp, err := sc.startRequest(ctx).
+			SetResult(groupResponse).
+			SetQueryParam("login", userLogin).
+			SetQueryParams(params).
+			Get("/users/groups")
+
+		if err = sc.checkError(rsp, err); err != nil {
+			return nil, pageInfo{}, err
+		}
+
+		return groupResponse.Groups, groupResponse.pageInfo, nil
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to get user groups: %w", err)
 	}
 
-	return groups.Groups, nil
+	return groups, nil
 }
 
 func (sc *Client) DeactivateUser(ctx context.Context, userLogin string) error {
+	if sc.capabilities.UsersV2() {
+		return sc.deactivateUserV2(ctx, userLogin)
+	}
+
 	rsp, err := sc.startRequest(ctx).
 		SetFormData(map[string]string{
 			"login": userLogin,
@@ -161,3 +208,120 @@ func (sc *Client) DeactivateUser(ctx context.Context, userLogin string) error {
 
 	return nil
 }
+
+func (sc *Client) searchUsersV2(ctx context.Context, userQuery string) ([]User, error) {
+	users, err := searchAll(ctx, v2Pager, func(ctx context.Context, params map[string]string) ([]User, pageInfo, error) {
+		var userResponse userSearchResponse
+
+		rsp, err := sc.startJSONRequest(ctx).
+			SetResult(&userResponse).
+			SetQueryParam("q", userQuery).
+			SetQueryParams(params).
+			Get(usersV2Path)
+		if err = sc.checkError(rsp, err); err != nil {
+			return nil, pageInfo{}, err
+		}
+
+		return userResponse.Users, userResponse.pageInfo, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for users: %w", err)
+	}
+
+	return users, nil
+}
+
+func (sc *Client) createUserV2(ctx context.Context, user *User) error {
+	body := map[string]any{
+		"login":    user.Login,
+		"name":     user.Name,
+		"password": user.Password,
+		"local":    true,
+	}
+
+	if user.Email != "" {
+		body["email"] = user.Email
+	}
+
+	created := &User{}
+
+	rsp, err := sc.startJSONRequest(ctx).
+		SetBody(body).
+		SetResult(created).
+		Post(usersV2Path)
+	if err = sc.checkError(rsp, err); err != nil {
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+
+	user.ID = created.ID
+
+	return nil
+}
+
+func (sc *Client) updateUserV2(ctx context.Context, user *User) error {
+	current, err := sc.GetUserByLogin(ctx, user.Login)
+	if err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	// null removes the field with merge-patch, so the email is sent only if it is set.
+	body := map[string]any{
+		"name": user.Name,
+	}
+
+	if user.Email != "" {
+		body["email"] = user.Email
+	}
+
+	rsp, err := sc.startJSONRequest(ctx).
+		SetHeader(contentTypeField, mergePatchContentType).
+		SetBody(body).
+		Patch(fmt.Sprintf("%s/%s", usersV2Path, current.ID))
+	if err = sc.checkError(rsp, err); err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return nil
+}
+
+func (sc *Client) getUserGroupsV2(ctx context.Context, userLogin string) ([]Group, error) {
+	user, err := sc.GetUserByLogin(ctx, userLogin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user groups: %w", err)
+	}
+
+	groups, err := searchAll(ctx, v2Pager, func(ctx context.Context, params map[string]string) ([]Group, pageInfo, error) {
+		groupResponse := &groupSearchResponse{}
+
+		rsp, err := sc.startJSONRequest(ctx).
+			SetResult(groupResponse).
+			SetQueryParam("userId", user.ID).
+			SetQueryParams(params).
+			Get(groupsV2Path)
+		if err = sc.checkError(rsp, err); err != nil {
+			return nil, pageInfo{}, err
+		}
+
+		return groupResponse.Groups, groupResponse.pageInfo, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+func (sc *Client) deactivateUserV2(ctx context.Context, userLogin string) error {
+	user, err := sc.GetUserByLogin(ctx, userLogin)
+	if err != nil {
+		return fmt.Errorf("failed to deactivate user: %w", err)
+	}
+
+	rsp, err := sc.startJSONRequest(ctx).
+		Delete(fmt.Sprintf("%s/%s", usersV2Path, user.ID))
+	if err = sc.checkError(rsp, err); err != nil {
+		return fmt.Errorf("failed to deactivate user: %w", err)
+	}
+
+	return nil
+}
@@ -2,7 +2,10 @@ package sonar
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 
@@ -145,3 +148,76 @@ func TestSonarClient_GetUserToken(t *testing.T) {
 		"failed to search for user tokens: status: 500, body: search fatal"
 	assert.Equal(t, expectedErr, err.Error())
 }
+
+func TestClient_UsersV2(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/users-management/users", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "user1", r.URL.Query().Get("q"))
+		assert.Equal(t, "500", r.URL.Query().Get("pageSize"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"users":[{"id":"user-id","login":"user1","name":"User"}]}`))
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("POST /api/v2/users-management/users", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "create")
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"login":"user1","name":"User","password":"secret","local":true}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err = w.Write([]byte(`{"id":"user-id","login":"user1"}`))
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("PATCH /api/v2/users-management/users/user-id", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "update")
+
+		assert.Equal(t, "application/merge-patch+json", r.Header.Get("Content-Type"))
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"name": "New name"}, body)
+	})
+	mux.HandleFunc("DELETE /api/v2/users-management/users/user-id", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "deactivate")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET /api/v2/authorizations/groups", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "user-id", r.URL.Query().Get("userId"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"groups":[{"id":"group-id","name":"group1"}]}`))
+		require.NoError(t, err)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	c := NewClient(server.URL, "user", "password")
+	c.capabilities = &Capabilities{Version: Version{Major: 10, Minor: 5}}
+
+	user := &User{Login: "user1", Name: "User", Password: "secret"}
+	require.NoError(t, c.CreateUser(ctx, user))
+	assert.Equal(t, "user-id", user.ID)
+
+	got, err := c.GetUserByLogin(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, "user-id", got.ID)
+
+	require.NoError(t, c.UpdateUser(ctx, &User{Login: "user1", Name: "New name"}))
+
+	groups, err := c.GetUserGroups(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, []Group{{ID: "group-id", Name: "group1"}}, groups)
+
+	require.NoError(t, c.DeactivateUser(ctx, "user1"))
+
+	assert.Equal(t, []string{"create", "update", "deactivate"}, requests)
+}
@@ -0,0 +1,111 @@
+package sonar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type createWebhookResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+// GetWebhooks returns webhooks of the project.
+// If projectKey is empty, global webhooks are returned.
+func (sc *Client) GetWebhooks(ctx context.Context, projectKey string) ([]Webhook, error) {
+	var result WebhooksListResponse
+
+	req := sc.startOrgRequest(ctx).SetResult(&result)
+	if projectKey != "" {
+		req.SetQueryParam("project", projectKey)
+	}
+
+	resp, err := req.Get("/webhooks/list")
+	if err = sc.checkError(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	return result.Webhooks, nil
+}
+
+// GetWebhook returns the webhook by key.
+// If projectKey is empty, the webhook is searched among global webhooks.
+func (sc *Client) GetWebhook(ctx context.Context, key, projectKey string) (*Webhook, error) {
+	webhooks, err := sc.GetWebhooks(ctx, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range webhooks {
+		if webhooks[i].Key == key {
+			return &webhooks[i], nil
+		}
+	}
+
+	return nil, NewHTTPError(http.StatusNotFound, fmt.Sprintf("webhook %s not found", key))
+}
+
+// CreateWebhook creates a webhook for the project.
+// If projectKey is empty, the webhook is global.
+func (sc *Client) CreateWebhook(ctx context.Context, projectKey string, webhook *Webhook) (*Webhook, error) {
+	var result createWebhookResponse
+
+	formData := map[string]string{
+		nameField: webhook.Name,
+		"url":     webhook.URL,
+	}
+
+	if projectKey != "" {
+		formData["project"] = projectKey
+	}
+
+	if webhook.Secret != "" {
+		formData["secret"] = webhook.Secret
+	}
+
+	resp, err := sc.startOrgRequest(ctx).
+		SetFormData(formData).
+		SetResult(&result).
+		Post("/webhooks/create")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to create webhook %s: %w", webhook.Name, err)
+	}
+
+	return &result.Webhook, nil
+}
+
+// UpdateWebhook updates the webhook by key.
+// The webhook secret is removed if webhook.Secret is empty.
+func (sc *Client) UpdateWebhook(ctx context.Context, webhook *Webhook) error {
+	// the secret is always sent because sonar keeps the current secret if the field is missing.
+	resp, err := sc.startOrgRequest(ctx).
+		SetFormData(map[string]string{
+			"webhook": webhook.Key,
+			nameField: webhook.Name,
+			"url":     webhook.URL,
+			"secret":  webhook.Secret,
+		}).
+		Post("/webhooks/update")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return fmt.Errorf("failed to update webhook %s: %w", webhook.Name, err)
+	}
+
+	return nil
+}
+
+// DeleteWebhook deletes the webhook by key.
+func (sc *Client) DeleteWebhook(ctx context.Context, key string) error {
+	resp, err := sc.startOrgRequest(ctx).
+		SetFormData(map[string]string{
+			"webhook": key,
+		}).
+		Post("/webhooks/delete")
+
+	if err = sc.checkError(resp, err); err != nil {
+		return fmt.Errorf("failed to delete webhook %s: %w", key, err)
+	}
+
+	return nil
+}
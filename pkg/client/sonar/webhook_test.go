@@ -0,0 +1,273 @@
+package sonar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetWebhook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		key            string
+		projectKey     string
+		serverResponse int
+		serverBody     string
+		want           *Webhook
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "global webhook",
+			key:            "AU-key",
+			serverResponse: http.StatusOK,
+			serverBody:     `{"webhooks":[{"key":"AU-key","name":"ci","url":"https://ci.example.com","hasSecret":true}]}`,
+			want:           &Webhook{Key: "AU-key", Name: "ci", URL: "https://ci.example.com", HasSecret: true},
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "project webhook",
+			key:            "AU-key",
+			projectKey:     "project",
+			serverResponse: http.StatusOK,
+			serverBody:     `{"webhooks":[{"key":"AU-key","name":"ci","url":"https://ci.example.com"}]}`,
+			want:           &Webhook{Key: "AU-key", Name: "ci", URL: "https://ci.example.com"},
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "webhook not found",
+			key:            "AU-key",
+			serverResponse: http.StatusOK,
+			serverBody:     `{"webhooks":[{"key":"AU-other","name":"ci","url":"https://ci.example.com"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.True(t, IsErrNotFound(err))
+			},
+		},
+		{
+			name:           "server error",
+			key:            "AU-key",
+			serverResponse: http.StatusInternalServerError,
+			serverBody:     `{"errors":[{"msg":"Internal server error"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get webhooks")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/api/webhooks/list", r.URL.Path)
+				assert.Equal(t, tt.projectKey, r.URL.Query().Get("project"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err := w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "user", "password")
+
+			got, err := client.GetWebhook(context.Background(), tt.key, tt.projectKey)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_CreateWebhook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		projectKey     string
+		webhook        *Webhook
+		serverResponse int
+		serverBody     string
+		want           *Webhook
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "project webhook with secret",
+			projectKey:     "project",
+			webhook:        &Webhook{Name: "ci", URL: "https://ci.example.com", Secret: "hmac-secret"},
+			serverResponse: http.StatusOK,
+			serverBody:     `{"webhook":{"key":"AU-key","name":"ci","url":"https://ci.example.com"}}`,
+			want:           &Webhook{Key: "AU-key", Name: "ci", URL: "https://ci.example.com"},
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "global webhook",
+			webhook:        &Webhook{Name: "ci", URL: "https://ci.example.com"},
+			serverResponse: http.StatusOK,
+			serverBody:     `{"webhook":{"key":"AU-key","name":"ci","url":"https://ci.example.com"}}`,
+			want:           &Webhook{Key: "AU-key", Name: "ci", URL: "https://ci.example.com"},
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "invalid url",
+			webhook:        &Webhook{Name: "ci", URL: "invalid"},
+			serverResponse: http.StatusBadRequest,
+			serverBody:     `{"errors":[{"msg":"Url parameter with value 'invalid' is not a valid URL"}]}`,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to create webhook ci")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/api/webhooks/create", r.URL.Path)
+
+				err := r.ParseForm()
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.webhook.Name, r.FormValue("name"))
+				assert.Equal(t, tt.webhook.URL, r.FormValue("url"))
+				assert.Equal(t, tt.webhook.Secret, r.FormValue("secret"))
+				assert.Equal(t, tt.projectKey, r.FormValue("project"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.serverResponse)
+				_, err = w.Write([]byte(tt.serverBody))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "user", "password")
+
+			got, err := client.CreateWebhook(context.Background(), tt.projectKey, tt.webhook)
+
+			tt.wantErr(t, err)
+
+			if tt.want != nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_UpdateWebhook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		webhook        *Webhook
+		serverResponse int
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			webhook:        &Webhook{Key: "AU-key", Name: "ci", URL: "https://ci.example.com", Secret: "hmac-secret"},
+			serverResponse: http.StatusNoContent,
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "secret is removed",
+			webhook:        &Webhook{Key: "AU-key", Name: "ci", URL: "https://ci.example.com"},
+			serverResponse: http.StatusNoContent,
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "webhook not found",
+			webhook:        &Webhook{Key: "AU-key", Name: "ci", URL: "https://ci.example.com"},
+			serverResponse: http.StatusNotFound,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.True(t, IsErrNotFound(err))
+				require.Contains(t, err.Error(), "failed to update webhook ci")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/api/webhooks/update", r.URL.Path)
+
+				err := r.ParseForm()
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.webhook.Key, r.FormValue("webhook"))
+				assert.Equal(t, tt.webhook.Name, r.FormValue("name"))
+				assert.Equal(t, tt.webhook.URL, r.FormValue("url"))
+				assert.Equal(t, tt.webhook.Secret, r.FormValue("secret"))
+				assert.Contains(t, r.PostForm, "secret")
+
+				w.WriteHeader(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "user", "password")
+
+			tt.wantErr(t, client.UpdateWebhook(context.Background(), tt.webhook))
+		})
+	}
+}
+
+func TestClient_DeleteWebhook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		serverResponse int
+		wantErr        require.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			serverResponse: http.StatusNoContent,
+			wantErr:        require.NoError,
+		},
+		{
+			name:           "server error",
+			serverResponse: http.StatusInternalServerError,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to delete webhook AU-key")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/api/webhooks/delete", r.URL.Path)
+
+				err := r.ParseForm()
+				require.NoError(t, err)
+
+				assert.Equal(t, "AU-key", r.FormValue("webhook"))
+
+				w.WriteHeader(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "user", "password")
+
+			tt.wantErr(t, client.DeleteWebhook(context.Background(), "AU-key"))
+		})
+	}
+}
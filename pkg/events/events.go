@@ -0,0 +1,129 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+)
+
+// Component is the source component of the events.
+const Component = "sonar-operator"
+
+// Reasons of the Normal events of the changes made in sonar.
+const (
+	ReasonCreated     = "Created"
+	ReasonUpdated     = "Updated"
+	ReasonDeleted     = "Deleted"
+	ReasonAdded       = "Added"
+	ReasonRemoved     = "Removed"
+	ReasonActivated   = "Activated"
+	ReasonDeactivated = "Deactivated"
+)
+
+// Reasons of the Warning events of the failures, they match the reasons of the conditions.
+const (
+	ReasonSyncFailed          = common.ReasonSyncFailed
+	ReasonConnectionFailed    = common.ReasonConnectionFailed
+	ReasonNamespaceNotAllowed = common.ReasonNamespaceNotAllowed
+	ReasonNotSupported        = common.ReasonNotSupported
+	ReasonDeletionFailed      = "DeletionFailed"
+	ReasonCleanupSkipped      = "CleanupSkipped"
+)
+
+type recorderKey struct{}
+
+// IntoContext returns the context with the event recorder.
+func IntoContext(ctx context.Context, recorder record.EventRecorder) context.Context {
+	return context.WithValue(ctx, recorderKey{}, recorder)
+}
+
+// FromContext returns the event recorder from the context.
+// If the context has no recorder, e.g. in the tests of the handlers, the events are discarded.
+func FromContext(ctx context.Context) record.EventRecorder {
+	if recorder, ok := ctx.Value(recorderKey{}).(record.EventRecorder); ok {
+		return recorder
+	}
+
+	return discardRecorder{}
+}
+
+// Normal records the Normal event of the change made in sonar for the resource.
+func Normal(ctx context.Context, obj runtime.Object, reason, messageFmt string, args ...any) {
+	FromContext(ctx).Eventf(obj, corev1.EventTypeNormal, reason, messageFmt, args...)
+}
+
+// Warning records the Warning event of the failure for the resource.
+func Warning(ctx context.Context, obj runtime.Object, reason string, err error) {
+	FromContext(ctx).Event(obj, corev1.EventTypeWarning, reason, err.Error())
+}
+
+// ConnectionFailed records the Warning event of the failed connection to sonar for the resource.
+// The reason is the same as the reason of the SonarConnected condition.
+func ConnectionFailed(ctx context.Context, obj runtime.Object, err error) {
+	reason := ReasonConnectionFailed
+	if errors.Is(err, common.ErrNamespaceNotAllowed) {
+		reason = ReasonNamespaceNotAllowed
+	}
+
+	Warning(ctx, obj, reason, err)
+}
+
+// NewReconciler returns the reconciler that passes the event recorder to the reconcile in the context.
+func NewReconciler(recorder record.EventRecorder, r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		return r.Reconcile(IntoContext(ctx, recorder), req)
+	})
+}
+
+// Redirect returns the event recorder that records the events of the from object for the to object.
+// It is used to record the events of the Sonar view of ClusterSonar for ClusterSonar.
+func Redirect(recorder record.EventRecorder, from, to runtime.Object) record.EventRecorder {
+	return &redirectRecorder{EventRecorder: recorder, from: from, to: to}
+}
+
+type redirectRecorder struct {
+	record.EventRecorder
+	from runtime.Object
+	to   runtime.Object
+}
+
+func (r *redirectRecorder) object(obj runtime.Object) runtime.Object {
+	if obj == r.from {
+		return r.to
+	}
+
+	return obj
+}
+
+func (r *redirectRecorder) Event(obj runtime.Object, eventtype, reason, message string) {
+	r.EventRecorder.Event(r.object(obj), eventtype, reason, message)
+}
+
+func (r *redirectRecorder) Eventf(obj runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.Eventf(r.object(obj), eventtype, reason, messageFmt, args...)
+}
+
+func (r *redirectRecorder) AnnotatedEventf(
+	obj runtime.Object,
+	annotations map[string]string,
+	eventtype, reason, messageFmt string,
+	args ...interface{},
+) {
+	r.EventRecorder.AnnotatedEventf(r.object(obj), annotations, eventtype, reason, messageFmt, args...)
+}
+
+// discardRecorder is the event recorder that discards the events.
+type discardRecorder struct{}
+
+func (discardRecorder) Event(runtime.Object, string, string, string) {}
+
+func (discardRecorder) Eventf(runtime.Object, string, string, string, ...interface{}) {}
+
+func (discardRecorder) AnnotatedEventf(runtime.Object, map[string]string, string, string, string, ...interface{}) {
+}
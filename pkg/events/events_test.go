@@ -0,0 +1,130 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+)
+
+func TestEvents(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		record func(ctx context.Context, obj *sonarApi.SonarGroup)
+		want   string
+	}{
+		{
+			name: "normal",
+			record: func(ctx context.Context, obj *sonarApi.SonarGroup) {
+				Normal(ctx, obj, ReasonCreated, "Group %s has been created", "developers")
+			},
+			want: "Normal Created Group developers has been created",
+		},
+		{
+			name: "warning",
+			record: func(ctx context.Context, obj *sonarApi.SonarGroup) {
+				Warning(ctx, obj, ReasonSyncFailed, errors.New("failed to create group"))
+			},
+			want: "Warning SyncFailed failed to create group",
+		},
+		{
+			name: "connection failed",
+			record: func(ctx context.Context, obj *sonarApi.SonarGroup) {
+				ConnectionFailed(ctx, obj, errors.New("connection refused"))
+			},
+			want: "Warning ConnectionFailed connection refused",
+		},
+		{
+			name: "namespace not allowed",
+			record: func(ctx context.Context, obj *sonarApi.SonarGroup) {
+				ConnectionFailed(ctx, obj, fmt.Errorf("failed to get sonar: %w", common.ErrNamespaceNotAllowed))
+			},
+			want: "Warning NamespaceNotAllowed failed to get sonar: " + common.ErrNamespaceNotAllowed.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := record.NewFakeRecorder(1)
+
+			tt.record(IntoContext(context.Background(), recorder), &sonarApi.SonarGroup{})
+
+			require.Len(t, recorder.Events, 1)
+			assert.Equal(t, tt.want, <-recorder.Events)
+		})
+	}
+}
+
+func TestFromContext_Discard(t *testing.T) {
+	t.Parallel()
+
+	assert.NotPanics(t, func() {
+		Normal(context.Background(), &sonarApi.SonarGroup{}, ReasonDeleted, "Group has been deleted")
+	})
+}
+
+func TestNewReconciler(t *testing.T) {
+	t.Parallel()
+
+	recorder := record.NewFakeRecorder(1)
+
+	r := NewReconciler(recorder, reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+		Normal(ctx, &sonarApi.SonarUser{}, ReasonCreated, "User has been created")
+
+		return reconcile.Result{}, nil
+	}))
+
+	_, err := r.Reconcile(context.Background(), reconcile.Request{})
+	require.NoError(t, err)
+
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Normal Created User has been created", <-recorder.Events)
+}
+
+func TestRedirect(t *testing.T) {
+	t.Parallel()
+
+	sonar := &sonarApi.Sonar{}
+	clusterSonar := &sonarApi.ClusterSonar{}
+	other := &sonarApi.Sonar{}
+
+	recorder := &objectRecorder{FakeRecorder: record.NewFakeRecorder(2)}
+
+	ctx := IntoContext(context.Background(), Redirect(recorder, sonar, clusterSonar))
+
+	Normal(ctx, sonar, ReasonUpdated, "Plugin %s has been installed", "java")
+	Warning(ctx, other, ReasonSyncFailed, errors.New("failed"))
+
+	require.Len(t, recorder.objects, 2)
+	assert.Same(t, clusterSonar, recorder.objects[0], "events of the from object must be redirected")
+	assert.Same(t, other, recorder.objects[1], "events of other objects must not be redirected")
+}
+
+// objectRecorder is the fake recorder that also records the objects of the events.
+type objectRecorder struct {
+	*record.FakeRecorder
+	objects []runtime.Object
+}
+
+func (r *objectRecorder) Event(obj runtime.Object, eventtype, reason, message string) {
+	r.objects = append(r.objects, obj)
+	r.FakeRecorder.Event(obj, eventtype, reason, message)
+}
+
+func (r *objectRecorder) Eventf(obj runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.objects = append(r.objects, obj)
+	r.FakeRecorder.Eventf(obj, eventtype, reason, messageFmt, args...)
+}
@@ -4,23 +4,50 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 const (
-	watchNamespaceEnvVar   = "WATCH_NAMESPACE"
-	debugModeEnvVar        = "DEBUG_MODE"
-	inClusterNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
-	FinalizerName          = "edp.epam.com/finalizer"
+	watchNamespaceEnvVar    = "WATCH_NAMESPACE"
+	operatorNamespaceEnvVar = "OPERATOR_NAMESPACE"
+	debugModeEnvVar         = "DEBUG_MODE"
+	inClusterNamespacePath  = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+	FinalizerName           = "edp.epam.com/finalizer"
 )
 
-// GetWatchNamespace returns the namespace the operator should be watching for changes.
-func GetWatchNamespace() (string, error) {
+// GetWatchNamespaces returns the namespaces the operator should be watching for changes.
+// The namespaces are separated by commas, an empty value means all namespaces.
+func GetWatchNamespaces() ([]string, error) {
 	ns, found := os.LookupEnv(watchNamespaceEnvVar)
 	if !found {
-		return "", fmt.Errorf("%s must be set", watchNamespaceEnvVar)
+		return nil, fmt.Errorf("%s must be set", watchNamespaceEnvVar)
 	}
 
-	return ns, nil
+	namespaces := strings.Split(ns, ",")
+	for i := range namespaces {
+		namespaces[i] = strings.TrimSpace(namespaces[i])
+	}
+
+	return namespaces, nil
+}
+
+// GetOperatorNamespace returns the namespace where the operator is running.
+// The namespace can be set with OPERATOR_NAMESPACE, it is required if the operator is running outside the cluster.
+func GetOperatorNamespace() (string, error) {
+	if ns := os.Getenv(operatorNamespaceEnvVar); ns != "" {
+		return ns, nil
+	}
+
+	ns, err := os.ReadFile(inClusterNamespacePath)
+	if err == nil {
+		return strings.TrimSpace(string(ns)), nil
+	}
+
+	if !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to read operator namespace: %w", err)
+	}
+
+	return "", fmt.Errorf("%s must be set when the operator is running outside the cluster", operatorNamespaceEnvVar)
 }
 
 // GetDebugMode returns the debug mode value.
@@ -53,16 +80,6 @@ func FileExists(filename string) bool {
 	return !info.IsDir()
 }
 
-func CheckPluginInstalled(pluginsList []string, plugin string) bool {
-	for i := range pluginsList {
-		if pluginsList[i] == plugin {
-			return true
-		}
-	}
-
-	return false
-}
-
 // SliceToMap converts slice to map.
 func SliceToMap[T comparable](s []T) map[T]struct{} {
 	m := make(map[T]struct{}, len(s))
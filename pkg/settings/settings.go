@@ -0,0 +1,368 @@
+// Package settings applies sonar settings from custom resources and tracks them in the resource status.
+package settings
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/events"
+	"github.com/epam/edp-sonar-operator/pkg/sourceref"
+)
+
+// Syncer applies settings to sonar.
+// It writes only settings whose values in sonar differ from the spec,
+// resets settings that were removed from the spec and records settings that were changed outside the operator.
+type Syncer struct {
+	sonarApiClient sonar.Settings
+	k8sClient      client.Client
+}
+
+func NewSyncer(sonarApiClient sonar.Settings, k8sClient client.Client) *Syncer {
+	return &Syncer{sonarApiClient: sonarApiClient, k8sClient: k8sClient}
+}
+
+// Sync applies the settings to sonar and updates the status.
+// If component is empty, the settings are global, otherwise they are the settings of the component (e.g. project key).
+// Values of the settings from ValueRef are taken from the given namespace.
+// The events of the changed settings are recorded for the owner resource of the settings.
+func (sr *Syncer) Sync(
+	ctx context.Context,
+	owner runtime.Object,
+	component, namespace string,
+	settings []sonarApi.SonarSetting,
+	status *sonarApi.SettingsStatus,
+) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	currentSettings, err := sr.getCurrentSettings(ctx, component, settings)
+	if err != nil {
+		return err
+	}
+
+	// if the user removes a setting from the CR, we need to reset it in Sonar
+	settingsToReset := getProcessedSettingsMap(status)
+	// we need to save processed settings to know which settings we need to reset
+	processedSettings := make([]sonarApi.ProcessedSetting, 0, len(settings))
+	driftedSettings := make([]string, 0)
+
+	var errs []error
+
+	for _, s := range settings {
+		previous := settingsToReset[s.Key]
+		delete(settingsToReset, s.Key)
+
+		processed, drifted, applyErr := sr.applySetting(ctx, owner, s, component, namespace, previous, currentSettings)
+		if applyErr != nil {
+			errs = append(errs, applyErr)
+		}
+
+		if drifted {
+			driftedSettings = append(driftedSettings, s.Key)
+		}
+
+		processedSettings = append(processedSettings, processed)
+	}
+
+	if len(settingsToReset) != 0 {
+		keys := settingsKeysMapToSlice(settingsToReset)
+
+		resetErr := sr.sonarApiClient.ResetSettings(ctx, component, keys)
+		if resetErr == nil {
+			events.Normal(ctx, owner, events.ReasonRemoved, "Settings %s have been reset", strings.Join(keys, ", "))
+		} else {
+			errs = append(errs, fmt.Errorf("failed to reset settings: %w", resetErr))
+
+			// keep the settings in the status to reset them during the next reconciliation
+			for _, s := range settingsToReset {
+				s.Error = resetErr.Error()
+				processedSettings = append(processedSettings, s)
+			}
+		}
+	}
+
+	setProcessedSettings(status, processedSettings)
+
+	if len(driftedSettings) != 0 {
+		log.Info("Sonar settings were changed outside the operator and have been corrected", "keys", driftedSettings)
+
+		status.SettingsDrift = &sonarApi.SettingsDrift{
+			Keys:           driftedSettings,
+			CorrectionTime: metav1.Now(),
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// applySetting sets the setting in sonar if its current value differs from the spec.
+// It returns the processed setting for the status and whether the setting was changed outside the operator.
+func (sr *Syncer) applySetting(
+	ctx context.Context,
+	owner runtime.Object,
+	s sonarApi.SonarSetting,
+	component, namespace string,
+	previous sonarApi.ProcessedSetting,
+	currentSettings map[string]sonar.Setting,
+) (sonarApi.ProcessedSetting, bool, error) {
+	processed := previous
+	processed.Key = s.Key
+	processed.Source = getSettingSource(s)
+
+	setting, err := sr.makeSetting(ctx, s, namespace)
+	if err != nil {
+		processed.Error = err.Error()
+
+		return processed, false, err
+	}
+
+	valueHash := hashSetting(setting)
+	if isSecretSetting(s) {
+		valueHash = hashSecretSetting(setting, ownerUID(owner))
+	}
+
+	if component != "" {
+		setting.Set("component", component)
+	}
+
+	var needsUpdate bool
+
+	if isSecuredSetting(s.Key) {
+		// sonar doesn't return values of secured settings, so we set them only if the spec is changed
+		needsUpdate = previous.ValueHash != valueHash || previous.Error != ""
+	} else {
+		current, found := currentSettings[s.Key]
+		needsUpdate = !found || settingChanged(setting, current)
+	}
+
+	if needsUpdate {
+		if err = sr.sonarApiClient.SetSetting(ctx, setting); err != nil {
+			processed.Error = err.Error()
+
+			return processed, false, fmt.Errorf("failed to set setting %s: %w", s.Key, err)
+		}
+
+		events.Normal(ctx, owner, events.ReasonUpdated, "Setting %s has been updated", s.Key)
+	}
+
+	// the setting has drifted if the value from the spec was already applied, but sonar has a different one.
+	// A retry of a failed update is not a drift because the value was not applied.
+	drifted := needsUpdate && !isSecuredSetting(s.Key) && previous.ValueHash == valueHash &&
+		previous.Error == "" && previous.LastAppliedTime != nil
+
+	if needsUpdate || previous.ValueHash != valueHash || previous.LastAppliedTime == nil {
+		now := metav1.Now()
+		processed.LastAppliedTime = &now
+	}
+
+	processed.ValueHash = valueHash
+	processed.Error = ""
+
+	return processed, drifted, nil
+}
+
+// getCurrentSettings returns the current values of the given settings from sonar.
+// Secured settings are skipped because sonar doesn't return their values.
+func (sr *Syncer) getCurrentSettings(
+	ctx context.Context,
+	component string,
+	settings []sonarApi.SonarSetting,
+) (map[string]sonar.Setting, error) {
+	keys := make([]string, 0, len(settings))
+
+	for _, s := range settings {
+		if !isSecuredSetting(s.Key) {
+			keys = append(keys, s.Key)
+		}
+	}
+
+	current := make(map[string]sonar.Setting, len(keys))
+
+	if len(keys) == 0 {
+		return current, nil
+	}
+
+	sonarSettings, err := sr.sonarApiClient.GetSettings(ctx, component, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current settings: %w", err)
+	}
+
+	for _, s := range sonarSettings {
+		// sonar returns global values for settings that are not set on the component,
+		// so they are treated as not set to apply the spec value to the component.
+		if component != "" && s.Inherited {
+			continue
+		}
+
+		current[s.Key] = s
+	}
+
+	return current, nil
+}
+
+// settingChanged checks if the setting in sonar differs from the setting built by makeSetting.
+func settingChanged(setting url.Values, current sonar.Setting) bool {
+	if setting.Has("fieldValues") {
+		currentFieldValues := make([]string, 0, len(current.FieldValues))
+
+		for _, fv := range current.FieldValues {
+			// nolint:errchkjson //we can skip error for marshal map[string]string
+			v, _ := json.Marshal(fv)
+			currentFieldValues = append(currentFieldValues, string(v))
+		}
+
+		return !slices.Equal(setting["fieldValues"], currentFieldValues)
+	}
+
+	if setting.Has("values") {
+		return !slices.Equal(setting["values"], current.Values)
+	}
+
+	// sonar returns a multi-value setting as values even if it was set with a single comma-separated value
+	if current.Values != nil {
+		return setting.Get("value") != strings.Join(current.Values, ",")
+	}
+
+	return setting.Get("value") != current.Value
+}
+
+func isSecuredSetting(key string) bool {
+	return strings.HasSuffix(key, ".secured")
+}
+
+// isSecretSetting checks if the value of the setting is a secret, i.e. the setting is secured or its value is from a secret.
+func isSecretSetting(setting sonarApi.SonarSetting) bool {
+	return isSecuredSetting(setting.Key) || (setting.ValueRef != nil && setting.ValueRef.SecretKeyRef != nil)
+}
+
+func ownerUID(owner runtime.Object) types.UID {
+	if o, ok := owner.(metav1.Object); ok {
+		return o.GetUID()
+	}
+
+	return ""
+}
+
+// getProcessedSettingsMap returns the processed settings from the status by their keys.
+func getProcessedSettingsMap(status *sonarApi.SettingsStatus) map[string]sonarApi.ProcessedSetting {
+	m := make(map[string]sonarApi.ProcessedSetting, len(status.Settings))
+
+	for _, s := range status.Settings {
+		m[s.Key] = s
+	}
+
+	return m
+}
+
+func settingsKeysMapToSlice(m map[string]sonarApi.ProcessedSetting) []string {
+	s := make([]string, 0, len(m))
+
+	for k := range m {
+		s = append(s, k)
+	}
+
+	// sort keys to send the same request to sonar every time
+	sort.Strings(s)
+
+	return s
+}
+
+func getSettingSource(setting sonarApi.SonarSetting) string {
+	switch {
+	case setting.FieldValues != nil:
+		return sonarApi.SettingSourceFieldValues
+	case setting.Values != nil:
+		return sonarApi.SettingSourceValues
+	case setting.ValueRef != nil:
+		return sonarApi.SettingSourceValueRef
+	default:
+		return sonarApi.SettingSourceInline
+	}
+}
+
+// hashSetting returns the SHA-256 hash of the setting built by makeSetting.
+func hashSetting(setting url.Values) string {
+	sum := sha256.Sum256([]byte(setting.Encode()))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// hashSecretSetting returns the HMAC-SHA-256 of the setting built by makeSetting keyed on the UID of the owner resource.
+// Unlike the plain hash, it can't be brute-forced to reveal a short secret by anyone who can read the status.
+func hashSecretSetting(setting url.Values, uid types.UID) string {
+	mac := hmac.New(sha256.New, []byte(uid))
+	mac.Write([]byte(setting.Encode()))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (sr *Syncer) makeSetting(
+	ctx context.Context,
+	setting sonarApi.SonarSetting,
+	namespace string,
+) (url.Values, error) {
+	if setting.FieldValues != nil {
+		// nolint:errchkjson //we can skip error for marshal map[string]string
+		fv, _ := json.Marshal(setting.FieldValues)
+
+		return url.Values{
+			"key":         []string{setting.Key},
+			"fieldValues": []string{string(fv)},
+		}, nil
+	}
+
+	if setting.Values != nil {
+		return url.Values{
+			"key":    []string{setting.Key},
+			"values": setting.Values,
+		}, nil
+	}
+
+	if setting.ValueRef != nil {
+		val, err := sourceref.GetValueFromSourceRef(ctx, setting.ValueRef, namespace, sr.k8sClient)
+		if err != nil {
+			return url.Values{}, fmt.Errorf("failed to get sonar setting from source ref: %w", err)
+		}
+
+		return newSettingValue(setting.Key, val), nil
+	}
+
+	return newSettingValue(setting.Key, setting.Value), nil
+}
+
+func newSettingValue(key, value string) url.Values {
+	return url.Values{
+		"key":   []string{key},
+		"value": []string{value},
+	}
+}
+
+func setProcessedSettings(status *sonarApi.SettingsStatus, settings []sonarApi.ProcessedSetting) {
+	// we need to sort settings to make sure that we have the same order of settings in the status
+	// to not update Settings field every time
+	sort.Slice(settings, func(i, j int) bool {
+		return settings[i].Key < settings[j].Key
+	})
+
+	if len(settings) == 0 {
+		settings = nil
+	}
+
+	status.Settings = settings
+}
@@ -0,0 +1,535 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-sonar-operator/api/common"
+	sonarApi "github.com/epam/edp-sonar-operator/api/v1alpha1"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar"
+	"github.com/epam/edp-sonar-operator/pkg/client/sonar/mocks"
+)
+
+func TestSyncer_Sync(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	appliedTime := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	applied := func(key, source string, setting url.Values) sonarApi.ProcessedSetting {
+		return sonarApi.ProcessedSetting{
+			Key:             key,
+			ValueHash:       hashSetting(setting),
+			Source:          source,
+			LastAppliedTime: &appliedTime,
+		}
+	}
+
+	owner := &sonarApi.Sonar{ObjectMeta: metav1.ObjectMeta{UID: "sonar-uid"}}
+
+	appliedSecret := func(key, source string, setting url.Values) sonarApi.ProcessedSetting {
+		s := applied(key, source, setting)
+		s.ValueHash = hashSecretSetting(setting, owner.UID)
+
+		return s
+	}
+
+	secretSetting := sonarApi.SonarSetting{
+		Key: "sonar.secret",
+		ValueRef: &common.SourceRef{
+			SecretKeyRef: &common.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: "sonar-secret",
+				},
+				Key: "secret-key",
+			},
+		},
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sonar-secret",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{"secret-key": []byte("secret-value")},
+	}
+
+	tests := []struct {
+		name           string
+		component      string
+		settings       []sonarApi.SonarSetting
+		status         sonarApi.SettingsStatus
+		sonarApiClient func(t *testing.T) sonar.Settings
+		k8sClient      func(t *testing.T) client.Client
+		wantErr        require.ErrorAssertionFunc
+		wantSettings   []sonarApi.ProcessedSetting
+		wantDrift      []string
+	}{
+		{
+			name: "settings are set",
+			settings: []sonarApi.SonarSetting{
+				{
+					Key:   "sonar.core.a",
+					Value: "sonar",
+				},
+				{
+					Key:    "sonar.core.b",
+					Values: []string{"value1", "value2"},
+				},
+				{
+					Key: "sonar.core.c",
+					FieldValues: map[string]string{
+						"field1": "value1",
+						"field2": "value2",
+					},
+				},
+				secretSetting,
+			},
+			sonarApiClient: func(t *testing.T) sonar.Settings {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "",
+					[]string{"sonar.core.a", "sonar.core.b", "sonar.core.c", "sonar.secret"}).
+					Return([]sonar.Setting{{Key: "sonar.core.a", Value: "default", Inherited: true}}, nil)
+				m.On("SetSetting", mock.Anything, mock.Anything).
+					Return(nil).Times(4)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+			},
+			wantErr: require.NoError,
+			wantSettings: []sonarApi.ProcessedSetting{
+				{Key: "sonar.core.a", Source: sonarApi.SettingSourceInline},
+				{Key: "sonar.core.b", Source: sonarApi.SettingSourceValues},
+				{Key: "sonar.core.c", Source: sonarApi.SettingSourceFieldValues},
+				{Key: "sonar.secret", Source: sonarApi.SettingSourceValueRef},
+			},
+		},
+		{
+			name:      "project settings are set",
+			component: "my-project",
+			settings: []sonarApi.SonarSetting{
+				{
+					Key:   "sonar.coverage.exclusions",
+					Value: "**/*_test.go",
+				},
+			},
+			status: sonarApi.SettingsStatus{
+				Settings: []sonarApi.ProcessedSetting{
+					applied("sonar.exclusions", sonarApi.SettingSourceInline,
+						newSettingValue("sonar.exclusions", "**/vendor/**")),
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.Settings {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "my-project", []string{"sonar.coverage.exclusions"}).
+					Return([]sonar.Setting{{Key: "sonar.coverage.exclusions", Value: "**/*.js", Inherited: true}}, nil)
+				m.On("SetSetting", mock.Anything, url.Values{
+					"key":       {"sonar.coverage.exclusions"},
+					"value":     {"**/*_test.go"},
+					"component": {"my-project"},
+				}).
+					Return(nil)
+				m.On("ResetSettings", mock.Anything, "my-project", []string{"sonar.exclusions"}).
+					Return(nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: require.NoError,
+			wantSettings: []sonarApi.ProcessedSetting{
+				{Key: "sonar.coverage.exclusions", Source: sonarApi.SettingSourceInline},
+			},
+		},
+		{
+			name:      "project setting equal to inherited global value is set",
+			component: "my-project",
+			settings: []sonarApi.SonarSetting{
+				{
+					Key:   "sonar.coverage.exclusions",
+					Value: "**/*_test.go",
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.Settings {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "my-project", []string{"sonar.coverage.exclusions"}).
+					Return([]sonar.Setting{{Key: "sonar.coverage.exclusions", Value: "**/*_test.go", Inherited: true}}, nil)
+				m.On("SetSetting", mock.Anything, url.Values{
+					"key":       {"sonar.coverage.exclusions"},
+					"value":     {"**/*_test.go"},
+					"component": {"my-project"},
+				}).
+					Return(nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: require.NoError,
+			wantSettings: []sonarApi.ProcessedSetting{
+				{Key: "sonar.coverage.exclusions", Source: sonarApi.SettingSourceInline},
+			},
+		},
+		{
+			name: "settings are up to date",
+			settings: []sonarApi.SonarSetting{
+				{
+					Key:   "sonar.core.a",
+					Value: "value1,value2",
+				},
+				{
+					Key: "sonar.core.c",
+					FieldValues: map[string]string{
+						"field1": "value1",
+						"field2": "value2",
+					},
+				},
+				secretSetting,
+				{
+					Key:   "sonar.auth.secured",
+					Value: "secret",
+				},
+			},
+			status: sonarApi.SettingsStatus{
+				Settings: []sonarApi.ProcessedSetting{
+					appliedSecret("sonar.auth.secured", sonarApi.SettingSourceInline,
+						newSettingValue("sonar.auth.secured", "secret")),
+					applied("sonar.core.a", sonarApi.SettingSourceInline,
+						newSettingValue("sonar.core.a", "value1,value2")),
+					applied("sonar.core.c", sonarApi.SettingSourceFieldValues, url.Values{
+						"key":         {"sonar.core.c"},
+						"fieldValues": {`{"field1":"value1","field2":"value2"}`},
+					}),
+					appliedSecret("sonar.secret", sonarApi.SettingSourceValueRef,
+						newSettingValue("sonar.secret", "secret-value")),
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.Settings {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "", []string{"sonar.core.a", "sonar.core.c", "sonar.secret"}).
+					Return([]sonar.Setting{
+						{Key: "sonar.core.a", Values: []string{"value1", "value2"}},
+						{Key: "sonar.core.c", FieldValues: []map[string]string{{"field1": "value1", "field2": "value2"}}},
+						{Key: "sonar.secret", Value: "secret-value"},
+					}, nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+			},
+			wantErr: require.NoError,
+			wantSettings: []sonarApi.ProcessedSetting{
+				{Key: "sonar.auth.secured", Source: sonarApi.SettingSourceInline},
+				{Key: "sonar.core.a", Source: sonarApi.SettingSourceInline},
+				{Key: "sonar.core.c", Source: sonarApi.SettingSourceFieldValues},
+				{Key: "sonar.secret", Source: sonarApi.SettingSourceValueRef},
+			},
+		},
+		{
+			name: "drifted settings are corrected",
+			settings: []sonarApi.SonarSetting{
+				{
+					Key:   "sonar.core.a",
+					Value: "sonar",
+				},
+				{
+					Key:    "sonar.core.b",
+					Values: []string{"value1", "value2"},
+				},
+				{
+					Key:   "sonar.core.new",
+					Value: "new",
+				},
+			},
+			status: sonarApi.SettingsStatus{
+				Settings: []sonarApi.ProcessedSetting{
+					applied("sonar.core.a", sonarApi.SettingSourceInline, newSettingValue("sonar.core.a", "sonar")),
+					applied("sonar.core.b", sonarApi.SettingSourceValues, url.Values{
+						"key":    {"sonar.core.b"},
+						"values": {"value1", "value2"},
+					}),
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.Settings {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "", []string{"sonar.core.a", "sonar.core.b", "sonar.core.new"}).
+					Return([]sonar.Setting{
+						{Key: "sonar.core.a", Value: "changed"},
+						{Key: "sonar.core.b", Values: []string{"value1"}},
+					}, nil)
+				m.On("SetSetting", mock.Anything, mock.Anything).
+					Return(nil).Times(3)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: require.NoError,
+			wantSettings: []sonarApi.ProcessedSetting{
+				{Key: "sonar.core.a", Source: sonarApi.SettingSourceInline},
+				{Key: "sonar.core.b", Source: sonarApi.SettingSourceValues},
+				{Key: "sonar.core.new", Source: sonarApi.SettingSourceInline},
+			},
+			wantDrift: []string{"sonar.core.a", "sonar.core.b"},
+		},
+		{
+			name: "changed spec is not a drift",
+			settings: []sonarApi.SonarSetting{
+				{
+					Key:   "sonar.core.a",
+					Value: "sonar",
+				},
+				{
+					Key:   "sonar.auth.secured",
+					Value: "new-secret",
+				},
+			},
+			status: sonarApi.SettingsStatus{
+				Settings: []sonarApi.ProcessedSetting{
+					appliedSecret("sonar.auth.secured", sonarApi.SettingSourceInline,
+						newSettingValue("sonar.auth.secured", "old-secret")),
+					applied("sonar.core.a", sonarApi.SettingSourceInline, newSettingValue("sonar.core.a", "old")),
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.Settings {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "", []string{"sonar.core.a"}).
+					Return([]sonar.Setting{{Key: "sonar.core.a", Value: "old"}}, nil)
+				m.On("SetSetting", mock.Anything, mock.Anything).
+					Return(nil).Twice()
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: require.NoError,
+			wantSettings: []sonarApi.ProcessedSetting{
+				{Key: "sonar.auth.secured", Source: sonarApi.SettingSourceInline},
+				{Key: "sonar.core.a", Source: sonarApi.SettingSourceInline},
+			},
+		},
+		{
+			name: "retry of failed setting is not a drift",
+			settings: []sonarApi.SonarSetting{
+				{
+					Key:   "sonar.core.a",
+					Value: "sonar",
+				},
+			},
+			status: sonarApi.SettingsStatus{
+				Settings: []sonarApi.ProcessedSetting{
+					{
+						Key:       "sonar.core.a",
+						ValueHash: hashSetting(newSettingValue("sonar.core.a", "sonar")),
+						Source:    sonarApi.SettingSourceInline,
+						Error:     "failed to set setting",
+					},
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.Settings {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "", []string{"sonar.core.a"}).
+					Return([]sonar.Setting{{Key: "sonar.core.a", Value: "old"}}, nil)
+				m.On("SetSetting", mock.Anything, mock.Anything).
+					Return(nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: require.NoError,
+			wantSettings: []sonarApi.ProcessedSetting{
+				{Key: "sonar.core.a", Source: sonarApi.SettingSourceInline},
+			},
+		},
+		{
+			name: "failed to unset previous settings",
+			settings: []sonarApi.SonarSetting{
+				{
+					Key:   "sonar.core.a",
+					Value: "sonar",
+				},
+			},
+			status: sonarApi.SettingsStatus{
+				Settings: []sonarApi.ProcessedSetting{
+					applied("sonar.core.a", sonarApi.SettingSourceInline, newSettingValue("sonar.core.a", "sonar")),
+					applied("sonar.core.b", sonarApi.SettingSourceInline, newSettingValue("sonar.core.b", "sonar")),
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.Settings {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "", []string{"sonar.core.a"}).
+					Return([]sonar.Setting{{Key: "sonar.core.a", Value: "sonar"}}, nil)
+				m.On("ResetSettings", mock.Anything, "", []string{"sonar.core.b"}).
+					Return(errors.New("failed to unset settings"))
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to unset settings")
+			},
+			wantSettings: []sonarApi.ProcessedSetting{
+				{Key: "sonar.core.a", Source: sonarApi.SettingSourceInline},
+				{Key: "sonar.core.b", Source: sonarApi.SettingSourceInline, Error: "failed to unset settings"},
+			},
+		},
+		{
+			name: "failed to set settings",
+			settings: []sonarApi.SonarSetting{
+				{
+					Key:   "sonar.core.a",
+					Value: "sonar",
+				},
+				{
+					Key:   "sonar.core.b",
+					Value: "sonar",
+				},
+				secretSetting,
+			},
+			sonarApiClient: func(t *testing.T) sonar.Settings {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "", mock.Anything).
+					Return(nil, nil)
+				m.On("SetSetting", mock.Anything, newSettingValue("sonar.core.a", "sonar")).
+					Return(errors.New("failed to set setting"))
+				m.On("SetSetting", mock.Anything, newSettingValue("sonar.core.b", "sonar")).
+					Return(nil)
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to set setting sonar.core.a")
+				require.Contains(t, err.Error(), "failed to get sonar setting from source ref")
+			},
+			wantSettings: []sonarApi.ProcessedSetting{
+				{Key: "sonar.core.a", Source: sonarApi.SettingSourceInline, Error: "failed to set setting"},
+				{Key: "sonar.core.b", Source: sonarApi.SettingSourceInline},
+				{
+					Key:    "sonar.secret",
+					Source: sonarApi.SettingSourceValueRef,
+					Error:  `failed to get sonar setting from source ref: unable to get secret: secrets "sonar-secret" not found`,
+				},
+			},
+		},
+		{
+			name: "failed to get current settings",
+			settings: []sonarApi.SonarSetting{
+				{
+					Key:   "sonar.core.a",
+					Value: "sonar",
+				},
+			},
+			sonarApiClient: func(t *testing.T) sonar.Settings {
+				m := mocks.NewMockClientInterface(t)
+				m.On("GetSettings", mock.Anything, "", mock.Anything).
+					Return(nil, errors.New("sonar is unavailable"))
+
+				return m
+			},
+			k8sClient: func(t *testing.T) client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed to get current settings")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			oldSettings := make(map[string]sonarApi.ProcessedSetting, len(tt.status.Settings))
+			for _, s := range tt.status.Settings {
+				oldSettings[s.Key] = s
+			}
+
+			status := tt.status.DeepCopy()
+
+			err := NewSyncer(tt.sonarApiClient(t), tt.k8sClient(t)).Sync(
+				ctrl.LoggerInto(context.Background(), logr.Discard()),
+				owner.DeepCopy(),
+				tt.component,
+				"default",
+				tt.settings,
+				status,
+			)
+
+			tt.wantErr(t, err)
+
+			if tt.wantDrift != nil {
+				require.NotNil(t, status.SettingsDrift)
+				assert.Equal(t, tt.wantDrift, status.SettingsDrift.Keys)
+			} else {
+				assert.Nil(t, status.SettingsDrift)
+			}
+
+			if tt.wantSettings == nil {
+				return
+			}
+
+			require.Len(t, status.Settings, len(tt.wantSettings))
+
+			for i, s := range status.Settings {
+				assert.Equal(t, tt.wantSettings[i].Key, s.Key)
+				assert.Equal(t, tt.wantSettings[i].Source, s.Source)
+				assert.Equal(t, tt.wantSettings[i].Error, s.Error)
+
+				if s.Error == "" {
+					assert.NotEmpty(t, s.ValueHash)
+					assert.NotNil(t, s.LastAppliedTime)
+				}
+
+				if old, ok := oldSettings[s.Key]; ok && old.ValueHash == s.ValueHash && old.Error == "" && !slices.Contains(tt.wantDrift, s.Key) {
+					assert.Equal(t, old.LastAppliedTime, s.LastAppliedTime, "unchanged setting shouldn't be reapplied")
+				}
+			}
+		})
+	}
+}
+
+func TestHashSecretSetting(t *testing.T) {
+	t.Parallel()
+
+	setting := newSettingValue("sonar.auth.secured", "secret")
+
+	assert.Equal(t, hashSecretSetting(setting, "sonar-uid"), hashSecretSetting(setting, "sonar-uid"))
+	assert.NotEqual(t, hashSetting(setting), hashSecretSetting(setting, "sonar-uid"),
+		"secret value shouldn't be hashed without the key")
+	assert.NotEqual(t, hashSecretSetting(setting, "sonar-uid"), hashSecretSetting(setting, "other-uid"),
+		"secret value should be hashed with the UID of the owner")
+}
@@ -0,0 +1,127 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// TracerName is the name of the tracer of the operator spans.
+	TracerName = "github.com/epam/edp-sonar-operator"
+
+	serviceName = "sonar-operator"
+)
+
+// Options are the options of the OTLP trace exporter.
+type Options struct {
+	// Endpoint is the host:port of the OTLP gRPC collector, tracing is disabled if it is empty.
+	Endpoint string
+	// Insecure disables TLS for the connection to the collector.
+	Insecure bool
+	// SampleRatio is the ratio of the sampled reconciles, from 0 to 1.
+	SampleRatio float64
+}
+
+// Setup configures the global tracer provider that exports the spans to the OTLP collector.
+// It returns the function that flushes the spans and stops the exporter.
+// If the endpoint is empty, the global no-op tracer provider is kept.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	if opts.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.Endpoint)}
+	if opts.Insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return provider.Shutdown, nil
+}
+
+// Start starts a span of the operator with the global tracer provider.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, opts...)
+}
+
+// StartHandler starts a span of the chain handler, the span is named by the type of the handler,
+// e.g. CreateQualityProfile.
+func StartHandler(ctx context.Context, handler any) (context.Context, trace.Span) {
+	return Start(ctx, handlerName(handler))
+}
+
+// End records the error in the span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+func handlerName(handler any) string {
+	t := reflect.TypeOf(handler)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
+// NewReconciler returns the reconciler that runs every reconcile of the kind in a span.
+// The trace ID is added to the logger of the reconcile to find the trace of the logs.
+func NewReconciler(kind string, r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		ctx, span := Start(ctx, kind+" reconcile",
+			trace.WithAttributes(
+				attribute.String("k8s.resource.kind", kind),
+				semconv.K8SNamespaceName(req.Namespace),
+				attribute.String("k8s.resource.name", req.Name),
+			),
+		)
+
+		if span.SpanContext().IsValid() {
+			ctx = ctrl.LoggerInto(ctx, ctrl.LoggerFrom(ctx).WithValues("traceID", span.SpanContext().TraceID().String()))
+		}
+
+		result, err := r.Reconcile(ctx, req)
+
+		if result.RequeueAfter > 0 {
+			span.SetAttributes(attribute.String("reconcile.requeue_after", result.RequeueAfter.String()))
+		}
+
+		End(span, err)
+
+		return result, err
+	})
+}
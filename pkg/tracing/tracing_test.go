@@ -0,0 +1,93 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-sonar-operator/pkg/testutils"
+)
+
+type CreateQualityProfile struct{}
+
+func (*CreateQualityProfile) ServeRequest(ctx context.Context) error {
+	_, span := StartHandler(ctx, &CreateQualityProfile{})
+	End(span, nil)
+
+	return nil
+}
+
+type SyncQualityProfileRules struct{}
+
+func (SyncQualityProfileRules) ServeRequest(ctx context.Context) error {
+	err := errors.New("rule not found")
+
+	_, span := StartHandler(ctx, SyncQualityProfileRules{})
+	End(span, err)
+
+	return err
+}
+
+func TestNewReconciler(t *testing.T) {
+	exporter := testutils.SetupInMemoryTracing(t)
+
+	var reconcileSpan trace.SpanContext
+
+	r := NewReconciler("SonarQualityProfile", reconcile.Func(
+		func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+			reconcileSpan = trace.SpanContextFromContext(ctx)
+
+			_ = (&CreateQualityProfile{}).ServeRequest(ctx)
+
+			return reconcile.Result{RequeueAfter: time.Minute}, SyncQualityProfileRules{}.ServeRequest(ctx)
+		},
+	))
+
+	_, err := r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "profile"},
+	})
+	require.Error(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+
+	create, sync, root := spans[0], spans[1], spans[2]
+
+	assert.Equal(t, "SonarQualityProfile reconcile", root.Name)
+	assert.Equal(t, reconcileSpan.SpanID(), root.SpanContext.SpanID())
+	assert.Equal(t, codes.Error, root.Status.Code)
+	assert.Contains(t, root.Attributes, attribute.String("k8s.resource.kind", "SonarQualityProfile"))
+	assert.Contains(t, root.Attributes, attribute.String("k8s.namespace.name", "default"))
+	assert.Contains(t, root.Attributes, attribute.String("k8s.resource.name", "profile"))
+	assert.Contains(t, root.Attributes, attribute.String("reconcile.requeue_after", "1m0s"))
+
+	assert.Equal(t, "CreateQualityProfile", create.Name)
+	assert.Equal(t, root.SpanContext.SpanID(), create.Parent.SpanID())
+	assert.Equal(t, codes.Unset, create.Status.Code)
+
+	assert.Equal(t, "SyncQualityProfileRules", sync.Name)
+	assert.Equal(t, root.SpanContext.SpanID(), sync.Parent.SpanID())
+	assert.Equal(t, codes.Error, sync.Status.Code)
+	assert.Equal(t, "rule not found", sync.Status.Description)
+	require.Len(t, sync.Events, 1, "error must be recorded")
+}
+
+func TestSetup_Disabled(t *testing.T) {
+	provider := otel.GetTracerProvider()
+
+	shutdown, err := Setup(context.Background(), Options{})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+
+	assert.Same(t, provider, otel.GetTracerProvider(), "tracer provider must not be changed")
+}